@@ -135,6 +135,18 @@ public sealed class DockerClient : IDockerClient
         return MakeRequestAsync<T>(errorHandlers, method, path, queryString, body, null, cancellationToken);
     }
 
+    internal Task MakeRequestAsync(
+        IEnumerable<ApiResponseErrorHandlingDelegate> errorHandlers,
+        HttpMethod method,
+        string path,
+        IQueryString? queryString,
+        IRequestContent? body,
+        IDictionary<string, string>? headers,
+        CancellationToken cancellationToken)
+    {
+        return MakeRequestAsync<NoContent>(errorHandlers, method, path, queryString, body, headers, _clientOptions.Timeout, cancellationToken);
+    }
+
     internal Task<T> MakeRequestAsync<T>(
         IEnumerable<ApiResponseErrorHandlingDelegate> errorHandlers,
         HttpMethod method,
@@ -257,23 +269,6 @@ public sealed class DockerClient : IDockerClient
         }
     }
 
-    internal async Task<HttpResponseMessage> MakeRequestForRawResponseAsync(
-        HttpMethod method,
-        string path,
-        IQueryString? queryString,
-        IRequestContent? body,
-        IDictionary<string, string>? headers,
-        CancellationToken cancellationToken)
-    {
-        var response = await PrivateMakeRequestAsync(Timeout.InfiniteTimeSpan, HttpCompletionOption.ResponseHeadersRead, method, path, queryString, headers, body, cancellationToken)
-            .ConfigureAwait(false);
-
-        await HandleIfErrorResponseAsync(response.StatusCode, response, null)
-            .ConfigureAwait(false);
-
-        return response;
-    }
-
     internal async Task<StandardStreamResponse> MakeRequestForStreamedResponseAsync(
         IEnumerable<ApiResponseErrorHandlingDelegate> errorHandlers,
         HttpMethod method,
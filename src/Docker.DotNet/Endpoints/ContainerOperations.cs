@@ -1,23 +1,7 @@
 namespace Docker.DotNet;
 
-internal class ContainerOperations : IContainerOperations
+internal partial class ContainerOperations : IContainerOperations
 {
-    private static readonly ApiResponseErrorHandlingDelegate NoSuchContainerHandler = (statusCode, responseBody) =>
-    {
-        if (statusCode == HttpStatusCode.NotFound)
-        {
-            throw new DockerContainerNotFoundException(statusCode, responseBody);
-        }
-    };
-
-    private static readonly ApiResponseErrorHandlingDelegate NoSuchImageHandler = (statusCode, responseBody) =>
-    {
-        if (statusCode == HttpStatusCode.NotFound)
-        {
-            throw new DockerImageNotFoundException(statusCode, responseBody);
-        }
-    };
-
     private readonly DockerClient _client;
 
     internal ContainerOperations(DockerClient client)
@@ -25,34 +9,6 @@ internal class ContainerOperations : IContainerOperations
         _client = client;
     }
 
-    public async Task<IList<ContainerListResponse>> ListContainersAsync(ContainersListParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainersListParameters>(parameters);
-
-        return await _client.MakeRequestAsync<ContainerListResponse[]>(_client.NoErrorHandlers, HttpMethod.Get, "containers/json", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task<CreateContainerResponse> CreateContainerAsync(CreateContainerParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<CreateContainerParameters>(parameters);
-
-        var data = new JsonRequestContent<CreateContainerParameters>(parameters, DockerClient.JsonSerializer);
-
-        return await _client.MakeRequestAsync<CreateContainerResponse>([NoSuchImageHandler], HttpMethod.Post, "containers/create", queryParameters, data, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task<ContainerInspectResponse> InspectContainerAsync(string id, CancellationToken cancellationToken = default)
     {
         if (string.IsNullOrEmpty(id))
@@ -64,42 +20,6 @@ internal class ContainerOperations : IContainerOperations
             .ConfigureAwait(false);
     }
 
-    public async Task<ContainerInspectResponse> InspectContainerAsync(string id, ContainerInspectParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainerInspectParameters>(parameters);
-
-        return await _client.MakeRequestAsync<ContainerInspectResponse>([NoSuchContainerHandler], HttpMethod.Get, $"containers/{id}/json", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task<ContainerProcessesResponse> ListProcessesAsync(string id, ContainerListProcessesParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainerListProcessesParameters>(parameters);
-
-        return await _client.MakeRequestAsync<ContainerProcessesResponse>([NoSuchContainerHandler], HttpMethod.Get, $"containers/{id}/top", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task GetContainerLogsAsync(string id, ContainerLogsParameters parameters, IProgress<string> progress, CancellationToken cancellationToken = default)
     {
         using var multiplexedStream = await GetContainerLogsAsync(id, parameters, cancellationToken)
@@ -135,28 +55,6 @@ internal class ContainerOperations : IContainerOperations
         return new MultiplexedStream(response, !containerConfig.Tty);
     }
 
-    public async Task<IList<ContainerFileSystemChangeResponse>> InspectChangesAsync(string id, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        return await _client.MakeRequestAsync<ContainerFileSystemChangeResponse[]>([NoSuchContainerHandler], HttpMethod.Get, $"containers/{id}/changes", cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task<Stream> ExportContainerAsync(string id, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        return await _client.MakeRequestForStreamAsync([NoSuchContainerHandler], HttpMethod.Get, $"containers/{id}/export", cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task<Stream> GetContainerStatsAsync(string id, ContainerStatsParameters parameters, CancellationToken cancellationToken = default)
     {
         if (string.IsNullOrEmpty(id))
@@ -175,49 +73,6 @@ internal class ContainerOperations : IContainerOperations
             .ConfigureAwait(false);
     }
 
-    public Task GetContainerStatsAsync(string id, ContainerStatsParameters parameters, IProgress<ContainerStatsResponse> progress, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        if (progress == null)
-        {
-            throw new ArgumentNullException(nameof(progress));
-        }
-
-        var queryParameters = new QueryString<ContainerStatsParameters>(parameters);
-
-        return StreamUtil.MonitorStreamForMessagesAsync(
-            _client.MakeRequestForStreamAsync([NoSuchContainerHandler], HttpMethod.Get, $"containers/{id}/stats", queryParameters, null, null, cancellationToken),
-            progress,
-            cancellationToken);
-    }
-
-    public async Task ResizeContainerTtyAsync(string id, ContainerResizeParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainerResizeParameters>(parameters);
-
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/resize", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task<bool> StartContainerAsync(string id, ContainerStartParameters? parameters = null, CancellationToken cancellationToken = default)
     {
         if (string.IsNullOrEmpty(id))
@@ -259,79 +114,6 @@ internal class ContainerOperations : IContainerOperations
         return result ?? throw new InvalidOperationException();
     }
 
-    public async Task RestartContainerAsync(string id, ContainerRestartParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainerRestartParameters>(parameters);
-
-        // since specified wait timespan can be greater than HttpClient's default, we set the
-        // client timeout to infinite and provide a cancellation token.
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/restart", queryParameters, null, null, Timeout.InfiniteTimeSpan, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task KillContainerAsync(string id, ContainerKillParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainerKillParameters>(parameters);
-
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/kill", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task RenameContainerAsync(string id, ContainerRenameParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        var queryParameters = new QueryString<ContainerRenameParameters>(parameters);
-
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/rename", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task PauseContainerAsync(string id, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/pause", cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task UnpauseContainerAsync(string id, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/unpause", cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task<MultiplexedStream> AttachContainerAsync(string id, ContainerAttachParameters parameters, CancellationToken cancellationToken = default)
     {
         if (string.IsNullOrEmpty(id))
@@ -358,35 +140,6 @@ internal class ContainerOperations : IContainerOperations
         return new MultiplexedStream(response, !containerConfig.Tty);
     }
 
-    public async Task<ContainerWaitResponse> WaitContainerAsync(string id, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        return await _client.MakeRequestAsync<ContainerWaitResponse>([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/wait", null, null, null, Timeout.InfiniteTimeSpan, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task RemoveContainerAsync(string id, ContainerRemoveParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainerRemoveParameters>(parameters);
-
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Delete, $"containers/{id}", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task<ContainerArchiveResponse> GetArchiveFromContainerAsync(string id, ContainerPathStatParameters parameters, bool statOnly, CancellationToken cancellationToken = default)
     {
         if (string.IsNullOrEmpty(id))
@@ -447,30 +200,4 @@ internal class ContainerOperations : IContainerOperations
         await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Put, $"containers/{id}/archive", queryParameters, data, cancellationToken)
             .ConfigureAwait(false);
     }
-
-    public async Task<ContainersPruneResponse> PruneContainersAsync(ContainersPruneParameters? parameters = null, CancellationToken cancellationToken = default)
-    {
-        var queryParameters = parameters == null ? null : new QueryString<ContainersPruneParameters>(parameters);
-
-        return await _client.MakeRequestAsync<ContainersPruneResponse>(_client.NoErrorHandlers, HttpMethod.Post, "containers/prune", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task<ContainerUpdateResponse> UpdateContainerAsync(string id, ContainerUpdateParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var data = new JsonRequestContent<ContainerUpdateParameters>(parameters, DockerClient.JsonSerializer);
-
-        return await _client.MakeRequestAsync<ContainerUpdateResponse>([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/update", null, data, cancellationToken)
-            .ConfigureAwait(false);
-    }
 }
\ No newline at end of file
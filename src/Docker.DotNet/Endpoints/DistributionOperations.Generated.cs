@@ -0,0 +1,23 @@
+namespace Docker.DotNet;
+
+internal partial class DistributionOperations : IDistributionOperations
+{
+    private static readonly ApiResponseErrorHandlingDelegate NoSuchImageHandler = (statusCode, responseBody) =>
+    {
+        if (statusCode == HttpStatusCode.NotFound)
+        {
+            throw new DockerImageNotFoundException(statusCode, responseBody);
+        }
+    };
+
+    public async Task<DistributionInspectResponse> InspectAsync(string name, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        return await _client.MakeRequestAsync<DistributionInspectResponse>([NoSuchImageHandler], HttpMethod.Get, $"distribution/{name}/json", cancellationToken)
+            .ConfigureAwait(false);
+    }
+}
@@ -1,15 +1,7 @@
 namespace Docker.DotNet;
 
-internal class ExecOperations : IExecOperations
+internal partial class ExecOperations : IExecOperations
 {
-    private static readonly ApiResponseErrorHandlingDelegate NoSuchContainerHandler = (statusCode, responseBody) =>
-    {
-        if (statusCode == HttpStatusCode.NotFound)
-        {
-            throw new DockerContainerNotFoundException(statusCode, responseBody);
-        }
-    };
-
     private readonly DockerClient _client;
 
     internal ExecOperations(DockerClient client)
@@ -17,35 +9,6 @@ internal class ExecOperations : IExecOperations
         _client = client;
     }
 
-    public async Task<ContainerExecInspectResponse> InspectContainerExecAsync(string id, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        return await _client.MakeRequestAsync<ContainerExecInspectResponse>([NoSuchContainerHandler], HttpMethod.Get, $"exec/{id}/json", cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task<ContainerExecCreateResponse> CreateContainerExecAsync(string id, ContainerExecCreateParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var data = new JsonRequestContent<ContainerExecCreateParameters>(parameters, DockerClient.JsonSerializer);
-
-        return await _client.MakeRequestAsync<ContainerExecCreateResponse>([NoSuchContainerHandler], HttpMethod.Post, $"containers/{id}/exec", null, data, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task<MultiplexedStream> StartContainerExecAsync(string id, ContainerExecStartParameters parameters, CancellationToken cancellationToken = default)
     {
         if (string.IsNullOrEmpty(id))
@@ -60,22 +23,4 @@ internal class ExecOperations : IExecOperations
 
         return new MultiplexedStream(response, !parameters.TTY);
     }
-
-    public async Task ResizeExecTtyAsync(string id, ContainerResizeParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(id))
-        {
-            throw new ArgumentNullException(nameof(id));
-        }
-
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ContainerResizeParameters>(parameters);
-
-        await _client.MakeRequestAsync([NoSuchContainerHandler], HttpMethod.Post, $"exec/{id}/resize", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
 }
\ No newline at end of file
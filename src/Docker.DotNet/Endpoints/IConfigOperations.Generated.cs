@@ -1,51 +1,70 @@
 namespace Docker.DotNet;
 
-public interface IConfigOperations
+public partial interface IConfigOperations
 {
     /// <summary>
     /// List configs
     /// </summary>
     /// <remarks>
+    /// HTTP GET /configs
+    ///
     /// 200 - No error.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task<IList<SwarmConfig>> ListConfigsAsync(CancellationToken cancellationToken = default);
 
     /// <summary>
-    /// Create a configs
+    /// Inspect a configs
     /// </summary>
     /// <remarks>
-    /// 201 - No error.
-    /// 406 - Server error or node is not part of a swarm.
-    /// 409 - Name conflicts with an existing object.
+    /// HTTP GET /configs/{id}
+    ///
+    /// 200 - No error.
+    /// 404 - Secret not found.
+    /// 406 - Node is not part of a swarm.
     /// 500 - Server error.
     /// </remarks>
+    /// <param name="id">ID of the config.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<SwarmCreateConfigResponse> CreateConfigAsync(SwarmCreateConfigParameters body, CancellationToken cancellationToken = default);
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<SwarmConfig> InspectConfigAsync(string id, CancellationToken cancellationToken = default);
 
     /// <summary>
-    /// Inspect a configs
+    /// Create a configs
     /// </summary>
     /// <remarks>
-    /// 200 - No error.
-    /// 404 - Secret not found.
-    /// 406 - Node is not part of a swarm.
+    /// HTTP POST /configs/create
+    ///
+    /// 201 - No error.
+    /// 406 - Server error or node is not part of a swarm.
+    /// 409 - Name conflicts with an existing object.
     /// 500 - Server error.
     /// </remarks>
-    /// <param name="id">ID of the config.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<SwarmConfig> InspectConfigAsync(string id, CancellationToken cancellationToken = default);
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<SwarmCreateConfigResponse> CreateConfigAsync(SwarmCreateConfigParameters body, CancellationToken cancellationToken = default);
 
     /// <summary>
     /// Remove a configs
     /// </summary>
     /// <remarks>
+    /// HTTP DELETE /configs/{id}
+    ///
     /// 204 - No error.
     /// 404 - Secret not found.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="id">ID of the config.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task RemoveConfigAsync(string id, CancellationToken cancellationToken = default);
-}
\ No newline at end of file
+}
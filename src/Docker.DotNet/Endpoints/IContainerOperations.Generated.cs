@@ -0,0 +1,304 @@
+namespace Docker.DotNet;
+
+public partial interface IContainerOperations
+{
+    /// <summary>
+    /// Creates a new container from an image.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker container create</c>.
+    ///
+    /// HTTP POST /containers/create
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a <see cref="CreateContainerResponse"/>, which provides information about the newly-created container.</returns>
+    /// <exception cref="DockerImageNotFoundException">No such image was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<CreateContainerResponse> CreateContainerAsync(CreateContainerParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Returns a list of containers.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker ps</c> and <c>docker container ls</c>.
+    ///
+    /// HTTP GET /containers/json
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a list of <see cref="ContainerListResponse"/> objects, which represent the containers found.</returns>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<ContainerListResponse>> ListContainersAsync(ContainersListParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Deletes stopped containers.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker container prune</c>.
+    ///
+    /// HTTP POST /containers/prune
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a <see cref="ContainersPruneResponse"/>, which details which containers were removed.</returns>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ContainersPruneResponse> PruneContainersAsync(ContainersPruneParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Deletes a container.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker rm</c> and <c>docker container rm</c>.
+    ///
+    /// HTTP DELETE /containers/{id}
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the container has been removed.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">There is a conflict, the input is invalid, or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task RemoveContainerAsync(string id, ContainerRemoveParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Reports which files in a container's filesystem have been added, deleted, or modified.
+    /// </summary>
+    /// <remarks>
+    /// HTTP GET /containers/{id}/changes
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a list of <see cref="ContainerFileSystemChangeResponse"/> objects. Each object corresponds to a single changed file.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<ContainerFileSystemChangeResponse>> InspectChangesAsync(string id, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Exports the contents of a container as a tarball.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker export</c> and <c>docker container export</c>.
+    ///
+    /// HTTP GET /containers/{id}/export
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a <see cref="Stream"/>, which can be read to obtain the bytes of the tarball.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<Stream> ExportContainerAsync(string id, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Retrieves low-level information about a container with additional options.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker inspect --size</c> and <c>docker container inspect --size</c>.
+    ///
+    /// HTTP GET /containers/{id}/json
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation, such as whether to include size information.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a <see cref="ContainerInspectResponse"/>, which holds details about the container.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ContainerInspectResponse> InspectContainerAsync(string id, ContainerInspectParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Sends a POSIX signal to a container--typically to kill it.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker kill</c> and <c>docker container kill</c>.
+    ///
+    /// HTTP POST /containers/{id}/kill
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the operation is complete.</returns>
+    /// <seealso cref="StopContainerAsync(string, ContainerStopParameters, CancellationToken)"/>
+    /// <seealso cref="PruneContainersAsync(ContainersPruneParameters, CancellationToken)"/>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The container was not running, the input is invalid, or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task KillContainerAsync(string id, ContainerKillParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Suspends a container.
+    /// </summary>
+    /// <remarks>
+    /// This uses the freeze cgroup to suspend all processes in the container. The processes are unaware that they are being
+    /// suspended (e.g., they cannot capture a SIGSTOP signal).
+    /// <br/>The corresponding commands in the Docker CLI are <c>docker pause</c> and <c>docker container pause</c>.
+    ///
+    /// HTTP POST /containers/{id}/pause
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the operation is complete.</returns>
+    /// <seealso cref="UnpauseContainerAsync(string, CancellationToken)"/>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task PauseContainerAsync(string id, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Changes the name of a container.
+    /// </summary>
+    /// <remarks>
+    /// HTTP POST /containers/{id}/rename
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the operation is complete.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The name is already in use, the input is invalid, or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task RenameContainerAsync(string id, ContainerRenameParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Resizes a container's TTY.
+    /// </summary>
+    /// <remarks>
+    /// You must restart the container for the change to take effect.
+    ///
+    /// HTTP POST /containers/{id}/resize
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the operation is complete.</returns>
+    /// <seealso cref="RestartContainerAsync(string, ContainerRestartParameters, CancellationToken)"/>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task ResizeContainerTtyAsync(string id, ContainerResizeParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Stops and then restarts a container.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker restart</c> and <c>docker container restart</c>.
+    ///
+    /// HTTP POST /containers/{id}/restart
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the operation is complete.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task RestartContainerAsync(string id, ContainerRestartParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Retrieves a live, raw stream of the container's resource usage statistics.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker stats</c> and <c>docker container stats</c>.
+    ///
+    /// HTTP GET /containers/{id}/stats
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="progress">Provides a callback to trigger whenever a new frame of statistics is available.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the stream has ended.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task GetContainerStatsAsync(string id, ContainerStatsParameters parameters, IProgress<ContainerStatsResponse> progress, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Retrieves a list of processes running within the container.
+    /// </summary>
+    /// <remarks>
+    /// This operation is not supported on Windows, because the underlying API does not support it.
+    /// <br/>The corresponding commands in the Docker CLI are <c>docker top</c> and <c>docker container top</c>.
+    ///
+    /// HTTP GET /containers/{id}/top
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a <see cref="ContainerProcessesResponse"/>, which holds information about the processes.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ContainerProcessesResponse> ListProcessesAsync(string id, ContainerListProcessesParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Resumes a container that was suspended.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker unpause</c> and <c>docker container unpause</c>.
+    ///
+    /// HTTP POST /containers/{id}/unpause
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task"/> that resolves when the operation is complete.</returns>
+    /// <seealso cref="PauseContainerAsync(string, CancellationToken)"/>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task UnpauseContainerAsync(string id, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Changes configuration options of a container without recreating it.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker update</c>.
+    ///
+    /// HTTP POST /containers/{id}/update
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a <see cref="ContainerUpdateResponse"/>, which provides updated information about the container.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ContainerUpdateResponse> UpdateContainerAsync(string id, ContainerUpdateParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Waits for a container to stop.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding commands in the Docker CLI are <c>docker wait</c> and <c>docker container wait</c>.
+    ///
+    /// HTTP POST /containers/{id}/wait
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>A <see cref="Task{TResult}"/> that resolves to a <see cref="ContainerWaitResponse"/> when the container has stopped.</returns>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ContainerWaitResponse> WaitContainerAsync(string id, CancellationToken cancellationToken = default);
+}
@@ -0,0 +1,50 @@
+namespace Docker.DotNet;
+
+public partial interface IExecOperations
+{
+    /// <summary>
+    /// Creates an exec instance, to run a command inside a running container.
+    /// </summary>
+    /// <remarks>
+    /// The exec instance is started by <see cref="StartContainerExecAsync"/>.
+    ///
+    /// HTTP POST /containers/{id}/exec
+    /// </remarks>
+    /// <param name="id">The ID or name of the container.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ContainerExecCreateResponse> CreateContainerExecAsync(string id, ContainerExecCreateParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Resizes the TTY of an exec instance.
+    /// </summary>
+    /// <remarks>
+    /// HTTP POST /exec/{id}/resize
+    /// </remarks>
+    /// <param name="id">ID of the exec instance.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task ResizeExecTtyAsync(string id, ContainerResizeParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Returns low-level information about an exec instance.
+    /// </summary>
+    /// <remarks>
+    /// HTTP GET /exec/{id}/json
+    /// </remarks>
+    /// <param name="id">ID of the exec instance.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ContainerExecInspectResponse> InspectContainerExecAsync(string id, CancellationToken cancellationToken = default);
+}
@@ -0,0 +1,112 @@
+namespace Docker.DotNet;
+
+public partial interface IImageOperations
+{
+    /// <summary>
+    /// Create a new image from a container.
+    /// </summary>
+    /// <remarks>
+    /// The equivalent command in the Docker CLI is <c>docker commit</c>.
+    ///
+    /// HTTP POST /commit
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<CommitContainerChangesResponse> CommitContainerChangesAsync(CommitContainerChangesParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Retrieves a list of the images on the server.
+    /// </summary>
+    /// <remarks>
+    /// The equivalent commands in the Docker CLI are <c>docker images</c> and <c>docker image ls</c>.
+    ///
+    /// HTTP GET /images/json
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<ImagesListResponse>> ListImagesAsync(ImagesListParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Deletes unused images.
+    /// </summary>
+    /// <remarks>
+    /// The equivalent command in the Docker CLI is <c>docker image prune</c>.
+    ///
+    /// HTTP POST /images/prune
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ImagesPruneResponse> PruneImagesAsync(ImagesPruneParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Searches for an image on Docker Hub.
+    /// </summary>
+    /// <remarks>
+    /// The equivalent command in the Docker CLI is <c>docker search</c>.
+    ///
+    /// HTTP GET /images/search
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<ImageSearchResponse>> SearchImagesAsync(ImagesSearchParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Gets the "history" (parent layers) of an image.
+    /// </summary>
+    /// <remarks>
+    /// The equivalent commands in the Docker CLI are <c>docker history</c> and <c>docker image history</c>.
+    ///
+    /// HTTP GET /images/{name}/history
+    /// </remarks>
+    /// <param name="name">An image name or ID.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerImageNotFoundException">No such image was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<ImageHistoryResponse>> GetImageHistoryAsync(string name, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Retrieves low-level information about an image.
+    /// </summary>
+    /// <remarks>
+    /// The equivalent commands in the Docker CLI are <c>docker inspect</c> and <c>docker image inspect</c>.
+    ///
+    /// HTTP GET /images/{name}/json
+    /// </remarks>
+    /// <param name="name">An image name or ID.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerImageNotFoundException">No such image was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<ImageInspectResponse> InspectImageAsync(string name, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Tags an image so that it becomes part of a registry.
+    /// </summary>
+    /// <remarks>
+    /// The equivalent commands in the Docker CLI are <c>docker tag</c> and <c>docker image tag</c>.
+    ///
+    /// HTTP POST /images/{name}/tag
+    /// </remarks>
+    /// <param name="name">An image name or ID.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerImageNotFoundException">No such image was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task TagImageAsync(string name, ImageTagParameters parameters, CancellationToken cancellationToken = default);
+}
@@ -1,20 +1,7 @@
 namespace Docker.DotNet;
 
-public interface IImageOperations
+public partial interface IImageOperations
 {
-    /// <summary>
-    /// Retrieves a list of the images on the server.
-    /// </summary>
-    /// <param name="parameters">Specifics of how to perform the operation.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    /// <remarks>
-    /// The equivalent commands in the Docker CLI are <c>docker images</c> and <c>docker image ls</c>.
-    /// </remarks>
-    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
-    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
-    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
-    Task<IList<ImagesListResponse>> ListImagesAsync(ImagesListParameters parameters, CancellationToken cancellationToken = default);
-
     /// <summary>
     /// Builds an image from a tar archive that contains a Dockerfile.
     /// </summary>
@@ -136,34 +123,6 @@ public interface IImageOperations
     /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task CreateImageAsync(ImagesCreateParameters parameters, Stream? imageStream, AuthConfig? authConfig, IDictionary<string, string>? headers, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default);
 
-    /// <summary>
-    /// Retrieves low-level information about an image.
-    /// </summary>
-    /// <param name="name">An image name or ID.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    /// <remarks>
-    /// The equivalent commands in the Docker CLI are <c>docker inspect</c> and <c>docker image inspect</c>.
-    /// </remarks>
-    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
-    /// <exception cref="DockerImageNotFoundException">No such image was found.</exception>
-    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
-    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
-    Task<ImageInspectResponse> InspectImageAsync(string name, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Gets the "history" (parent layers) of an image.
-    /// </summary>
-    /// <param name="name">An image name or ID.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    /// <remarks>
-    /// The equivalent commands in the Docker CLI are <c>docker history</c> and <c>docker image history</c>.
-    /// </remarks>
-    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
-    /// <exception cref="DockerImageNotFoundException">No such image was found.</exception>
-    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
-    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
-    Task<IList<ImageHistoryResponse>> GetImageHistoryAsync(string name, CancellationToken cancellationToken = default);
-
     /// <summary>
     /// Pushes an image to a registry.
     /// </summary>
@@ -186,21 +145,6 @@ public interface IImageOperations
     /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task PushImageAsync(string name, ImagePushParameters parameters, AuthConfig? authConfig, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default);
 
-    /// <summary>
-    /// Tags an image so that it becomes part of a registry.
-    /// </summary>
-    /// <param name="name">An image name or ID.</param>
-    /// <param name="parameters">Specifics of how to perform the operation.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    /// <remarks>
-    /// The equivalent commands in the Docker CLI are <c>docker tag</c> and <c>docker image tag</c>.
-    /// </remarks>
-    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
-    /// <exception cref="DockerImageNotFoundException">No such image was found.</exception>
-    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
-    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
-    Task TagImageAsync(string name, ImageTagParameters parameters, CancellationToken cancellationToken = default);
-
     /// <summary>
     /// Removes an image, along with any untagged parent images that were referenced by that image.
     /// </summary>
@@ -219,46 +163,6 @@ public interface IImageOperations
     /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task<IList<IDictionary<string, string>>> DeleteImageAsync(string name, ImageDeleteParameters parameters, CancellationToken cancellationToken = default);
 
-    /// <summary>
-    /// Searchs for an image on Docker Hub.
-    /// </summary>
-    /// <param name="parameters">Specifics of how to perform the operation.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    /// <remarks>
-    /// The equivalent command in the Docker CLI is <c>docker search</c>.
-    /// </remarks>
-    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
-    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
-    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
-    Task<IList<ImageSearchResponse>> SearchImagesAsync(ImagesSearchParameters parameters, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Deletes unused images.
-    /// </summary>
-    /// <param name="parameters">Specifics of how to perform the operation.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    /// <remarks>
-    /// The equivalent commands in the Docker CLI are <c>docker rmi</c> and <c>docker image rm</c>.
-    /// </remarks>
-    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
-    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
-    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
-    Task<ImagesPruneResponse> PruneImagesAsync(ImagesPruneParameters? parameters = null, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Create a new image from a container.
-    /// </summary>
-    /// <param name="parameters">Specifics of how to perform the operation.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    /// <remarks>
-    /// The equivalent command in the Docker CLI is <c>docker commit</c>.
-    /// </remarks>
-    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
-    /// <exception cref="DockerApiException">There was a conflict, or the input is invalid, or the daemon experienced an error.</exception>
-    /// <exception cref="DockerContainerNotFoundException">No such container was found.</exception>
-    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
-    Task<CommitContainerChangesResponse> CommitContainerChangesAsync(CommitContainerChangesParameters parameters, CancellationToken cancellationToken = default);
-
     /// <summary>
     /// Exports an image and its associated metadata as a tarball.
     /// </summary>
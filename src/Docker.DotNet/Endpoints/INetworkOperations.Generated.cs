@@ -0,0 +1,142 @@
+namespace Docker.DotNet;
+
+public partial interface INetworkOperations
+{
+    /// <summary>
+    /// List networks.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker network ls</c>.
+    ///
+    /// HTTP GET /networks
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<NetworkResponse>> ListNetworksAsync(NetworksListParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Create a network.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker network create</c>.
+    ///
+    /// HTTP POST /networks/create
+    ///
+    /// 201 - No error.
+    /// 403 - Operation not supported for pre-defined networks.
+    /// 404 - Plugin not found.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<NetworksCreateResponse> CreateNetworkAsync(NetworksCreateParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Delete unused networks.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker network prune</c>.
+    ///
+    /// HTTP POST /networks/prune
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<NetworksPruneResponse> PruneNetworksAsync(NetworksDeleteUnusedParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Inspect a network.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker network inspect</c>.
+    ///
+    /// HTTP GET /networks/{id}
+    ///
+    /// 200 - No error.
+    /// 404 - Network not found.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="id">Network ID or name.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerNetworkNotFoundException">No such network was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<NetworkResponse> InspectNetworkAsync(string id, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Remove a network.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker network rm</c>.
+    ///
+    /// HTTP DELETE /networks/{id}
+    ///
+    /// 204 - No error.
+    /// 404 - No such network.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="id">Network ID or name.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerNetworkNotFoundException">No such network was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task DeleteNetworkAsync(string id, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Connect a container to a network.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker network connect</c>.
+    ///
+    /// HTTP POST /networks/{id}/connect
+    ///
+    /// 200 - No error.
+    /// 403 - Operation not supported for swarm scoped networks.
+    /// 404 - Network or container not found.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="id">Network ID or name.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerNetworkNotFoundException">No such network was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task ConnectNetworkAsync(string id, NetworkConnectParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Disconnect a container from a network.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker network disconnect</c>.
+    ///
+    /// HTTP POST /networks/{id}/disconnect
+    ///
+    /// 200 - No error.
+    /// 403 - Operation not supported for swarm scoped networks.
+    /// 404 - Network or container not found.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="id">Network ID or name.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerNetworkNotFoundException">No such network was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task DisconnectNetworkAsync(string id, NetworkDisconnectParameters parameters, CancellationToken cancellationToken = default);
+}
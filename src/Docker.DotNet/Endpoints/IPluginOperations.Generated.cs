@@ -0,0 +1,209 @@
+namespace Docker.DotNet;
+
+public partial interface IPluginOperations
+{
+    /// <summary>
+    /// List plugins.
+    ///
+    /// Returns information about installed plugins.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin ls</c>.
+    ///
+    /// HTTP GET /plugins
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<Plugin>> ListPluginsAsync(PluginListParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Get plugin privileges.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin privileges</c>.
+    ///
+    /// HTTP GET /plugins/privileges
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<PluginPrivilege>> GetPrivilegesAsync(PluginGetPrivilegeParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Install a plugin.
+    ///
+    /// Pulls and installs a plugin. After the plugin is installed, it can be enabled using the `POST /plugins/{name}/enable` endpoint.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin install</c>.
+    ///
+    /// HTTP POST /plugins/pull
+    ///
+    /// 204 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="progress">Provides a callback to trigger whenever a progress message is received.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task InstallPluginAsync(PluginInstallParameters parameters, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Inspect a plugin.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin inspect</c>.
+    ///
+    /// HTTP GET /plugins/{name}/json
+    ///
+    /// 200 - No error.
+    /// 404 - Plugin not installed.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerPluginNotFoundException">No such plugin was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<Plugin> InspectPluginAsync(string name, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Remove a plugin.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin rm</c>.
+    ///
+    /// HTTP DELETE /plugins/{name}
+    ///
+    /// 200 - No error.
+    /// 404 - Plugin not installed.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerPluginNotFoundException">No such plugin was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task RemovePluginAsync(string name, PluginRemoveParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Enable a plugin.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin enable</c>.
+    ///
+    /// HTTP POST /plugins/{name}/enable
+    ///
+    /// 200 - No error.
+    /// 404 - Plugin not installed.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerPluginNotFoundException">No such plugin was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task EnablePluginAsync(string name, PluginEnableParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Disable a plugin.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin disable</c>.
+    ///
+    /// HTTP POST /plugins/{name}/disable
+    ///
+    /// 200 - No error.
+    /// 404 - Plugin not installed.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerPluginNotFoundException">No such plugin was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task DisablePluginAsync(string name, PluginDisableParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Upgrade a plugin.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin upgrade</c>.
+    ///
+    /// HTTP POST /plugins/{name}/upgrade
+    ///
+    /// 200 - No error.
+    /// 404 - Plugin not installed.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerPluginNotFoundException">No such plugin was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task UpgradePluginAsync(string name, PluginUpgradeParameters parameters, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Push a plugin.
+    ///
+    /// Push a plugin to the registry.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin push</c>.
+    ///
+    /// HTTP POST /plugins/{name}/push
+    ///
+    /// 200 - No error.
+    /// 404 - Plugin not installed.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerPluginNotFoundException">No such plugin was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task PushPluginAsync(string name, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Configure a plugin.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker plugin set</c>.
+    ///
+    /// HTTP POST /plugins/{name}/set
+    ///
+    /// 204 - No error.
+    /// 404 - Plugin not installed.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerPluginNotFoundException">No such plugin was found.</exception>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task ConfigurePluginAsync(string name, PluginConfigureParameters parameters, CancellationToken cancellationToken = default);
+}
@@ -1,133 +1,7 @@
 namespace Docker.DotNet;
 
-public interface IPluginOperations
+public partial interface IPluginOperations
 {
-    /// <summary>
-    /// List plugins.
-    ///
-    /// Returns information about installed plugins.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin ls
-    ///
-    /// HTTP GET /plugins
-    ///
-    /// 200 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<IList<Plugin>> ListPluginsAsync(PluginListParameters? parameters = null, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Get plugin privileges.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin privileges
-    ///
-    /// HTTP POST /plugins/privileges
-    ///
-    /// 200 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<IList<PluginPrivilege>> GetPrivilegesAsync(PluginGetPrivilegeParameters parameters, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Install a plugin.
-    ///
-    /// Pulls and installs a plugin. After the plugin is installed, it can be enabled using the `POST /plugins/{name}/enable` endpoint.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin pull
-    ///
-    /// HTTP POST /plugins/pull
-    ///
-    /// 204 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task InstallPluginAsync(PluginInstallParameters parameters, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Inspect a plugin.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin inspect
-    ///
-    /// HTTP GET /plugins/{name}/json
-    ///
-    /// 200 - No error.
-    /// 404 - Plugin not installed.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<Plugin> InspectPluginAsync(string name, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Remove a plugin.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin rm
-    ///
-    /// HTTP DELETE /plugins/{name}
-    ///
-    /// 200 - No error.
-    /// 404 - Plugin not installed.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task RemovePluginAsync(string name, PluginRemoveParameters? parameters = null, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Enable a plugin.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin enable
-    ///
-    /// HTTP POST /plugins/{name}/enable
-    ///
-    /// 200 - No error.
-    /// 404 - Plugin not installed.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task EnablePluginAsync(string name, PluginEnableParameters? parameters = null, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Disable a plugin.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin disable
-    ///
-    /// HTTP POST /plugins/{name}/disable
-    ///
-    /// 200 - No error.
-    /// 404 - Plugin not installed.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task DisablePluginAsync(string name, PluginDisableParameters? parameters = null, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Upgrade a plugin.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin upgrade
-    ///
-    /// HTTP POST /plugins/{name}/upgrade
-    ///
-    /// 200 - No error.
-    /// 404 - Plugin not installed.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task UpgradePluginAsync(string name, PluginUpgradeParameters parameters, CancellationToken cancellationToken = default);
-
     /// <summary>
     /// Create a plugin.
     /// </summary>
@@ -141,38 +15,4 @@ public interface IPluginOperations
     /// </remarks>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
     Task CreatePluginAsync(PluginCreateParameters parameters, Stream plugin, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Push a plugin.
-    ///
-    /// Push a plugin to the registry.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin push
-    ///
-    /// HTTP POST /plugins/{name}/push
-    ///
-    /// 200 - No error.
-    /// 404 - Plugin not installed.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task PushPluginAsync(string name, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Configure a plugin.
-    /// </summary>
-    /// <remarks>
-    /// docker plugin set
-    ///
-    /// HTTP POST /plugins/{name}/set
-    ///
-    /// 204 - No error.
-    /// 404 - Plugin not installed.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="name">The name of the plugin. The `:latest` tag is optional, and is the default if omitted.</param>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task ConfigurePluginAsync(string name, PluginConfigureParameters parameters, CancellationToken cancellationToken = default);
 }
\ No newline at end of file
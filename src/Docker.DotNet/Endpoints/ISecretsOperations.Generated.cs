@@ -1,51 +1,70 @@
 namespace Docker.DotNet;
 
-public interface ISecretsOperations
+public partial interface ISecretsOperations
 {
     /// <summary>
     /// List secrets
     /// </summary>
     /// <remarks>
+    /// HTTP GET /secrets
+    ///
     /// 200 - No error.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task<IList<Secret>> ListAsync(CancellationToken cancellationToken = default);
 
     /// <summary>
-    /// Create a secret
+    /// Inspect a secret
     /// </summary>
     /// <remarks>
-    /// 201 - No error.
-    /// 406 - Server error or node is not part of a swarm.
-    /// 409 - Name conflicts with an existing object.
+    /// HTTP GET /secrets/{id}
+    ///
+    /// 200 - No error.
+    /// 404 - Secret not found.
+    /// 406 - Node is not part of a swarm.
     /// 500 - Server error.
     /// </remarks>
+    /// <param name="id">ID of the secret.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<SecretCreateResponse> CreateAsync(SwarmSecretSpec body, CancellationToken cancellationToken = default);
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<Secret> InspectAsync(string id, CancellationToken cancellationToken = default);
 
     /// <summary>
-    /// Inspect a secret
+    /// Create a secret
     /// </summary>
     /// <remarks>
-    /// 200 - No error.
-    /// 404 - Secret not found.
-    /// 406 - Node is not part of a swarm.
+    /// HTTP POST /secrets/create
+    ///
+    /// 201 - No error.
+    /// 406 - Server error or node is not part of a swarm.
+    /// 409 - Name conflicts with an existing object.
     /// 500 - Server error.
     /// </remarks>
-    /// <param name="id">ID of the secret.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<Secret> InspectAsync(string id, CancellationToken cancellationToken = default);
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<SecretCreateResponse> CreateAsync(SwarmSecretSpec body, CancellationToken cancellationToken = default);
 
     /// <summary>
     /// Delete a secret
     /// </summary>
     /// <remarks>
+    /// HTTP DELETE /secrets/{id}
+    ///
     /// 204 - No error.
     /// 404 - Secret not found.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="id">ID of the secret.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task DeleteAsync(string id, CancellationToken cancellationToken = default);
-}
\ No newline at end of file
+}
@@ -0,0 +1,104 @@
+namespace Docker.DotNet;
+
+public partial interface ISystemOperations
+{
+    /// <summary>
+    /// Ping.
+    ///
+    /// This is a dummy endpoint you can use to test if the server is accessible.
+    /// </summary>
+    /// <remarks>
+    /// HTTP GET /_ping
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task PingAsync(CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Monitor events.
+    ///
+    /// Stream real-time events from the server.
+    ///
+    /// Various objects within Docker report events when something happens to them.
+    ///
+    /// Containers report these events: {attach, commit, copy, create, destroy, detach, die, exec_create, exec_detach, exec_start, export, kill, oom, pause, rename, resize, restart, start, stop, top, unpause, update}
+    ///
+    /// Images report these events: {delete, import, load, pull, push, save, tag, untag}
+    ///
+    /// Volumes report these events: {create, mount, unmount, destroy}
+    ///
+    /// Networks report these events: {create, connect, disconnect, destroy}
+    ///
+    /// The Docker daemon reports these events: {reload}
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker events</c>.
+    ///
+    /// HTTP GET /events
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="progress">Provides a callback to trigger whenever an event is received.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task MonitorEventsAsync(ContainerEventsParameters parameters, IProgress<Message> progress, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Get system information.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker info</c>.
+    ///
+    /// HTTP GET /info
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<SystemInfoResponse> GetSystemInfoAsync(CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Get data usage information
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker system df</c>.
+    ///
+    /// HTTP GET /system/df
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="parameters">Specifics of how to perform the operation.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<SystemDataUsageInfoResponse> GetDataUsageInfoAsync(SytemDataUsageInfoParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Get version.
+    ///
+    /// Returns the version of Docker that is running and various information about the system that Docker is running on.
+    /// </summary>
+    /// <remarks>
+    /// The corresponding command in the Docker CLI is <c>docker version</c>.
+    ///
+    /// HTTP GET /version
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<VersionResponse> GetVersionAsync(CancellationToken cancellationToken = default);
+}
@@ -1,6 +1,6 @@
 namespace Docker.DotNet;
 
-public interface ISystemOperations
+public partial interface ISystemOperations
 {
     /// <summary>
     /// Check auth configuration.
@@ -14,69 +14,6 @@ public interface ISystemOperations
     /// </remarks>
     Task AuthenticateAsync(AuthConfig authConfig, CancellationToken cancellationToken = default);
 
-    /// <summary>
-    /// Get version.
-    /// 
-    /// Returns the version of Docker that is running and various information about the system that Docker is running on.
-    /// </summary>
-    /// <remarks>
-    /// docker version
-    ///
-    /// 200 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    Task<VersionResponse> GetVersionAsync(CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Ping.
-    /// 
-    /// This is a dummy endpoint you can use to test if the server is accessible.
-    /// </summary>
-    /// <remarks>
-    /// 200 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    Task PingAsync(CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Get system information.
-    /// </summary>
-    /// <remarks>
-    /// docker info
-    ///
-    /// 200 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    Task<SystemInfoResponse> GetSystemInfoAsync(CancellationToken cancellationToken = default);
-
     [Obsolete("Use 'Task MonitorEventsAsync(ContainerEventsParameters parameters, IProgress<Message> progress, CancellationToken cancellationToken)'")]
     Task<Stream> MonitorEventsAsync(ContainerEventsParameters parameters, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Monitor events.
-    /// 
-    /// Stream real-time events from the server.
-    ///
-    /// Various objects within Docker report events when something happens to them.
-    ///
-    /// Containers report these events: {attach, commit, copy, create, destroy, detach, die, exec_create, exec_detach, exec_start, export, kill, oom, pause, rename, resize, restart, start, stop, top, unpause, update}
-    ///
-    /// Images report these events: {delete, import, load, pull, push, save, tag, untag}
-    ///
-    /// Volumes report these events: {create, mount, unmount, destroy}
-    ///
-    /// Networks report these events: {create, connect, disconnect, destroy}
-    ///
-    /// The Docker daemon reports these events: {reload}
-    /// </summary>
-    /// <remarks>
-    /// 200 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    Task MonitorEventsAsync(ContainerEventsParameters parameters, IProgress<Message> progress, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Get data usage information
-    /// </summary>
-    Task<SystemDataUsageInfoResponse> GetDataUsageInfoAsync(SytemDataUsageInfoParameters? parameters = null, CancellationToken cancellationToken = default);
 }
\ No newline at end of file
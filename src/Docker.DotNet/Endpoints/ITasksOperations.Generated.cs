@@ -0,0 +1,49 @@
+namespace Docker.DotNet;
+
+public partial interface ITasksOperations
+{
+    /// <summary>
+    /// List tasks
+    /// </summary>
+    /// <remarks>
+    /// HTTP GET /tasks
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<TaskResponse>> ListAsync(CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// List tasks
+    /// </summary>
+    /// <remarks>
+    /// HTTP GET /tasks
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<IList<TaskResponse>> ListAsync(TasksListParameters? parameters = null, CancellationToken cancellationToken = default);
+
+    /// <summary>
+    /// Inspect a task
+    /// </summary>
+    /// <remarks>
+    /// HTTP GET /tasks/{id}
+    ///
+    /// 200 - No error.
+    /// 404 - No such task.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="id">ID of the task.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<TaskResponse> InspectAsync(string id, CancellationToken cancellationToken = default);
+}
@@ -1,48 +1,80 @@
 namespace Docker.DotNet;
 
-public interface IVolumeOperations
+public partial interface IVolumeOperations
 {
     /// <summary>
     /// List volumes
     /// </summary>
     /// <remarks>
+    /// HTTP GET /volumes
+    ///
     /// 200 - No error.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task<VolumesListResponse> ListAsync(CancellationToken cancellationToken = default);
 
     /// <summary>
     /// List volumes
     /// </summary>
     /// <remarks>
+    /// HTTP GET /volumes
+    ///
     /// 200 - No error.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task<VolumesListResponse> ListAsync(VolumesListParameters? parameters = null, CancellationToken cancellationToken = default);
 
     /// <summary>
     /// Create a volume.
     /// </summary>
     /// <remarks>
+    /// HTTP POST /volumes/create
+    ///
     /// 201 - The volume was created successfully.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="parameters">Volume parameters to create.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task<VolumeResponse> CreateAsync(VolumesCreateParameters parameters, CancellationToken cancellationToken = default);
 
+    /// <summary>
+    /// Delete unused volumes.
+    /// </summary>
+    /// <remarks>
+    /// HTTP POST /volumes/prune
+    ///
+    /// 200 - No error.
+    /// 500 - Server error.
+    /// </remarks>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
+    Task<VolumesPruneResponse> PruneAsync(VolumesPruneParameters? parameters = null, CancellationToken cancellationToken = default);
+
     /// <summary>
     /// Inspect a volume.
     /// </summary>
     /// <remarks>
+    /// HTTP GET /volumes/{name}
+    ///
     /// 200 - No error.
     /// 404 - No such volume.
     /// 500 - Server error.
     /// </remarks>
     /// <param name="name">Volume name or ID.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task<VolumeResponse> InspectAsync(string name, CancellationToken cancellationToken = default);
 
     /// <summary>
@@ -51,6 +83,8 @@ public interface IVolumeOperations
     /// Instruct the driver to remove the volume.
     /// </summary>
     /// <remarks>
+    /// HTTP DELETE /volumes/{name}
+    ///
     /// 204 - The volume was removed.
     /// 404 - No such volume or volume driver.
     /// 409 - Volume is in use and cannot be removed.
@@ -59,17 +93,8 @@ public interface IVolumeOperations
     /// <param name="name">Volume name or ID.</param>
     /// <param name="force">Force the removal of the volume.</param>
     /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <exception cref="ArgumentNullException">One or more of the inputs was <see langword="null"/>.</exception>
+    /// <exception cref="DockerApiException">The input is invalid or the daemon experienced an error.</exception>
+    /// <exception cref="HttpRequestException">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>
     Task RemoveAsync(string name, bool? force = null, CancellationToken cancellationToken = default);
-
-    /// <summary>
-    /// Delete unused volumes.
-    /// </summary>
-    /// <remarks>
-    /// HTTP POST /volumes/prune
-    ///
-    /// 200 - No error.
-    /// 500 - Server error.
-    /// </remarks>
-    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
-    Task<VolumesPruneResponse> PruneAsync(VolumesPruneParameters? parameters = null, CancellationToken cancellationToken = default);
-}
\ No newline at end of file
+}
@@ -0,0 +1,101 @@
+namespace Docker.DotNet;
+
+internal partial class ImageOperations : IImageOperations
+{
+    private static readonly ApiResponseErrorHandlingDelegate NoSuchImageHandler = (statusCode, responseBody) =>
+    {
+        if (statusCode == HttpStatusCode.NotFound)
+        {
+            throw new DockerImageNotFoundException(statusCode, responseBody);
+        }
+    };
+
+    public async Task<CommitContainerChangesResponse> CommitContainerChangesAsync(CommitContainerChangesParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        var queryParameters = new QueryString<CommitContainerChangesParameters>(parameters);
+
+        var data = new JsonRequestContent<CommitContainerChangesParameters>(parameters, DockerClient.JsonSerializer);
+
+        return await _client.MakeRequestAsync<CommitContainerChangesResponse>(_client.NoErrorHandlers, HttpMethod.Post, "commit", queryParameters, data, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<IList<ImagesListResponse>> ListImagesAsync(ImagesListParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        var queryParameters = new QueryString<ImagesListParameters>(parameters);
+
+        return await _client.MakeRequestAsync<ImagesListResponse[]>(_client.NoErrorHandlers, HttpMethod.Get, "images/json", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<ImagesPruneResponse> PruneImagesAsync(ImagesPruneParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        var queryParameters = parameters == null ? null : new QueryString<ImagesPruneParameters>(parameters);
+
+        return await _client.MakeRequestAsync<ImagesPruneResponse>(_client.NoErrorHandlers, HttpMethod.Post, "images/prune", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<IList<ImageSearchResponse>> SearchImagesAsync(ImagesSearchParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        var queryParameters = new QueryString<ImagesSearchParameters>(parameters);
+
+        return await _client.MakeRequestAsync<ImageSearchResponse[]>(_client.NoErrorHandlers, HttpMethod.Get, "images/search", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<IList<ImageHistoryResponse>> GetImageHistoryAsync(string name, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        return await _client.MakeRequestAsync<ImageHistoryResponse[]>([NoSuchImageHandler], HttpMethod.Get, $"images/{name}/history", cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<ImageInspectResponse> InspectImageAsync(string name, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        return await _client.MakeRequestAsync<ImageInspectResponse>([NoSuchImageHandler], HttpMethod.Get, $"images/{name}/json", cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task TagImageAsync(string name, ImageTagParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        var queryParameters = new QueryString<ImageTagParameters>(parameters);
+
+        await _client.MakeRequestAsync([NoSuchImageHandler], HttpMethod.Post, $"images/{name}/tag", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+}
@@ -1,6 +1,6 @@
 namespace Docker.DotNet;
 
-internal class ImageOperations : IImageOperations
+internal partial class ImageOperations : IImageOperations
 {
     private const string RegistryAuthHeaderKey = "X-Registry-Auth";
 
@@ -10,14 +10,6 @@ internal class ImageOperations : IImageOperations
 
     private const string ImportFromBodySource = "-";
 
-    private static readonly ApiResponseErrorHandlingDelegate NoSuchImageHandler = (statusCode, responseBody) =>
-    {
-        if (statusCode == HttpStatusCode.NotFound)
-        {
-            throw new DockerImageNotFoundException(statusCode, responseBody);
-        }
-    };
-
     private readonly DockerClient _client;
 
     internal ImageOperations(DockerClient client)
@@ -25,19 +17,6 @@ internal class ImageOperations : IImageOperations
         _client = client;
     }
 
-    public async Task<IList<ImagesListResponse>> ListImagesAsync(ImagesListParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ImagesListParameters>(parameters);
-
-        return await _client.MakeRequestAsync<ImagesListResponse[]>(_client.NoErrorHandlers, HttpMethod.Get, "images/json", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public async Task<Stream> BuildImageFromDockerfileAsync(Stream contents, ImageBuildParameters parameters, CancellationToken cancellationToken = default)
     {
         if (contents == null)
@@ -54,11 +33,13 @@ internal class ImageOperations : IImageOperations
 
         var data = new BinaryRequestContent(contents, TarContentType);
 
-        return await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, "build", queryParameters, data, cancellationToken)
+        var customHeaders = new RequestHeaders<ImageBuildParameters>(parameters).GetHeaders();
+
+        return await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, "build", queryParameters, data, customHeaders, cancellationToken)
             .ConfigureAwait(false);
     }
 
-    public Task BuildImageFromDockerfileAsync(ImageBuildParameters parameters, Stream contents, IEnumerable<AuthConfig>? authConfigs, IDictionary<string, string>? headers, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
+    public async Task BuildImageFromDockerfileAsync(ImageBuildParameters parameters, Stream contents, IEnumerable<AuthConfig>? authConfigs, IDictionary<string, string>? headers, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
     {
         if (contents == null)
         {
@@ -74,7 +55,13 @@ internal class ImageOperations : IImageOperations
 
         var data = new BinaryRequestContent(contents, TarContentType);
 
-        var customHeaders = RegistryConfigHeaders(authConfigs);
+        var customHeaders = new RequestHeaders<ImageBuildParameters>(parameters).GetHeaders();
+
+        // An explicitly passed authConfigs argument takes precedence over ImageBuildParameters.AuthConfigs.
+        if (authConfigs != null || !customHeaders.ContainsKey(RegistryConfigHeaderKey))
+        {
+            customHeaders[RegistryConfigHeaderKey] = RegistryConfigHeaderValue(authConfigs);
+        }
 
         if (headers != null)
         {
@@ -84,10 +71,13 @@ internal class ImageOperations : IImageOperations
             }
         }
 
-        return StreamUtil.MonitorResponseForMessagesAsync(
-            _client.MakeRequestForRawResponseAsync(HttpMethod.Post, "build", queryParameters, data, customHeaders, cancellationToken),
-            progress,
-            cancellationToken);
+        using var stream = await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, "build", queryParameters, data, customHeaders, cancellationToken)
+            .ConfigureAwait(false);
+
+        await foreach (var message in MessageStreams.ReadBuildImageMessagesAsync(stream, cancellationToken).ConfigureAwait(false))
+        {
+            progress.Report(message);
+        }
     }
 
     public Task CreateImageAsync(ImagesCreateParameters parameters, AuthConfig? authConfig, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
@@ -105,7 +95,7 @@ internal class ImageOperations : IImageOperations
         return CreateImageAsync(parameters, imageStream, authConfig, null, progress, cancellationToken);
     }
 
-    public Task CreateImageAsync(ImagesCreateParameters parameters, Stream? imageStream, AuthConfig? authConfig, IDictionary<string, string>? headers, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
+    public async Task CreateImageAsync(ImagesCreateParameters parameters, Stream? imageStream, AuthConfig? authConfig, IDictionary<string, string>? headers, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
     {
         if (parameters == null)
         {
@@ -121,7 +111,7 @@ internal class ImageOperations : IImageOperations
 
         var queryParameters = new QueryString<ImagesCreateParameters>(parameters);
 
-        var customHeaders = RegistryAuthHeaders(authConfig);
+        var customHeaders = RegistryAuthHeaders(new RequestHeaders<ImagesCreateParameters>(parameters).GetHeaders(), authConfig);
 
         if (headers != null)
         {
@@ -131,35 +121,16 @@ internal class ImageOperations : IImageOperations
             }
         }
 
-        return StreamUtil.MonitorResponseForMessagesAsync(
-            _client.MakeRequestForRawResponseAsync(HttpMethod.Post, "images/create", queryParameters, content, customHeaders, cancellationToken),
-            progress,
-            cancellationToken);
-    }
-
-    public async Task<ImageInspectResponse> InspectImageAsync(string name, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(name))
-        {
-            throw new ArgumentNullException(nameof(name));
-        }
-
-        return await _client.MakeRequestAsync<ImageInspectResponse>([NoSuchImageHandler], HttpMethod.Get, $"images/{name}/json", cancellationToken)
+        using var stream = await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, "images/create", queryParameters, content, customHeaders, cancellationToken)
             .ConfigureAwait(false);
-    }
 
-    public async Task<IList<ImageHistoryResponse>> GetImageHistoryAsync(string name, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(name))
+        await foreach (var message in MessageStreams.ReadCreateImageMessagesAsync(stream, cancellationToken).ConfigureAwait(false))
         {
-            throw new ArgumentNullException(nameof(name));
+            progress.Report(message);
         }
-
-        return await _client.MakeRequestAsync<ImageHistoryResponse[]>([NoSuchImageHandler], HttpMethod.Get, $"images/{name}/history", cancellationToken)
-            .ConfigureAwait(false);
     }
 
-    public Task PushImageAsync(string name, ImagePushParameters parameters, AuthConfig? authConfig, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
+    public async Task PushImageAsync(string name, ImagePushParameters parameters, AuthConfig? authConfig, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
     {
         if (string.IsNullOrEmpty(name))
         {
@@ -173,28 +144,15 @@ internal class ImageOperations : IImageOperations
 
         var queryParameters = new QueryString<ImagePushParameters>(parameters);
 
-        return StreamUtil.MonitorStreamForMessagesAsync(
-            _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, $"images/{name}/push", queryParameters, null, RegistryAuthHeaders(authConfig), cancellationToken),
-            progress,
-            cancellationToken);
-    }
+        var headers = RegistryAuthHeaders(new RequestHeaders<ImagePushParameters>(parameters).GetHeaders(), authConfig);
 
-    public async Task TagImageAsync(string name, ImageTagParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (string.IsNullOrEmpty(name))
-        {
-            throw new ArgumentNullException(nameof(name));
-        }
+        using var stream = await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, $"images/{name}/push", queryParameters, null, headers, cancellationToken)
+            .ConfigureAwait(false);
 
-        if (parameters == null)
+        await foreach (var message in MessageStreams.ReadPushImageMessagesAsync(stream, cancellationToken).ConfigureAwait(false))
         {
-            throw new ArgumentNullException(nameof(parameters));
+            progress.Report(message);
         }
-
-        var queryParameters = new QueryString<ImageTagParameters>(parameters);
-
-        await _client.MakeRequestAsync([NoSuchImageHandler], HttpMethod.Post, $"images/{name}/tag", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
     }
 
     public async Task<IList<IDictionary<string, string>>> DeleteImageAsync(string name, ImageDeleteParameters parameters, CancellationToken cancellationToken = default)
@@ -215,42 +173,6 @@ internal class ImageOperations : IImageOperations
             .ConfigureAwait(false);
     }
 
-    public async Task<IList<ImageSearchResponse>> SearchImagesAsync(ImagesSearchParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<ImagesSearchParameters>(parameters);
-
-        return await _client.MakeRequestAsync<ImageSearchResponse[]>(_client.NoErrorHandlers, HttpMethod.Get, "images/search", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task<ImagesPruneResponse> PruneImagesAsync(ImagesPruneParameters? parameters = null, CancellationToken cancellationToken = default)
-    {
-        var queryParameters = parameters == null ? null : new QueryString<ImagesPruneParameters>(parameters);
-
-        return await _client.MakeRequestAsync<ImagesPruneResponse>(_client.NoErrorHandlers, HttpMethod.Post, "images/prune", queryParameters, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
-    public async Task<CommitContainerChangesResponse> CommitContainerChangesAsync(CommitContainerChangesParameters parameters, CancellationToken cancellationToken = default)
-    {
-        if (parameters == null)
-        {
-            throw new ArgumentNullException(nameof(parameters));
-        }
-
-        var queryParameters = new QueryString<CommitContainerChangesParameters>(parameters);
-
-        var data = new JsonRequestContent<CommitContainerChangesParameters>(parameters, DockerClient.JsonSerializer);
-
-        return await _client.MakeRequestAsync<CommitContainerChangesResponse>(_client.NoErrorHandlers, HttpMethod.Post, "commit", queryParameters, data, cancellationToken)
-            .ConfigureAwait(false);
-    }
-
     public Task<Stream> SaveImageAsync(string name, CancellationToken cancellationToken = default)
     {
         return SaveImagesAsync([name], cancellationToken);
@@ -269,7 +191,7 @@ internal class ImageOperations : IImageOperations
             .ConfigureAwait(false);
     }
 
-    public Task LoadImageAsync(ImageLoadParameters parameters, Stream imageStream, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
+    public async Task LoadImageAsync(ImageLoadParameters parameters, Stream imageStream, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
     {
         if (parameters == null)
         {
@@ -285,27 +207,30 @@ internal class ImageOperations : IImageOperations
 
         var queryParameters = new QueryString<ImageLoadParameters>(parameters);
 
-        return StreamUtil.MonitorStreamForMessagesAsync(
-            _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, "images/load", queryParameters, content, cancellationToken),
-            progress,
-            cancellationToken);
+        using var stream = await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, "images/load", queryParameters, content, cancellationToken)
+            .ConfigureAwait(false);
+
+        await foreach (var message in MessageStreams.ReadLoadImageMessagesAsync(stream, cancellationToken).ConfigureAwait(false))
+        {
+            progress.Report(message);
+        }
     }
 
-    private static Dictionary<string, string> RegistryAuthHeaders(AuthConfig? authConfig)
+    private static Dictionary<string, string> RegistryAuthHeaders(Dictionary<string, string> headers, AuthConfig? authConfig)
     {
-        return new Dictionary<string, string>
+        // An explicitly passed authConfig argument takes precedence over the parameters' RegistryAuth.
+        if (authConfig != null || !headers.ContainsKey(RegistryAuthHeaderKey))
         {
-            {
-                RegistryAuthHeaderKey,
-                Convert.ToBase64String(DockerClient.JsonSerializer.SerializeToUtf8Bytes(authConfig ?? new AuthConfig())).Replace("/", "_").Replace("+", "-")
-                // This is not documented in Docker API but from source code (https://github.com/docker/docker-ce/blob/10e40bd1548f69354a803a15fde1b672cc024b91/components/cli/cli/command/registry.go#L47)
-                // and from multiple internet sources it has to be base64-url-safe.
-                // See RFC 4648 Section 5. Padding (=) needs to be kept.
-            }
-        };
+            // This is not documented in Docker API but from source code (https://github.com/docker/docker-ce/blob/10e40bd1548f69354a803a15fde1b672cc024b91/components/cli/cli/command/registry.go#L47)
+            // and from multiple internet sources it has to be base64-url-safe.
+            // See RFC 4648 Section 5. Padding (=) needs to be kept.
+            headers[RegistryAuthHeaderKey] = Convert.ToBase64String(DockerClient.JsonSerializer.SerializeToUtf8Bytes(authConfig ?? new AuthConfig())).Replace("/", "_").Replace("+", "-");
+        }
+
+        return headers;
     }
 
-    private static Dictionary<string, string> RegistryConfigHeaders(IEnumerable<AuthConfig>? authConfigs)
+    private static string RegistryConfigHeaderValue(IEnumerable<AuthConfig>? authConfigs)
     {
         var registryAuthConfigurations = new Dictionary<string, AuthConfig>();
 
@@ -319,12 +244,6 @@ internal class ImageOperations : IImageOperations
             }
         }
 
-        return new Dictionary<string, string>
-        {
-            {
-                RegistryConfigHeaderKey,
-                Convert.ToBase64String(DockerClient.JsonSerializer.SerializeToUtf8Bytes(registryAuthConfigurations))
-            }
-        };
+        return Convert.ToBase64String(DockerClient.JsonSerializer.SerializeToUtf8Bytes(registryAuthConfigurations));
     }
 }
\ No newline at end of file
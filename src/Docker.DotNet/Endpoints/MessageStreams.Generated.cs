@@ -0,0 +1,119 @@
+namespace Docker.DotNet;
+
+/// <summary>
+/// Reads the newline-delimited JSON messages of the streaming Docker Engine API routes from their response body.
+/// </summary>
+public static class MessageStreams
+{
+    /// <summary>
+    /// Reads the <see cref="JSONMessage"/> messages of <c>POST /build</c>.
+    /// </summary>
+    /// <param name="stream">The response body.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>
+    public static IAsyncEnumerable<JSONMessage> ReadBuildImageMessagesAsync(Stream stream, CancellationToken cancellationToken = default)
+    {
+        if (stream == null)
+        {
+            throw new ArgumentNullException(nameof(stream));
+        }
+
+        return DockerClient.JsonSerializer.DeserializeAsync<JSONMessage>(stream, cancellationToken);
+    }
+
+    /// <summary>
+    /// Reads the <see cref="ContainerStatsResponse"/> messages of <c>GET /containers/{id}/stats</c>.
+    /// </summary>
+    /// <param name="stream">The response body.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>
+    public static IAsyncEnumerable<ContainerStatsResponse> ReadContainerStatsAsync(Stream stream, CancellationToken cancellationToken = default)
+    {
+        if (stream == null)
+        {
+            throw new ArgumentNullException(nameof(stream));
+        }
+
+        return DockerClient.JsonSerializer.DeserializeAsync<ContainerStatsResponse>(stream, cancellationToken);
+    }
+
+    /// <summary>
+    /// Reads the <see cref="Message"/> messages of <c>GET /events</c>.
+    /// </summary>
+    /// <param name="stream">The response body.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>
+    public static IAsyncEnumerable<Message> ReadEventsAsync(Stream stream, CancellationToken cancellationToken = default)
+    {
+        if (stream == null)
+        {
+            throw new ArgumentNullException(nameof(stream));
+        }
+
+        return DockerClient.JsonSerializer.DeserializeAsync<Message>(stream, cancellationToken);
+    }
+
+    /// <summary>
+    /// Reads the <see cref="JSONMessage"/> messages of <c>POST /images/create</c>.
+    /// </summary>
+    /// <param name="stream">The response body.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>
+    public static IAsyncEnumerable<JSONMessage> ReadCreateImageMessagesAsync(Stream stream, CancellationToken cancellationToken = default)
+    {
+        if (stream == null)
+        {
+            throw new ArgumentNullException(nameof(stream));
+        }
+
+        return DockerClient.JsonSerializer.DeserializeAsync<JSONMessage>(stream, cancellationToken);
+    }
+
+    /// <summary>
+    /// Reads the <see cref="JSONMessage"/> messages of <c>POST /images/load</c>.
+    /// </summary>
+    /// <param name="stream">The response body.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>
+    public static IAsyncEnumerable<JSONMessage> ReadLoadImageMessagesAsync(Stream stream, CancellationToken cancellationToken = default)
+    {
+        if (stream == null)
+        {
+            throw new ArgumentNullException(nameof(stream));
+        }
+
+        return DockerClient.JsonSerializer.DeserializeAsync<JSONMessage>(stream, cancellationToken);
+    }
+
+    /// <summary>
+    /// Reads the <see cref="JSONMessage"/> messages of <c>POST /images/{name}/push</c>.
+    /// </summary>
+    /// <param name="stream">The response body.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>
+    public static IAsyncEnumerable<JSONMessage> ReadPushImageMessagesAsync(Stream stream, CancellationToken cancellationToken = default)
+    {
+        if (stream == null)
+        {
+            throw new ArgumentNullException(nameof(stream));
+        }
+
+        return DockerClient.JsonSerializer.DeserializeAsync<JSONMessage>(stream, cancellationToken);
+    }
+
+    /// <summary>
+    /// Reads the <see cref="JSONMessage"/> messages of <c>POST /plugins/pull</c>.
+    /// </summary>
+    /// <param name="stream">The response body.</param>
+    /// <param name="cancellationToken">When triggered, the operation will stop at the next available time, if possible.</param>
+    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>
+    public static IAsyncEnumerable<JSONMessage> ReadInstallPluginMessagesAsync(Stream stream, CancellationToken cancellationToken = default)
+    {
+        if (stream == null)
+        {
+            throw new ArgumentNullException(nameof(stream));
+        }
+
+        return DockerClient.JsonSerializer.DeserializeAsync<JSONMessage>(stream, cancellationToken);
+    }
+}
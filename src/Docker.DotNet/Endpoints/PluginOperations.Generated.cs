@@ -0,0 +1,176 @@
+namespace Docker.DotNet;
+
+internal partial class PluginOperations : IPluginOperations
+{
+    private static readonly ApiResponseErrorHandlingDelegate NoSuchPluginHandler = (statusCode, responseBody) =>
+    {
+        if (statusCode == HttpStatusCode.NotFound)
+        {
+            throw new DockerPluginNotFoundException(statusCode, responseBody);
+        }
+    };
+
+    public async Task<IList<Plugin>> ListPluginsAsync(PluginListParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        var queryParameters = parameters == null ? null : new QueryString<PluginListParameters>(parameters);
+
+        return await _client.MakeRequestAsync<Plugin[]>(_client.NoErrorHandlers, HttpMethod.Get, "plugins", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<IList<PluginPrivilege>> GetPrivilegesAsync(PluginGetPrivilegeParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        var queryParameters = new QueryString<PluginGetPrivilegeParameters>(parameters);
+
+        return await _client.MakeRequestAsync<PluginPrivilege[]>(_client.NoErrorHandlers, HttpMethod.Get, "plugins/privileges", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task InstallPluginAsync(PluginInstallParameters parameters, IProgress<JSONMessage> progress, CancellationToken cancellationToken = default)
+    {
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        if (parameters.Privileges == null)
+        {
+            throw new ArgumentNullException(nameof(parameters.Privileges));
+        }
+
+        if (progress == null)
+        {
+            throw new ArgumentNullException(nameof(progress));
+        }
+
+        var queryParameters = new QueryString<PluginInstallParameters>(parameters);
+
+        var data = new JsonRequestContent<IList<PluginPrivilege>>(parameters.Privileges, DockerClient.JsonSerializer);
+
+        var headers = new RequestHeaders<PluginInstallParameters>(parameters).GetHeaders();
+
+        using var stream = await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Post, "plugins/pull", queryParameters, data, headers, cancellationToken)
+            .ConfigureAwait(false);
+
+        await foreach (var message in MessageStreams.ReadInstallPluginMessagesAsync(stream, cancellationToken).ConfigureAwait(false))
+        {
+            progress.Report(message);
+        }
+    }
+
+    public async Task<Plugin> InspectPluginAsync(string name, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        return await _client.MakeRequestAsync<Plugin>([NoSuchPluginHandler], HttpMethod.Get, $"plugins/{name}/json", cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task RemovePluginAsync(string name, PluginRemoveParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        var queryParameters = parameters == null ? null : new QueryString<PluginRemoveParameters>(parameters);
+
+        await _client.MakeRequestAsync([NoSuchPluginHandler], HttpMethod.Delete, $"plugins/{name}", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task EnablePluginAsync(string name, PluginEnableParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        var queryParameters = parameters == null ? null : new QueryString<PluginEnableParameters>(parameters);
+
+        await _client.MakeRequestAsync([NoSuchPluginHandler], HttpMethod.Post, $"plugins/{name}/enable", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task DisablePluginAsync(string name, PluginDisableParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        var queryParameters = parameters == null ? null : new QueryString<PluginDisableParameters>(parameters);
+
+        await _client.MakeRequestAsync([NoSuchPluginHandler], HttpMethod.Post, $"plugins/{name}/disable", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task UpgradePluginAsync(string name, PluginUpgradeParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        if (parameters.Privileges == null)
+        {
+            throw new ArgumentNullException(nameof(parameters.Privileges));
+        }
+
+        var queryParameters = new QueryString<PluginUpgradeParameters>(parameters);
+
+        var data = new JsonRequestContent<IList<PluginPrivilege>>(parameters.Privileges, DockerClient.JsonSerializer);
+
+        var headers = new RequestHeaders<PluginUpgradeParameters>(parameters).GetHeaders();
+
+        await _client.MakeRequestAsync([NoSuchPluginHandler], HttpMethod.Post, $"plugins/{name}/upgrade", queryParameters, data, headers, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task PushPluginAsync(string name, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        await _client.MakeRequestAsync([NoSuchPluginHandler], HttpMethod.Post, $"plugins/{name}/push", cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task ConfigurePluginAsync(string name, PluginConfigureParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        if (parameters.Args == null)
+        {
+            throw new ArgumentNullException(nameof(parameters.Args));
+        }
+
+        var data = new JsonRequestContent<IList<string>>(parameters.Args, DockerClient.JsonSerializer);
+
+        await _client.MakeRequestAsync([NoSuchPluginHandler], HttpMethod.Post, $"plugins/{name}/set", null, data, cancellationToken)
+            .ConfigureAwait(false);
+    }
+}
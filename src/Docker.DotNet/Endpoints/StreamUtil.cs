@@ -63,30 +63,4 @@ internal static class StreamUtil
             memoryStream.SetLength(remainingBytes);
         }
     }
-
-    internal static async Task MonitorStreamForMessagesAsync<T>(Task<StandardStreamResponse> responseTask, IProgress<T> progress, CancellationToken cancellationToken)
-    {
-        using var response = await responseTask
-            .ConfigureAwait(false);
-
-        var asyncEnumerable = DockerClient.JsonSerializer.DeserializeAsync<T>(response, cancellationToken)
-            .ConfigureAwait(false);
-
-        await foreach (var value in asyncEnumerable)
-        {
-            progress.Report(value);
-        }
-    }
-
-    internal static async Task MonitorResponseForMessagesAsync<T>(Task<HttpResponseMessage> responseTask, IProgress<T> progress, CancellationToken cancellationToken)
-    {
-        using var response = await responseTask
-            .ConfigureAwait(false);
-
-        var stream = await response.Content.ReadAsStreamAsync()
-            .ConfigureAwait(false);
-
-        await MonitorStreamForMessagesAsync(Task.FromResult(new StandardStreamResponse(response, stream)), progress, cancellationToken)
-            .ConfigureAwait(false);
-    }
 }
\ No newline at end of file
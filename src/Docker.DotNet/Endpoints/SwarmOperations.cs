@@ -31,7 +31,9 @@ internal class SwarmOperations : ISwarmOperations
 
         var data = new JsonRequestContent<ServiceSpec>(parameters.Service, DockerClient.JsonSerializer);
 
-        return await _client.MakeRequestAsync<ServiceCreateResponse>([NotInSwarmResponseHandler], HttpMethod.Post, "services/create", null, data, RegistryAuthHeaders(parameters.RegistryAuth), cancellationToken)
+        var headers = new RequestHeaders<ServiceCreateParameters>(parameters).GetHeaders();
+
+        return await _client.MakeRequestAsync<ServiceCreateResponse>([NotInSwarmResponseHandler], HttpMethod.Post, "services/create", null, data, headers, cancellationToken)
             .ConfigureAwait(false);
     }
 
@@ -125,7 +127,9 @@ internal class SwarmOperations : ISwarmOperations
 
         var data = new JsonRequestContent<ServiceSpec>(parameters.Service, DockerClient.JsonSerializer);
 
-        return await _client.MakeRequestAsync<ServiceUpdateResponse>([NotInSwarmResponseHandler], HttpMethod.Post, $"services/{id}/update", queryParameters, data, RegistryAuthHeaders(parameters.RegistryAuth), cancellationToken)
+        var headers = new RequestHeaders<ServiceUpdateParameters>(parameters).GetHeaders();
+
+        return await _client.MakeRequestAsync<ServiceUpdateResponse>([NotInSwarmResponseHandler], HttpMethod.Post, $"services/{id}/update", queryParameters, data, headers, cancellationToken)
             .ConfigureAwait(false);
     }
 
@@ -177,22 +181,6 @@ internal class SwarmOperations : ISwarmOperations
             .ConfigureAwait(false);
     }
 
-    private static Dictionary<string, string> RegistryAuthHeaders(AuthConfig? authConfig)
-    {
-        if (authConfig == null)
-        {
-            return new Dictionary<string, string>();
-        }
-
-        return new Dictionary<string, string>
-        {
-            {
-                "X-Registry-Auth",
-                Convert.ToBase64String(DockerClient.JsonSerializer.SerializeToUtf8Bytes(authConfig))
-            }
-        };
-    }
-
     public async Task<IList<NodeListResponse>> ListNodesAsync(CancellationToken cancellationToken = default)
     {
         return await _client.MakeRequestAsync<NodeListResponse[]>([NotInSwarmResponseHandler], HttpMethod.Get, "nodes", cancellationToken)
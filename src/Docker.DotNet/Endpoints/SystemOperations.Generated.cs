@@ -0,0 +1,53 @@
+namespace Docker.DotNet;
+
+internal partial class SystemOperations : ISystemOperations
+{
+    public async Task PingAsync(CancellationToken cancellationToken = default)
+    {
+        await _client.MakeRequestAsync(_client.NoErrorHandlers, HttpMethod.Get, "_ping", cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task MonitorEventsAsync(ContainerEventsParameters parameters, IProgress<Message> progress, CancellationToken cancellationToken = default)
+    {
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        if (progress == null)
+        {
+            throw new ArgumentNullException(nameof(progress));
+        }
+
+        var queryParameters = new QueryString<ContainerEventsParameters>(parameters);
+
+        using var stream = await _client.MakeRequestForStreamAsync(_client.NoErrorHandlers, HttpMethod.Get, "events", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+
+        await foreach (var message in MessageStreams.ReadEventsAsync(stream, cancellationToken).ConfigureAwait(false))
+        {
+            progress.Report(message);
+        }
+    }
+
+    public async Task<SystemInfoResponse> GetSystemInfoAsync(CancellationToken cancellationToken = default)
+    {
+        return await _client.MakeRequestAsync<SystemInfoResponse>(_client.NoErrorHandlers, HttpMethod.Get, "info", cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<SystemDataUsageInfoResponse> GetDataUsageInfoAsync(SytemDataUsageInfoParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        var queryParameters = parameters == null ? null : new QueryString<SytemDataUsageInfoParameters>(parameters);
+
+        return await _client.MakeRequestAsync<SystemDataUsageInfoResponse>(_client.NoErrorHandlers, HttpMethod.Get, "system/df", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<VersionResponse> GetVersionAsync(CancellationToken cancellationToken = default)
+    {
+        return await _client.MakeRequestAsync<VersionResponse>(_client.NoErrorHandlers, HttpMethod.Get, "version", cancellationToken)
+            .ConfigureAwait(false);
+    }
+}
@@ -0,0 +1,64 @@
+namespace Docker.DotNet;
+
+internal partial class VolumeOperations : IVolumeOperations
+{
+    public Task<VolumesListResponse> ListAsync(CancellationToken cancellationToken = default)
+    {
+        return ListAsync(null, cancellationToken);
+    }
+
+    public async Task<VolumesListResponse> ListAsync(VolumesListParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        var queryParameters = parameters == null ? null : new QueryString<VolumesListParameters>(parameters);
+
+        return await _client.MakeRequestAsync<VolumesListResponse>(_client.NoErrorHandlers, HttpMethod.Get, "volumes", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<VolumeResponse> CreateAsync(VolumesCreateParameters parameters, CancellationToken cancellationToken = default)
+    {
+        if (parameters == null)
+        {
+            throw new ArgumentNullException(nameof(parameters));
+        }
+
+        var data = new JsonRequestContent<VolumesCreateParameters>(parameters, DockerClient.JsonSerializer);
+
+        return await _client.MakeRequestAsync<VolumeResponse>(_client.NoErrorHandlers, HttpMethod.Post, "volumes/create", null, data, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<VolumesPruneResponse> PruneAsync(VolumesPruneParameters? parameters = null, CancellationToken cancellationToken = default)
+    {
+        var queryParameters = parameters == null ? null : new QueryString<VolumesPruneParameters>(parameters);
+
+        return await _client.MakeRequestAsync<VolumesPruneResponse>(_client.NoErrorHandlers, HttpMethod.Post, "volumes/prune", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task<VolumeResponse> InspectAsync(string name, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        return await _client.MakeRequestAsync<VolumeResponse>(_client.NoErrorHandlers, HttpMethod.Get, $"volumes/{name}", cancellationToken)
+            .ConfigureAwait(false);
+    }
+
+    public async Task RemoveAsync(string name, bool? force = null, CancellationToken cancellationToken = default)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        var parameters = new VolumeRemoveParameters { Force = force };
+
+        var queryParameters = new QueryString<VolumeRemoveParameters>(parameters);
+
+        await _client.MakeRequestAsync(_client.NoErrorHandlers, HttpMethod.Delete, $"volumes/{name}", queryParameters, cancellationToken)
+            .ConfigureAwait(false);
+    }
+}
@@ -0,0 +1,18 @@
+namespace Docker.DotNet;
+
+/// <summary>
+/// Sends the property value as base64url encoded JSON, e.g. for the X-Registry-Auth header.
+/// </summary>
+internal sealed class HeaderBase64JsonParameterAttribute(Type type, string name, bool required) : HeaderParameterAttribute(name, required)
+{
+    public override string Convert(object value)
+    {
+        if (!type.IsInstanceOfType(value))
+        {
+            throw new ArgumentException($"Expected value of type '{type}'.", nameof(value));
+        }
+
+        // The daemon decodes these headers with base64.URLEncoding (RFC 4648 Section 5), padding (=) needs to be kept.
+        return System.Convert.ToBase64String(Encoding.UTF8.GetBytes(JsonSerializer.Instance.Serialize(value, type))).Replace("/", "_").Replace("+", "-");
+    }
+}
@@ -0,0 +1,22 @@
+namespace Docker.DotNet;
+
+[AttributeUsage(AttributeTargets.Property)]
+internal class HeaderParameterAttribute : Attribute
+{
+    public string Name { get; private set; }
+
+    public bool IsRequired { get; private set; }
+
+    public virtual string Convert(object value) => value.ToString()!;
+
+    public HeaderParameterAttribute(string name, bool required)
+    {
+        if (string.IsNullOrEmpty(name))
+        {
+            throw new ArgumentNullException(nameof(name));
+        }
+
+        Name = name;
+        IsRequired = required;
+    }
+}
@@ -0,0 +1,23 @@
+namespace Docker.DotNet;
+
+internal sealed class JsonGoTimeConverter : JsonConverter<DateTimeOffset?>
+{
+    // Go writes the zero value of an unset time.Time as 0001-01-01T00:00:00Z.
+    private static readonly DateTimeOffset GoZeroTime = new(1, 1, 1, 0, 0, 0, TimeSpan.Zero);
+
+    public override DateTimeOffset? Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)
+    {
+        if (reader.ValueTextEquals(string.Empty))
+        {
+            return null;
+        }
+
+        var value = reader.GetDateTimeOffset();
+        return value == GoZeroTime ? null : value;
+    }
+
+    public override void Write(Utf8JsonWriter writer, DateTimeOffset? value, JsonSerializerOptions options)
+    {
+        writer.WriteStringValue(value ?? GoZeroTime);
+    }
+}
\ No newline at end of file
@@ -19,8 +19,6 @@ internal sealed class JsonSerializer
             DockerModelsJsonSerializerContext.Default,
             DockerExtendedJsonSerializerContext.Default);
         _options.DefaultIgnoreCondition = JsonIgnoreCondition.WhenWritingNull;
-        _options.Converters.Add(new JsonEnumMemberConverter<RestartPolicyKind>());
-        _options.Converters.Add(new JsonEnumMemberConverter<TaskState>());
         _options.Converters.Add(new JsonDateTimeConverter());
         _options.Converters.Add(new JsonTimeSpanNanosecondsConverter());
         _options.MakeReadOnly();
@@ -137,7 +135,8 @@ internal sealed class JsonSerializer
 [JsonSerializable(typeof(Dictionary<string, string>[]))] // DeleteImageAsync
 [JsonSerializable(typeof(ImageHistoryResponse[]))] // GetImageHistoryAsync
 [JsonSerializable(typeof(ImagesListResponse[]))] // ListImagesAsync
-[JsonSerializable(typeof(Dictionary<string, AuthConfig>))] // RegistryConfigHeaders
+[JsonSerializable(typeof(Dictionary<string, AuthConfig>))] // RegistryConfigHeaderValue
+[JsonSerializable(typeof(IDictionary<string, AuthConfig>))] // ImageBuildParameters.AuthConfigs
 [JsonSerializable(typeof(ImageSearchResponse[]))] // SearchImagesAsync
 
 // NetworkOperations
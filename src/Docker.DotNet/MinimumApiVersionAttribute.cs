@@ -0,0 +1,24 @@
+namespace Docker.DotNet;
+
+/// <summary>
+/// Marks a model or a property that Docker Engine API versions older than <see cref="Version"/> do not understand.
+/// </summary>
+/// <remarks>
+/// The version is derived by specgen from the versioned Docker Engine API specifications. Models and properties
+/// without the attribute are supported by every API version Docker.DotNet is generated against.
+/// </remarks>
+[AttributeUsage(AttributeTargets.Class | AttributeTargets.Property)]
+public sealed class MinimumApiVersionAttribute : Attribute
+{
+    public Version Version { get; private set; }
+
+    public MinimumApiVersionAttribute(string version)
+    {
+        if (string.IsNullOrEmpty(version))
+        {
+            throw new ArgumentNullException(nameof(version));
+        }
+
+        Version = Version.Parse(version);
+    }
+}
@@ -7,9 +7,9 @@ namespace Docker.DotNet.Models
     public class Annotations // (swarm.Annotations)
     {
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
     }
 }
@@ -0,0 +1,20 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// AppArmorMode is type used for the enumeration of possible AppArmor modes in
+    /// AppArmorOpts
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<AppArmorMode>))]
+    public enum AppArmorMode // (swarm.AppArmorMode)
+    {
+        [EnumMember(Value = "default")]
+        Default,
+
+        [EnumMember(Value = "disabled")]
+        Disabled,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -8,6 +8,6 @@ namespace Docker.DotNet.Models
     public class AppArmorOpts // (swarm.AppArmorOpts)
     {
         [JsonPropertyName("Mode")]
-        public string? Mode { get; set; }
+        public AppArmorMode? Mode { get; set; }
     }
 }
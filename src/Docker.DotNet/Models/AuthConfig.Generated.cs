@@ -7,28 +7,28 @@ namespace Docker.DotNet.Models
     public class AuthConfig // (registry.AuthConfig)
     {
         [JsonPropertyName("username")]
-        public string? Username { get; set; }
+        public string Username { get; set; } = string.Empty;
 
         [JsonPropertyName("password")]
-        public string? Password { get; set; }
+        public string Password { get; set; } = string.Empty;
 
         [JsonPropertyName("auth")]
-        public string? Auth { get; set; }
+        public string Auth { get; set; } = string.Empty;
 
         [JsonPropertyName("serveraddress")]
-        public string? ServerAddress { get; set; }
+        public string ServerAddress { get; set; } = string.Empty;
 
         /// <summary>
         /// IdentityToken is used to authenticate the user and get
         /// an access token for the registry.
         /// </summary>
         [JsonPropertyName("identitytoken")]
-        public string? IdentityToken { get; set; }
+        public string IdentityToken { get; set; } = string.Empty;
 
         /// <summary>
         /// RegistryToken is a bearer token to be sent to a registry
         /// </summary>
         [JsonPropertyName("registrytoken")]
-        public string? RegistryToken { get; set; }
+        public string RegistryToken { get; set; } = string.Empty;
     }
 }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// AuthResponse An identity token was generated successfully.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model AuthResponse
+    /// </para>
     /// </summary>
     public class AuthResponse // (registry.AuthResponse)
     {
@@ -13,7 +16,7 @@ namespace Docker.DotNet.Models
         /// Example: 9cbaf023786cd7...
         /// </summary>
         [JsonPropertyName("IdentityToken")]
-        public string? IdentityToken { get; set; }
+        public string IdentityToken { get; set; } = string.Empty;
 
         /// <summary>
         /// The status of the authentication
@@ -7,25 +7,25 @@ namespace Docker.DotNet.Models
     public class BindOptions // (mount.BindOptions)
     {
         [JsonPropertyName("Propagation")]
-        public string? Propagation { get; set; }
+        public Propagation? Propagation { get; set; }
 
         [JsonPropertyName("NonRecursive")]
-        public bool? NonRecursive { get; set; }
+        public bool NonRecursive { get; set; } = default!;
 
         [JsonPropertyName("CreateMountpoint")]
-        public bool? CreateMountpoint { get; set; }
+        public bool CreateMountpoint { get; set; } = default!;
 
         /// <summary>
         /// ReadOnlyNonRecursive makes the mount non-recursively read-only, but still leaves the mount recursive
         /// (unless NonRecursive is set to true in conjunction).
         /// </summary>
         [JsonPropertyName("ReadOnlyNonRecursive")]
-        public bool? ReadOnlyNonRecursive { get; set; }
+        public bool ReadOnlyNonRecursive { get; set; } = default!;
 
         /// <summary>
         /// ReadOnlyForceRecursive raises an error if the mount cannot be made recursively read-only.
         /// </summary>
         [JsonPropertyName("ReadOnlyForceRecursive")]
-        public bool? ReadOnlyForceRecursive { get; set; }
+        public bool ReadOnlyForceRecursive { get; set; } = default!;
     }
 }
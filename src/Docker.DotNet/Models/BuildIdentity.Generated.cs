@@ -11,12 +11,13 @@ namespace Docker.DotNet.Models
         /// look up the build details in BuildKit history API.
         /// </summary>
         [JsonPropertyName("Ref")]
-        public string? Ref { get; set; }
+        public string Ref { get; set; } = string.Empty;
 
         /// <summary>
         /// CreatedAt is the time when the build ran.
         /// </summary>
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
     }
 }
@@ -0,0 +1,12 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Result contains the image id of a successful build.
+    /// </summary>
+    public class BuildResult : JSONMessageAux // (build.Result)
+    {
+        [JsonPropertyName("ID")]
+        public string ID { get; set; } = string.Empty;
+    }
+}
@@ -10,7 +10,8 @@ namespace Docker.DotNet.Models
         /// NodeCertExpiry is the duration certificates should be issued for
         /// </summary>
         [JsonPropertyName("NodeCertExpiry")]
-        public TimeSpan? NodeCertExpiry { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        public TimeSpan NodeCertExpiry { get; set; } = default!;
 
         /// <summary>
         /// ExternalCAs is a list of CAs to which a manager node will make
@@ -25,16 +26,16 @@ namespace Docker.DotNet.Models
         /// be redacted.
         /// </summary>
         [JsonPropertyName("SigningCACert")]
-        public string? SigningCACert { get; set; }
+        public string SigningCACert { get; set; } = string.Empty;
 
         [JsonPropertyName("SigningCAKey")]
-        public string? SigningCAKey { get; set; }
+        public string SigningCAKey { get; set; } = string.Empty;
 
         /// <summary>
         /// If this value changes, and there is no specified signing cert and key,
         /// then the swarm is forced to generate a new root certificate and key.
         /// </summary>
         [JsonPropertyName("ForceRotate")]
-        public ulong? ForceRotate { get; set; }
+        public ulong ForceRotate { get; set; } = default!;
     }
 }
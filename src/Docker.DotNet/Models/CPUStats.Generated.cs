@@ -16,18 +16,18 @@ namespace Docker.DotNet.Models
         /// System Usage. Linux only.
         /// </summary>
         [JsonPropertyName("system_cpu_usage")]
-        public ulong? SystemUsage { get; set; }
+        public ulong SystemUsage { get; set; } = default!;
 
         /// <summary>
         /// Online CPUs. Linux only.
         /// </summary>
         [JsonPropertyName("online_cpus")]
-        public uint? OnlineCPUs { get; set; }
+        public uint OnlineCPUs { get; set; } = default!;
 
         /// <summary>
         /// Throttling Data. Linux only.
         /// </summary>
         [JsonPropertyName("throttling_data")]
-        public ThrottlingData? ThrottlingData { get; set; }
+        public ThrottlingData ThrottlingData { get; set; } = default!;
     }
 }
@@ -52,13 +52,15 @@ namespace Docker.DotNet.Models
         /// CreatedAt is the date and time at which the build cache was created.
         /// </summary>
         [JsonPropertyName("CreatedAt")]
-        public DateTime CreatedAt { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         /// <summary>
         /// LastUsedAt is the date and time at which the build cache was last used.
         /// </summary>
         [JsonPropertyName("LastUsedAt")]
-        public DateTime? LastUsedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? LastUsedAt { get; set; }
 
         [JsonPropertyName("UsageCount")]
         public long UsageCount { get; set; } = default!;
@@ -0,0 +1,19 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// CgroupnsMode represents the cgroup namespace mode of the container
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<CgroupnsMode>))]
+    public enum CgroupnsMode // (container.CgroupnsMode)
+    {
+        [EnumMember(Value = "")]
+        Undefined,
+
+        [EnumMember(Value = "private")]
+        Private,
+
+        [EnumMember(Value = "host")]
+        Host
+    }
+}
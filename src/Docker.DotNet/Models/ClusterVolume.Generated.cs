@@ -30,13 +30,15 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
 
         /// <summary>
         /// Spec is the cluster-specific options from which this volume is derived.
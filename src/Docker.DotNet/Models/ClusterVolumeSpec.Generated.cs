@@ -15,7 +15,7 @@ namespace Docker.DotNet.Models
         /// group.
         /// </summary>
         [JsonPropertyName("Group")]
-        public string? Group { get; set; }
+        public string Group { get; set; } = string.Empty;
 
         /// <summary>
         /// AccessMode defines how the volume is used by tasks.
@@ -24,16 +24,20 @@ namespace Docker.DotNet.Models
         public VolumeAccessMode? AccessMode { get; set; }
 
         /// <summary>
+        /// <para>
         /// AccessibilityRequirements specifies where in the cluster a volume must
         /// be accessible from.
-        /// 
+        /// </para>
+        /// <para>
         /// This field must be empty if the plugin does not support
         /// VOLUME_ACCESSIBILITY_CONSTRAINTS capabilities. If it is present but the
         /// plugin does not support it, volume will not be created.
-        /// 
+        /// </para>
+        /// <para>
         /// If AccessibilityRequirements is empty, but the plugin does support
         /// VOLUME_ACCESSIBILITY_CONSTRAINTS, then Swarmkit will assume the entire
         /// cluster is a valid target for the volume.
+        /// </para>
         /// </summary>
         [JsonPropertyName("AccessibilityRequirements")]
         public TopologyRequirement? AccessibilityRequirements { get; set; }
@@ -58,6 +62,6 @@ namespace Docker.DotNet.Models
         /// update or delete them.
         /// </summary>
         [JsonPropertyName("Availability")]
-        public string? Availability { get; set; }
+        public VolumeAvailability? Availability { get; set; }
     }
 }
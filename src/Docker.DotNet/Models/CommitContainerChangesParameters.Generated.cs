@@ -123,13 +123,13 @@ namespace Docker.DotNet.Models
         /// List of environment variable to set in the container
         /// </summary>
         [JsonPropertyName("Env")]
-        public IList<string> Env { get; set; } = default!;
+        public IList<string>? Env { get; set; }
 
         /// <summary>
         /// Command to run when starting the container
         /// </summary>
         [JsonPropertyName("Cmd")]
-        public IList<string> Cmd { get; set; } = default!;
+        public IList<string>? Cmd { get; set; }
 
         /// <summary>
         /// Healthcheck describes how to check the container is healthy
@@ -141,7 +141,7 @@ namespace Docker.DotNet.Models
         /// True if command is already escaped (meaning treat as a command line) (Windows specific).
         /// </summary>
         [JsonPropertyName("ArgsEscaped")]
-        public bool? ArgsEscaped { get; set; }
+        public bool ArgsEscaped { get; set; } = default!;
 
         /// <summary>
         /// Name of the image as it was passed by the operator (e.g. could be symbolic)
@@ -153,7 +153,7 @@ namespace Docker.DotNet.Models
         /// List of volumes (mounts) used for the container
         /// </summary>
         [JsonPropertyName("Volumes")]
-        public IDictionary<string, EmptyStruct> Volumes { get; set; } = default!;
+        public IDictionary<string, EmptyStruct>? Volumes { get; set; }
 
         /// <summary>
         /// Current directory (PWD) in the command will be launched
@@ -165,13 +165,13 @@ namespace Docker.DotNet.Models
         /// Entrypoint to run when starting the container
         /// </summary>
         [JsonPropertyName("Entrypoint")]
-        public IList<string> Entrypoint { get; set; } = default!;
+        public IList<string>? Entrypoint { get; set; }
 
         /// <summary>
         /// Is network disabled
         /// </summary>
         [JsonPropertyName("NetworkDisabled")]
-        public bool? NetworkDisabled { get; set; }
+        public bool NetworkDisabled { get; set; } = default!;
 
         /// <summary>
         /// ONBUILD metadata that were defined on the image Dockerfile
@@ -183,13 +183,13 @@ namespace Docker.DotNet.Models
         /// List of labels set to this container
         /// </summary>
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
         /// Signal to stop a container
         /// </summary>
         [JsonPropertyName("StopSignal")]
-        public string? StopSignal { get; set; }
+        public string StopSignal { get; set; } = string.Empty;
 
         /// <summary>
         /// Timeout (in seconds) to stop a container
@@ -13,12 +13,15 @@ namespace Docker.DotNet.Models
         public string Version { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Details contains Key/value pairs of strings with additional information
         /// about the component. These values are intended for informational purposes
         /// only, and their content is not defined, and not part of the API
         /// specification.
-        /// 
+        /// </para>
+        /// <para>
         /// These messages can be printed by the client as information to the user.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Details")]
         public IDictionary<string, string>? Details { get; set; }
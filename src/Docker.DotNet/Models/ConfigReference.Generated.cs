@@ -2,19 +2,25 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// ConfigReference The config-only network source to provide the configuration for
     /// this network.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model ConfigReference
+    /// </para>
     /// </summary>
     public class ConfigReference // (network.ConfigReference)
     {
         /// <summary>
+        /// <para>
         /// The name of the config-only network that provides the network&apos;s
         /// configuration. The specified network must be an existing config-only
         /// network. Only network names are allowed, not network IDs.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: config_only_network_01
+        /// </para>
         /// </summary>
         [JsonPropertyName("Network")]
         public string Network { get; set; } = string.Empty;
@@ -0,0 +1,37 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Consistency represents the consistency requirements of a mount.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<Consistency>))]
+    public enum Consistency // (mount.Consistency)
+    {
+        /// <summary>
+        /// ConsistencyFull guarantees bind mount-like consistency
+        /// </summary>
+        [EnumMember(Value = "consistent")]
+        Consistent,
+
+        /// <summary>
+        /// ConsistencyCached mounts can cache read data and FS structure
+        /// </summary>
+        [EnumMember(Value = "cached")]
+        Cached,
+
+        /// <summary>
+        /// ConsistencyDelegated mounts can cache read and written data and structure
+        /// </summary>
+        [EnumMember(Value = "delegated")]
+        Delegated,
+
+        /// <summary>
+        /// ConsistencyDefault provides &quot;consistent&quot; behavior unless overridden
+        /// </summary>
+        [EnumMember(Value = "default")]
+        Default,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
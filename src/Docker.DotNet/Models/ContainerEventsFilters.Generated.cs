@@ -0,0 +1,98 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="ContainerEventsParameters.Filters"/> of <c>GET /events</c>.
+    /// </summary>
+    public sealed class ContainerEventsFilters : FiltersBuilder<ContainerEventsFilters> // (ContainerEventsParameters.Filters)
+    {
+        /// <summary>
+        /// `config=&lt;string&gt;` config name or ID
+        /// </summary>
+        public ContainerEventsFilters Config(string value) => AddFilter("config", value);
+
+        /// <summary>
+        /// `container=&lt;string&gt;` container name or ID
+        /// </summary>
+        public ContainerEventsFilters Container(string value) => AddFilter("container", value);
+
+        /// <summary>
+        /// `daemon=&lt;string&gt;` daemon name or ID
+        /// </summary>
+        public ContainerEventsFilters Daemon(string value) => AddFilter("daemon", value);
+
+        /// <summary>
+        /// `event=&lt;string&gt;` event type
+        /// </summary>
+        public ContainerEventsFilters Event(string value) => AddFilter("event", value);
+
+        /// <summary>
+        /// `image=&lt;string&gt;` image name or ID
+        /// </summary>
+        public ContainerEventsFilters Image(string value) => AddFilter("image", value);
+
+        /// <summary>
+        /// `label=&lt;string&gt;` image or container label
+        /// </summary>
+        public ContainerEventsFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public ContainerEventsFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `network=&lt;string&gt;` network name or ID
+        /// </summary>
+        public ContainerEventsFilters Network(string value) => AddFilter("network", value);
+
+        /// <summary>
+        /// `node=&lt;string&gt;` node ID
+        /// </summary>
+        public ContainerEventsFilters Node(string value) => AddFilter("node", value);
+
+        /// <summary>
+        /// `plugin`=&lt;string&gt; plugin name or ID
+        /// </summary>
+        public ContainerEventsFilters Plugin(string value) => AddFilter("plugin", value);
+
+        /// <summary>
+        /// `scope`=&lt;string&gt; local or swarm
+        /// </summary>
+        public ContainerEventsFilters Scope(string value) => AddFilter("scope", value);
+
+        /// <summary>
+        /// `secret=&lt;string&gt;` secret name or ID
+        /// </summary>
+        public ContainerEventsFilters Secret(string value) => AddFilter("secret", value);
+
+        /// <summary>
+        /// `service=&lt;string&gt;` service name or ID
+        /// </summary>
+        public ContainerEventsFilters Service(string value) => AddFilter("service", value);
+
+        /// <summary>
+        /// `type=&lt;string&gt;` object to filter by, one of `container`, `image`, `volume`, `network`, `daemon`, `plugin`, `node`, `service`, `secret` or `config`
+        /// </summary>
+        public ContainerEventsFilters Type(EventType value) => AddFilter("type", value switch
+        {
+            EventType.Builder => "builder",
+            EventType.Config => "config",
+            EventType.Container => "container",
+            EventType.Daemon => "daemon",
+            EventType.Image => "image",
+            EventType.Network => "network",
+            EventType.Node => "node",
+            EventType.Plugin => "plugin",
+            EventType.Secret => "secret",
+            EventType.Service => "service",
+            EventType.Volume => "volume",
+            _ => throw new ArgumentOutOfRangeException(nameof(value), value, null),
+        });
+
+        /// <summary>
+        /// `volume=&lt;string&gt;` volume name
+        /// </summary>
+        public ContainerEventsFilters Volume(string value) => AddFilter("volume", value);
+    }
+}
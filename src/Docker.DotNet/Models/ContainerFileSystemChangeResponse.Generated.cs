@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// FilesystemChange Change in the container&apos;s filesystem.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model FilesystemChange
+    /// </para>
     /// </summary>
     public class ContainerFileSystemChangeResponse // (container.FilesystemChange)
     {
@@ -16,9 +19,12 @@ namespace Docker.DotNet.Models
         public FileSystemChangeKind Kind { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Path to file or directory that has changed.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Path")]
         public string Path { get; set; } = string.Empty;
@@ -11,13 +11,14 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Created")]
-        public DateTime Created { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Created { get; set; }
 
         [JsonPropertyName("Path")]
         public string Path { get; set; } = string.Empty;
 
         [JsonPropertyName("Args")]
-        public IList<string> Args { get; set; } = default!;
+        public IList<string>? Args { get; set; }
 
         [JsonPropertyName("State")]
         public State? State { get; set; }
@@ -59,7 +60,7 @@ namespace Docker.DotNet.Models
         public string AppArmorProfile { get; set; } = string.Empty;
 
         [JsonPropertyName("ExecIDs")]
-        public IList<string> ExecIDs { get; set; } = default!;
+        public IList<string>? ExecIDs { get; set; }
 
         [JsonPropertyName("HostConfig")]
         public HostConfig? HostConfig { get; set; }
@@ -73,7 +74,9 @@ namespace Docker.DotNet.Models
         /// <summary>
         /// Storage contains information about the storage used for the container&apos;s filesystem.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.52 or later.</remarks>
         [JsonPropertyName("Storage")]
+        [MinimumApiVersion("1.52")]
         public Storage? Storage { get; set; }
 
         [JsonPropertyName("SizeRw")]
@@ -83,7 +86,7 @@ namespace Docker.DotNet.Models
         public long? SizeRootFs { get; set; }
 
         [JsonPropertyName("Mounts")]
-        public IList<MountPoint> Mounts { get; set; } = default!;
+        public IList<MountPoint>? Mounts { get; set; }
 
         [JsonPropertyName("Config")]
         public ContainerConfig? Config { get; set; }
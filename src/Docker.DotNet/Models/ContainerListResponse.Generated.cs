@@ -11,7 +11,7 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Names")]
-        public IList<string> Names { get; set; } = default!;
+        public IList<string>? Names { get; set; }
 
         [JsonPropertyName("Image")]
         public string Image { get; set; } = string.Empty;
@@ -19,29 +19,32 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("ImageID")]
         public string ImageID { get; set; } = string.Empty;
 
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("ImageManifestDescriptor")]
+        [MinimumApiVersion("1.48")]
         public Descriptor? ImageManifestDescriptor { get; set; }
 
         [JsonPropertyName("Command")]
         public string Command { get; set; } = string.Empty;
 
         [JsonPropertyName("Created")]
+        [JsonConverter(typeof(JsonUnixTimestampConverter))]
         public DateTime Created { get; set; } = default!;
 
         [JsonPropertyName("Ports")]
-        public IList<PortSummary> Ports { get; set; } = default!;
+        public IList<PortSummary>? Ports { get; set; }
 
         [JsonPropertyName("SizeRw")]
-        public long? SizeRw { get; set; }
+        public long SizeRw { get; set; } = default!;
 
         [JsonPropertyName("SizeRootFs")]
-        public long? SizeRootFs { get; set; }
+        public long SizeRootFs { get; set; } = default!;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         [JsonPropertyName("State")]
-        public string State { get; set; } = string.Empty;
+        public ContainerState? State { get; set; }
 
         [JsonPropertyName("Status")]
         public string Status { get; set; } = string.Empty;
@@ -49,13 +52,15 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("HostConfig")]
         public SummaryHostConfig HostConfig { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.52 or later.</remarks>
         [JsonPropertyName("Health")]
+        [MinimumApiVersion("1.52")]
         public HealthSummary? Health { get; set; }
 
         [JsonPropertyName("NetworkSettings")]
         public NetworkSettingsSummary? NetworkSettings { get; set; }
 
         [JsonPropertyName("Mounts")]
-        public IList<MountPoint> Mounts { get; set; } = default!;
+        public IList<MountPoint>? Mounts { get; set; }
     }
 }
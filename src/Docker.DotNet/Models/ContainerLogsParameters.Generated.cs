@@ -12,7 +12,9 @@ namespace Docker.DotNet.Models
         [QueryStringParameter("since", false)]
         public string? Since { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.35 or later.</remarks>
         [QueryStringParameter("until", false)]
+        [MinimumApiVersion("1.35")]
         public string? Until { get; set; }
 
         [QueryStringBoolParameter("timestamps", false)]
@@ -18,7 +18,8 @@ namespace Docker.DotNet.Models
         public uint Mode { get; set; } = default!;
 
         [JsonPropertyName("mtime")]
-        public DateTime Mtime { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Mtime { get; set; }
 
         [JsonPropertyName("linkTarget")]
         public string LinkTarget { get; set; } = string.Empty;
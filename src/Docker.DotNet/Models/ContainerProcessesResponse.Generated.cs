@@ -2,11 +2,15 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// TopResponse ContainerTopResponse
-    /// 
+    /// </para>
+    /// <para>
     /// Container &quot;top&quot; response.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model TopResponse
+    /// </para>
     /// </summary>
     public class ContainerProcessesResponse // (container.TopResponse)
     {
@@ -16,13 +20,13 @@ namespace Docker.DotNet.Models
         /// Example: {&quot;Processes&quot;:[[&quot;root&quot;,&quot;13642&quot;,&quot;882&quot;,&quot;0&quot;,&quot;17:03&quot;,&quot;pts/0&quot;,&quot;00:00:00&quot;,&quot;/bin/bash&quot;],[&quot;root&quot;,&quot;13735&quot;,&quot;13642&quot;,&quot;0&quot;,&quot;17:06&quot;,&quot;pts/0&quot;,&quot;00:00:00&quot;,&quot;sleep 10&quot;]]}
         /// </summary>
         [JsonPropertyName("Processes")]
-        public IList<IList<string>> Processes { get; set; } = default!;
+        public IList<IList<string>>? Processes { get; set; }
 
         /// <summary>
         /// The ps column titles
         /// Example: {&quot;Titles&quot;:[&quot;UID&quot;,&quot;PID&quot;,&quot;PPID&quot;,&quot;C&quot;,&quot;STIME&quot;,&quot;TTY&quot;,&quot;TIME&quot;,&quot;CMD&quot;]}
         /// </summary>
         [JsonPropertyName("Titles")]
-        public IList<string> Titles { get; set; } = default!;
+        public IList<string>? Titles { get; set; }
     }
 }
@@ -6,7 +6,9 @@ namespace Docker.DotNet.Models
         [QueryStringBoolParameter("v", false)]
         public bool? RemoveVolumes { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.27 or later.</remarks>
         [QueryStringBoolParameter("link", false)]
+        [MinimumApiVersion("1.27")]
         public bool? RemoveLinks { get; set; }
 
         [QueryStringBoolParameter("force", false)]
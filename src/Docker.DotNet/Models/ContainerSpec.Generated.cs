@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class ContainerSpec // (swarm.ContainerSpec)
     {
         [JsonPropertyName("Image")]
-        public string? Image { get; set; }
+        public string Image { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
         public IDictionary<string, string>? Labels { get; set; }
@@ -19,16 +19,16 @@ namespace Docker.DotNet.Models
         public IList<string>? Args { get; set; }
 
         [JsonPropertyName("Hostname")]
-        public string? Hostname { get; set; }
+        public string Hostname { get; set; } = string.Empty;
 
         [JsonPropertyName("Env")]
         public IList<string>? Env { get; set; }
 
         [JsonPropertyName("Dir")]
-        public string? Dir { get; set; }
+        public string Dir { get; set; } = string.Empty;
 
         [JsonPropertyName("User")]
-        public string? User { get; set; }
+        public string User { get; set; } = string.Empty;
 
         [JsonPropertyName("Groups")]
         public IList<string>? Groups { get; set; }
@@ -40,30 +40,35 @@ namespace Docker.DotNet.Models
         public bool? Init { get; set; }
 
         [JsonPropertyName("StopSignal")]
-        public string? StopSignal { get; set; }
+        public string StopSignal { get; set; } = string.Empty;
 
         [JsonPropertyName("TTY")]
-        public bool? TTY { get; set; }
+        public bool TTY { get; set; } = default!;
 
         [JsonPropertyName("OpenStdin")]
-        public bool? OpenStdin { get; set; }
+        public bool OpenStdin { get; set; } = default!;
 
         [JsonPropertyName("ReadOnly")]
-        public bool? ReadOnly { get; set; }
+        public bool ReadOnly { get; set; } = default!;
 
         [JsonPropertyName("Mounts")]
         public IList<Mount>? Mounts { get; set; }
 
         [JsonPropertyName("StopGracePeriod")]
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
         public TimeSpan? StopGracePeriod { get; set; }
 
         [JsonPropertyName("Healthcheck")]
         public HealthcheckConfig? Healthcheck { get; set; }
 
         /// <summary>
+        /// <para>
         /// The format of extra hosts on swarmkit is specified in:
-        /// http://man7.org/linux/man-pages/man5/hosts.5.html
-        ///    IP_address canonical_hostname [aliases...]
+        /// <see href="http://man7.org/linux/man-pages/man5/hosts.5.html"/>
+        /// </para>
+        /// <code>
+        /// IP_address canonical_hostname [aliases...]
+        /// </code>
         /// </summary>
         [JsonPropertyName("Hosts")]
         public IList<string>? Hosts { get; set; }
@@ -78,7 +83,7 @@ namespace Docker.DotNet.Models
         public IList<SwarmConfigReference>? Configs { get; set; }
 
         [JsonPropertyName("Isolation")]
-        public string? Isolation { get; set; }
+        public Isolation Isolation { get; set; } = default!;
 
         [JsonPropertyName("Sysctls")]
         public IDictionary<string, string>? Sysctls { get; set; }
@@ -93,6 +98,6 @@ namespace Docker.DotNet.Models
         public IList<Ulimit>? Ulimits { get; set; }
 
         [JsonPropertyName("OomScoreAdj")]
-        public long? OomScoreAdj { get; set; }
+        public long OomScoreAdj { get; set; } = default!;
     }
 }
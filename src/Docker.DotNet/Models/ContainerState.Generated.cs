@@ -0,0 +1,55 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// ContainerState is a string representation of the container&apos;s current state.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<ContainerState>))]
+    public enum ContainerState // (container.ContainerState)
+    {
+        /// <summary>
+        /// StateCreated indicates the container is created, but not (yet) started.
+        /// </summary>
+        [EnumMember(Value = "created")]
+        Created,
+
+        /// <summary>
+        /// StateRunning indicates that the container is running.
+        /// </summary>
+        [EnumMember(Value = "running")]
+        Running,
+
+        /// <summary>
+        /// StatePaused indicates that the container&apos;s current state is paused.
+        /// </summary>
+        [EnumMember(Value = "paused")]
+        Paused,
+
+        /// <summary>
+        /// StateRestarting indicates that the container is currently restarting.
+        /// </summary>
+        [EnumMember(Value = "restarting")]
+        Restarting,
+
+        /// <summary>
+        /// StateRemoving indicates that the container is being removed.
+        /// </summary>
+        [EnumMember(Value = "removing")]
+        Removing,
+
+        /// <summary>
+        /// StateExited indicates that the container exited.
+        /// </summary>
+        [EnumMember(Value = "exited")]
+        Exited,
+
+        /// <summary>
+        /// StateDead indicates that the container failed to be deleted. Containers in this state are attempted to be cleaned up when the daemon restarts.
+        /// </summary>
+        [EnumMember(Value = "dead")]
+        Dead,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
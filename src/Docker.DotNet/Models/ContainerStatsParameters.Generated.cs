@@ -6,7 +6,9 @@ namespace Docker.DotNet.Models
         [QueryStringBoolParameter("stream", true)]
         public bool Stream { get; set; } = true;
 
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [QueryStringBoolParameter("one-shot", false)]
+        [MinimumApiVersion("1.41")]
         public bool? OneShot { get; set; }
     }
 }
@@ -10,85 +10,105 @@ namespace Docker.DotNet.Models
         /// ID is the ID of the container for which the stats were collected.
         /// </summary>
         [JsonPropertyName("id")]
-        public string? ID { get; set; }
+        public string ID { get; set; } = string.Empty;
 
         /// <summary>
         /// Name is the name of the container for which the stats were collected.
         /// </summary>
         [JsonPropertyName("name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         /// <summary>
         /// OSType is the OS of the container (&quot;linux&quot; or &quot;windows&quot;) to allow
         /// platform-specific handling of stats.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.52 or later.</remarks>
         [JsonPropertyName("os_type")]
-        public string? OSType { get; set; }
+        [MinimumApiVersion("1.52")]
+        public string OSType { get; set; } = string.Empty;
 
         /// <summary>
         /// Read is the date and time at which this sample was collected.
         /// </summary>
         [JsonPropertyName("read")]
-        public DateTime Read { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Read { get; set; }
 
         /// <summary>
         /// CPUStats contains CPU related info of the container.
         /// </summary>
         [JsonPropertyName("cpu_stats")]
-        public CPUStats? CPUStats { get; set; }
+        public CPUStats CPUStats { get; set; } = default!;
 
         /// <summary>
         /// MemoryStats aggregates all memory stats since container inception on Linux.
         /// Windows returns stats for commit and private working set only.
         /// </summary>
         [JsonPropertyName("memory_stats")]
-        public MemoryStats? MemoryStats { get; set; }
+        public MemoryStats MemoryStats { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Networks contains Nntwork statistics for the container per interface.
-        /// 
+        /// </para>
+        /// <para>
         /// This field is omitted if the container has no networking enabled.
+        /// </para>
         /// </summary>
         [JsonPropertyName("networks")]
         public IDictionary<string, NetworkStats>? Networks { get; set; }
 
         /// <summary>
+        /// <para>
         /// PidsStats contains Linux-specific stats of a container&apos;s process-IDs (PIDs).
-        /// 
+        /// </para>
+        /// <para>
         /// This field is Linux-specific and omitted for Windows containers.
+        /// </para>
         /// </summary>
         [JsonPropertyName("pids_stats")]
-        public PidsStats? PidsStats { get; set; }
+        public PidsStats PidsStats { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// BlkioStats stores all IO service stats for data read and write.
-        /// 
+        /// </para>
+        /// <para>
         /// This type is Linux-specific and holds many fields that are specific
         /// to cgroups v1.
-        /// 
+        /// </para>
+        /// <para>
         /// On a cgroup v2 host, all fields other than &quot;io_service_bytes_recursive&quot;
         /// are omitted or &quot;null&quot;.
-        /// 
+        /// </para>
+        /// <para>
         /// This type is only populated on Linux and omitted for Windows containers.
+        /// </para>
         /// </summary>
         [JsonPropertyName("blkio_stats")]
-        public BlkioStats? BlkioStats { get; set; }
+        public BlkioStats BlkioStats { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// NumProcs is the number of processors on the system.
-        /// 
+        /// </para>
+        /// <para>
         /// This field is Windows-specific and always zero for Linux containers.
+        /// </para>
         /// </summary>
         [JsonPropertyName("num_procs")]
         public uint NumProcs { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// StorageStats is the disk I/O stats for read/write on Windows.
-        /// 
+        /// </para>
+        /// <para>
         /// This type is Windows-specific and omitted for Linux containers.
+        /// </para>
         /// </summary>
         [JsonPropertyName("storage_stats")]
-        public StorageStats? StorageStats { get; set; }
+        public StorageStats StorageStats { get; set; } = default!;
 
         /// <summary>
         /// PreRead is the date and time at which this first sample was collected.
@@ -97,12 +117,13 @@ namespace Docker.DotNet.Models
         /// to a default date (`0001-01-01T00:00:00Z`).
         /// </summary>
         [JsonPropertyName("preread")]
-        public DateTime PreRead { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? PreRead { get; set; }
 
         /// <summary>
         /// PreCPUStats contains the CPUStats of the previous sample.
         /// </summary>
         [JsonPropertyName("precpu_stats")]
-        public CPUStats? PreCPUStats { get; set; }
+        public CPUStats PreCPUStats { get; set; } = default!;
     }
 }
@@ -6,7 +6,9 @@ namespace Docker.DotNet.Models
         [QueryStringParameter("t", false)]
         public uint? WaitBeforeKillSeconds { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.42 or later.</remarks>
         [QueryStringParameter("signal", false)]
+        [MinimumApiVersion("1.42")]
         public string? Signal { get; set; }
     }
 }
@@ -4,6 +4,6 @@ namespace Docker.DotNet.Models
     public class ContainerUpdateResponse // (main.ContainerUpdateResponse)
     {
         [JsonPropertyName("Warnings")]
-        public IList<string> Warnings { get; set; } = default!;
+        public IList<string>? Warnings { get; set; }
     }
 }
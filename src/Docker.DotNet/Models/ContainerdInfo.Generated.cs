@@ -10,7 +10,7 @@ namespace Docker.DotNet.Models
         /// Address is the path to the containerd socket.
         /// </summary>
         [JsonPropertyName("Address")]
-        public string? Address { get; set; }
+        public string Address { get; set; } = string.Empty;
 
         /// <summary>
         /// Namespaces is the containerd namespaces used by the daemon.
@@ -2,38 +2,48 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// ContainerdNamespaces reflects the containerd namespaces used by the daemon.
-    /// 
+    /// </para>
+    /// <para>
     /// These namespaces can be configured in the daemon configuration, and are
     /// considered to be used exclusively by the daemon,
-    /// 
+    /// </para>
+    /// <para>
     /// As these namespaces are considered to be exclusively accessed
     /// by the daemon, it is not recommended to change these values,
     /// or to change them to a value that is used by other systems,
     /// such as cri-containerd.
+    /// </para>
     /// </summary>
     public class ContainerdNamespaces // (system.ContainerdNamespaces)
     {
         /// <summary>
+        /// <para>
         /// Containers holds the default containerd namespace used for
         /// containers managed by the daemon.
-        /// 
+        /// </para>
+        /// <para>
         /// The default namespace for containers is &quot;moby&quot;, but will be
         /// suffixed with the `&lt;uid&gt;.&lt;gid&gt;` of the remapped `root` if
         /// user-namespaces are enabled and the containerd image-store
         /// is used.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Containers")]
         public string Containers { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Plugins holds the default containerd namespace used for
         /// plugins managed by the daemon.
-        /// 
+        /// </para>
+        /// <para>
         /// The default namespace for plugins is &quot;moby&quot;, but will be
         /// suffixed with the `&lt;uid&gt;.&lt;gid&gt;` of the remapped `root` if
         /// user-namespaces are enabled and the containerd image-store
         /// is used.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Plugins")]
         public string Plugins { get; set; } = string.Empty;
@@ -0,0 +1,111 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="ContainersListParameters.Filters"/> of <c>GET /containers/json</c>.
+    /// </summary>
+    public sealed class ContainersListFilters : FiltersBuilder<ContainersListFilters> // (ContainersListParameters.Filters)
+    {
+        /// <summary>
+        /// `ancestor`=(`&lt;image-name&gt;[:&lt;tag&gt;]`, `&lt;image id&gt;`, or `&lt;image@digest&gt;`)
+        /// </summary>
+        public ContainersListFilters Ancestor(string value) => AddFilter("ancestor", value);
+
+        /// <summary>
+        /// `before`=(`&lt;container id&gt;` or `&lt;container name&gt;`)
+        /// </summary>
+        public ContainersListFilters Before(string value) => AddFilter("before", value);
+
+        /// <summary>
+        /// `expose`=(`&lt;port&gt;[/&lt;proto&gt;]`|`&lt;startport-endport&gt;/[&lt;proto&gt;]`)
+        /// </summary>
+        public ContainersListFilters Expose(string value) => AddFilter("expose", value);
+
+        /// <summary>
+        /// `exited=&lt;int&gt;` containers with exit code of `&lt;int&gt;`
+        /// </summary>
+        public ContainersListFilters Exited(string value) => AddFilter("exited", value);
+
+        /// <summary>
+        /// `exited=&lt;int&gt;` containers with exit code of `&lt;int&gt;`
+        /// </summary>
+        public ContainersListFilters Exited(int value) => AddFilter("exited", value.ToString(CultureInfo.InvariantCulture));
+
+        /// <summary>
+        /// `health`=(`starting`|`healthy`|`unhealthy`|`none`)
+        /// </summary>
+        public ContainersListFilters Health(HealthStatus value) => AddFilter("health", value switch
+        {
+            HealthStatus.None => "none",
+            HealthStatus.Starting => "starting",
+            HealthStatus.Healthy => "healthy",
+            HealthStatus.Unhealthy => "unhealthy",
+            _ => throw new ArgumentOutOfRangeException(nameof(value), value, null),
+        });
+
+        /// <summary>
+        /// `id=&lt;ID&gt;` a container&apos;s ID
+        /// </summary>
+        public ContainersListFilters Id(string value) => AddFilter("id", value);
+
+        /// <summary>
+        /// `isolation=`(`default`|`process`|`hyperv`) (Windows daemon only)
+        /// </summary>
+        public ContainersListFilters Isolation(string value) => AddFilter("isolation", value);
+
+        /// <summary>
+        /// `is-task=`(`true`|`false`)
+        /// </summary>
+        public ContainersListFilters IsTask(bool value) => AddFilter("is-task", value ? "true" : "false");
+
+        /// <summary>
+        /// `label=key` or `label=&quot;key=value&quot;` of a container label
+        /// </summary>
+        public ContainersListFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public ContainersListFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `name=&lt;name&gt;` a container&apos;s name
+        /// </summary>
+        public ContainersListFilters Name(string value) => AddFilter("name", value);
+
+        /// <summary>
+        /// `network`=(`&lt;network id&gt;` or `&lt;network name&gt;`)
+        /// </summary>
+        public ContainersListFilters Network(string value) => AddFilter("network", value);
+
+        /// <summary>
+        /// `publish`=(`&lt;port&gt;[/&lt;proto&gt;]`|`&lt;startport-endport&gt;/[&lt;proto&gt;]`)
+        /// </summary>
+        public ContainersListFilters Publish(string value) => AddFilter("publish", value);
+
+        /// <summary>
+        /// `since`=(`&lt;container id&gt;` or `&lt;container name&gt;`)
+        /// </summary>
+        public ContainersListFilters Since(string value) => AddFilter("since", value);
+
+        /// <summary>
+        /// `status=`(`created`|`restarting`|`running`|`removing`|`paused`|`exited`|`dead`)
+        /// </summary>
+        public ContainersListFilters Status(ContainerState value) => AddFilter("status", value switch
+        {
+            ContainerState.Created => "created",
+            ContainerState.Running => "running",
+            ContainerState.Paused => "paused",
+            ContainerState.Restarting => "restarting",
+            ContainerState.Removing => "removing",
+            ContainerState.Exited => "exited",
+            ContainerState.Dead => "dead",
+            _ => throw new ArgumentOutOfRangeException(nameof(value), value, null),
+        });
+
+        /// <summary>
+        /// `volume`=(`&lt;volume name&gt;` or `&lt;mount point destination&gt;`)
+        /// </summary>
+        public ContainersListFilters Volume(string value) => AddFilter("volume", value);
+    }
+}
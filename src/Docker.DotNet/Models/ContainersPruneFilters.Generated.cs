@@ -0,0 +1,24 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="ContainersPruneParameters.Filters"/> of <c>POST /containers/prune</c>.
+    /// </summary>
+    public sealed class ContainersPruneFilters : FiltersBuilder<ContainersPruneFilters> // (ContainersPruneParameters.Filters)
+    {
+        /// <summary>
+        /// `until=&lt;timestamp&gt;` Prune containers created before this timestamp. The `&lt;timestamp&gt;` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
+        /// </summary>
+        public ContainersPruneFilters Until(string value) => AddFilter("until", value);
+
+        /// <summary>
+        /// `label` (`label=&lt;key&gt;`, `label=&lt;key&gt;=&lt;value&gt;`, `label!=&lt;key&gt;`, or `label!=&lt;key&gt;=&lt;value&gt;`) Prune containers with (or without, in case `label!=...` is used) the specified labels.
+        /// </summary>
+        public ContainersPruneFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public ContainersPruneFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+    }
+}
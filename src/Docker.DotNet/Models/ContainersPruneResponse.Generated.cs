@@ -8,7 +8,7 @@ namespace Docker.DotNet.Models
     public class ContainersPruneResponse // (container.PruneReport)
     {
         [JsonPropertyName("ContainersDeleted")]
-        public IList<string> ContainersDeleted { get; set; } = default!;
+        public IList<string>? ContainersDeleted { get; set; }
 
         [JsonPropertyName("SpaceReclaimed")]
         public ulong SpaceReclaimed { get; set; } = default!;
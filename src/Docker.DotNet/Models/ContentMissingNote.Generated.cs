@@ -0,0 +1,21 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// ContentMissing is a note that is sent when push fails because the content is missing.
+    /// </summary>
+    public class ContentMissingNote : JSONMessageAux // (auxprogress.ContentMissing)
+    {
+        /// <summary>
+        /// Always true
+        /// </summary>
+        [JsonPropertyName("contentMissing")]
+        public bool ContentMissing { get; set; } = default!;
+
+        /// <summary>
+        /// Desc is the descriptor of the root object that was attempted to be pushed.
+        /// </summary>
+        [JsonPropertyName("desc")]
+        public Descriptor Desc { get; set; } = default!;
+    }
+}
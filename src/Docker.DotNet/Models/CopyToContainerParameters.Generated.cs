@@ -9,7 +9,9 @@ namespace Docker.DotNet.Models
         [QueryStringBoolParameter("noOverwriteDirNonDir", false)]
         public bool? AllowOverwriteDirWithFile { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.39 or later.</remarks>
         [QueryStringBoolParameter("copyUIDGID", false)]
+        [MinimumApiVersion("1.39")]
         public bool? CopyUIDGID { get; set; }
     }
 }
@@ -2,11 +2,13 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// CreateResponse ContainerCreateResponse
-    /// 
-    /// # OK response to ContainerCreate operation
-    /// 
+    /// </para>
+    /// <para><b>OK response to ContainerCreate operation</b></para>
+    /// <para>
     /// swagger:model CreateResponse
+    /// </para>
     /// </summary>
     public class CreateContainerResponse // (container.CreateResponse)
     {
@@ -24,6 +26,6 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Warnings")]
-        public IList<string> Warnings { get; set; } = default!;
+        public IList<string>? Warnings { get; set; }
     }
 }
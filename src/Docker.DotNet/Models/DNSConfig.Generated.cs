@@ -4,7 +4,7 @@ namespace Docker.DotNet.Models
     /// <summary>
     /// DNSConfig specifies DNS related configurations in resolver configuration file (resolv.conf)
     /// Detailed documentation is available in:
-    /// http://man7.org/linux/man-pages/man5/resolv.conf.5.html
+    /// <see href="http://man7.org/linux/man-pages/man5/resolv.conf.5.html"/>
     /// `nameserver`, `search`, `options` have been supported.
     /// TODO: `domain` is not supported yet.
     /// </summary>
@@ -12,19 +12,29 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("size")]
         public long Size { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("urls")]
+        [MinimumApiVersion("1.48")]
         public IList<string>? URLs { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("annotations")]
+        [MinimumApiVersion("1.48")]
         public IDictionary<string, string>? Annotations { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("data")]
+        [MinimumApiVersion("1.48")]
         public byte[]? Data { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("platform")]
+        [MinimumApiVersion("1.48")]
         public Platform? Platform { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("artifactType")]
-        public string? ArtifactType { get; set; }
+        [MinimumApiVersion("1.48")]
+        public string ArtifactType { get; set; } = string.Empty;
     }
 }
@@ -10,9 +10,9 @@ namespace Docker.DotNet.Models
     public class DiscreteGenericResource // (swarm.DiscreteGenericResource)
     {
         [JsonPropertyName("Kind")]
-        public string? Kind { get; set; }
+        public string Kind { get; set; } = string.Empty;
 
         [JsonPropertyName("Value")]
-        public long? Value { get; set; }
+        public long Value { get; set; } = default!;
     }
 }
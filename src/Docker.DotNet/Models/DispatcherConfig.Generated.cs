@@ -11,6 +11,7 @@ namespace Docker.DotNet.Models
         /// dispatcher.
         /// </summary>
         [JsonPropertyName("HeartbeatPeriod")]
-        public TimeSpan? HeartbeatPeriod { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        public TimeSpan HeartbeatPeriod { get; set; } = default!;
     }
 }
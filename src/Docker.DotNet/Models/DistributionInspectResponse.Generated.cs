@@ -5,6 +5,8 @@ namespace Docker.DotNet.Models
     /// DistributionInspect describes the result obtained from contacting the
     /// registry to retrieve image metadata
     /// </summary>
+    /// <remarks>Requires Docker Engine API v1.30 or later.</remarks>
+    [MinimumApiVersion("1.30")]
     public class DistributionInspectResponse // (registry.DistributionInspect)
     {
         /// <summary>
@@ -19,6 +21,6 @@ namespace Docker.DotNet.Models
         /// obtained by parsing the manifest
         /// </summary>
         [JsonPropertyName("Platforms")]
-        public IList<Platform> Platforms { get; set; } = default!;
+        public IList<Platform>? Platforms { get; set; }
     }
 }
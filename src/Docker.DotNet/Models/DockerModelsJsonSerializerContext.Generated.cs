@@ -11,6 +11,7 @@ namespace Docker.DotNet.Models
     [JsonSerializable(typeof(BlkioStats))]
     [JsonSerializable(typeof(BuildDiskUsage))]
     [JsonSerializable(typeof(BuildIdentity))]
+    [JsonSerializable(typeof(BuildResult))]
     [JsonSerializable(typeof(CAConfig))]
     [JsonSerializable(typeof(CPUStats))]
     [JsonSerializable(typeof(CPUUsage))]
@@ -48,6 +49,7 @@ namespace Docker.DotNet.Models
     [JsonSerializable(typeof(ContainerdInfo))]
     [JsonSerializable(typeof(ContainerdNamespaces))]
     [JsonSerializable(typeof(ContainersPruneResponse))]
+    [JsonSerializable(typeof(ContentMissingNote))]
     [JsonSerializable(typeof(CreateContainerParameters))]
     [JsonSerializable(typeof(CreateContainerResponse))]
     [JsonSerializable(typeof(CredentialSpec))]
@@ -87,8 +89,6 @@ namespace Docker.DotNet.Models
     [JsonSerializable(typeof(IPAMOptions))]
     [JsonSerializable(typeof(IPAMStatus))]
     [JsonSerializable(typeof(Identity))]
-    [JsonSerializable(typeof(ImageBuildParameters))]
-    [JsonSerializable(typeof(ImageBuildResult))]
     [JsonSerializable(typeof(ImageConfig))]
     [JsonSerializable(typeof(ImageDeleteResponse))]
     [JsonSerializable(typeof(ImageDiskUsage))]
@@ -97,11 +97,8 @@ namespace Docker.DotNet.Models
     [JsonSerializable(typeof(ImageOptions))]
     [JsonSerializable(typeof(ImageProperties))]
     [JsonSerializable(typeof(ImagePropertiesSize))]
-    [JsonSerializable(typeof(ImagePushParameters))]
     [JsonSerializable(typeof(ImageSearchResponse))]
-    [JsonSerializable(typeof(ImagesCreateParameters))]
     [JsonSerializable(typeof(ImagesListResponse))]
-    [JsonSerializable(typeof(ImagesLoadResponse))]
     [JsonSerializable(typeof(ImagesPruneResponse))]
     [JsonSerializable(typeof(IndexInfo))]
     [JsonSerializable(typeof(Info))]
@@ -112,6 +109,7 @@ namespace Docker.DotNet.Models
     [JsonSerializable(typeof(JoinTokens))]
     [JsonSerializable(typeof(LogConfig))]
     [JsonSerializable(typeof(ManagerStatus))]
+    [JsonSerializable(typeof(ManifestPushedInsteadOfIndexNote))]
     [JsonSerializable(typeof(ManifestSummary))]
     [JsonSerializable(typeof(ManifestSummarySize))]
     [JsonSerializable(typeof(MemoryStats))]
@@ -178,6 +176,7 @@ namespace Docker.DotNet.Models
     [JsonSerializable(typeof(Privileges))]
     [JsonSerializable(typeof(PublishStatus))]
     [JsonSerializable(typeof(PullIdentity))]
+    [JsonSerializable(typeof(PushResult))]
     [JsonSerializable(typeof(RaftConfig))]
     [JsonSerializable(typeof(ReplicatedJob))]
     [JsonSerializable(typeof(ReplicatedService))]
@@ -2,24 +2,31 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// DriverData Information about the storage driver used to store the container&apos;s and
     /// image&apos;s filesystem.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model DriverData
+    /// </para>
     /// </summary>
     public class DriverData // (storage.DriverData)
     {
         /// <summary>
+        /// <para>
         /// Low-level storage metadata, provided as key/value pairs.
-        /// 
+        /// </para>
+        /// <para>
         /// This information is driver-specific, and depends on the storage-driver
         /// in use, and should be used for informational purposes only.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;MergedDir&quot;:&quot;/var/lib/docker/overlay2/ef749362d13333e65fc95c572eb525abbe0052e16e086cb64bc3b98ae9aa6d74/merged&quot;,&quot;UpperDir&quot;:&quot;/var/lib/docker/overlay2/ef749362d13333e65fc95c572eb525abbe0052e16e086cb64bc3b98ae9aa6d74/diff&quot;,&quot;WorkDir&quot;:&quot;/var/lib/docker/overlay2/ef749362d13333e65fc95c572eb525abbe0052e16e086cb64bc3b98ae9aa6d74/work&quot;}
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Data")]
-        public IDictionary<string, string> Data { get; set; } = default!;
+        public IDictionary<string, string>? Data { get; set; }
 
         /// <summary>
         /// Name of the storage driver.
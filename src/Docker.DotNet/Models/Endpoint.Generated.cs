@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class Endpoint // (swarm.Endpoint)
     {
         [JsonPropertyName("Spec")]
-        public EndpointSpec? Spec { get; set; }
+        public EndpointSpec Spec { get; set; } = default!;
 
         [JsonPropertyName("Ports")]
         public IList<PortConfig>? Ports { get; set; }
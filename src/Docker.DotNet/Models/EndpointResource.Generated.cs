@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// EndpointResource contains network resources allocated and used for a container in a network.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model EndpointResource
+    /// </para>
     /// </summary>
     public class EndpointResource // (network.EndpointResource)
     {
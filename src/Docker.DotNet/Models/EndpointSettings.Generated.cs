@@ -13,16 +13,18 @@ namespace Docker.DotNet.Models
         public EndpointIPAMConfig? IPAMConfig { get; set; }
 
         [JsonPropertyName("Links")]
-        public IList<string> Links { get; set; } = default!;
+        public IList<string>? Links { get; set; }
 
         /// <summary>
         /// Aliases holds the list of extra, user-specified DNS names for this endpoint.
         /// </summary>
         [JsonPropertyName("Aliases")]
-        public IList<string> Aliases { get; set; } = default!;
+        public IList<string>? Aliases { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.32 or later.</remarks>
         [JsonPropertyName("DriverOpts")]
-        public IDictionary<string, string> DriverOpts { get; set; } = default!;
+        [MinimumApiVersion("1.32")]
+        public IDictionary<string, string>? DriverOpts { get; set; }
 
         /// <summary>
         /// GwPriority determines which endpoint will provide the default gateway
@@ -30,7 +32,9 @@ namespace Docker.DotNet.Models
         /// If multiple endpoints have the same priority, they are lexicographically
         /// sorted based on their network name, and the one that sorts first is picked.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("GwPriority")]
+        [MinimumApiVersion("1.48")]
         public long GwPriority { get; set; } = default!;
 
         [JsonPropertyName("NetworkID")]
@@ -69,7 +73,9 @@ namespace Docker.DotNet.Models
         /// DNSNames holds all the (non fully qualified) DNS names associated to this
         /// endpoint. The first entry is used to generate PTR records.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.44 or later.</remarks>
         [JsonPropertyName("DNSNames")]
-        public IList<string> DNSNames { get; set; } = default!;
+        [MinimumApiVersion("1.44")]
+        public IList<string>? DNSNames { get; set; }
     }
 }
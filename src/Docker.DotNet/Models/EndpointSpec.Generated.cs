@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class EndpointSpec // (swarm.EndpointSpec)
     {
         [JsonPropertyName("Mode")]
-        public string? Mode { get; set; }
+        public ResolutionMode? Mode { get; set; }
 
         [JsonPropertyName("Ports")]
         public IList<PortConfig>? Ports { get; set; }
@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class EndpointVirtualIP // (swarm.EndpointVirtualIP)
     {
         [JsonPropertyName("NetworkID")]
-        public string? NetworkID { get; set; }
+        public string NetworkID { get; set; } = string.Empty;
 
         /// <summary>
         /// Addr is the virtual ip address.
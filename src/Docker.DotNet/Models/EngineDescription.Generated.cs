@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class EngineDescription // (swarm.EngineDescription)
     {
         [JsonPropertyName("EngineVersion")]
-        public string? EngineVersion { get; set; }
+        public string EngineVersion { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
         public IDictionary<string, string>? Labels { get; set; }
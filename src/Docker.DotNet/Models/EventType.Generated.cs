@@ -0,0 +1,79 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Type is used for event-types.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<EventType>))]
+    public enum EventType // (events.Type)
+    {
+        /// <summary>
+        /// BuilderEventType is the event type that the builder generates.
+        /// </summary>
+        [EnumMember(Value = "builder")]
+        Builder,
+
+        /// <summary>
+        /// ConfigEventType is the event type that configs generate.
+        /// </summary>
+        [EnumMember(Value = "config")]
+        Config,
+
+        /// <summary>
+        /// ContainerEventType is the event type that containers generate.
+        /// </summary>
+        [EnumMember(Value = "container")]
+        Container,
+
+        /// <summary>
+        /// DaemonEventType is the event type that daemon generate.
+        /// </summary>
+        [EnumMember(Value = "daemon")]
+        Daemon,
+
+        /// <summary>
+        /// ImageEventType is the event type that images generate.
+        /// </summary>
+        [EnumMember(Value = "image")]
+        Image,
+
+        /// <summary>
+        /// NetworkEventType is the event type that networks generate.
+        /// </summary>
+        [EnumMember(Value = "network")]
+        Network,
+
+        /// <summary>
+        /// NodeEventType is the event type that nodes generate.
+        /// </summary>
+        [EnumMember(Value = "node")]
+        Node,
+
+        /// <summary>
+        /// PluginEventType is the event type that plugins generate.
+        /// </summary>
+        [EnumMember(Value = "plugin")]
+        Plugin,
+
+        /// <summary>
+        /// SecretEventType is the event type that secrets generate.
+        /// </summary>
+        [EnumMember(Value = "secret")]
+        Secret,
+
+        /// <summary>
+        /// ServiceEventType is the event type that services generate.
+        /// </summary>
+        [EnumMember(Value = "service")]
+        Service,
+
+        /// <summary>
+        /// VolumeEventType is the event type that volumes generate.
+        /// </summary>
+        [EnumMember(Value = "volume")]
+        Volume,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -10,7 +10,7 @@ namespace Docker.DotNet.Models
         /// Protocol is the protocol used by this external CA.
         /// </summary>
         [JsonPropertyName("Protocol")]
-        public string Protocol { get; set; } = string.Empty;
+        public ExternalCAProtocol? Protocol { get; set; }
 
         /// <summary>
         /// URL is the URL where the external CA can be reached.
@@ -0,0 +1,16 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// ExternalCAProtocol represents type of external CA.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<ExternalCAProtocol>))]
+    public enum ExternalCAProtocol // (swarm.ExternalCAProtocol)
+    {
+        [EnumMember(Value = "cfssl")]
+        Cfssl,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -0,0 +1,31 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// FailureAction is the action to perform when updating a service fails.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<FailureAction>))]
+    public enum FailureAction // (swarm.FailureAction)
+    {
+        /// <summary>
+        /// UpdateFailureActionPause PAUSE
+        /// </summary>
+        [EnumMember(Value = "pause")]
+        Pause,
+
+        /// <summary>
+        /// UpdateFailureActionContinue CONTINUE
+        /// </summary>
+        [EnumMember(Value = "continue")]
+        Continue,
+
+        /// <summary>
+        /// UpdateFailureActionRollback ROLLBACK
+        /// </summary>
+        [EnumMember(Value = "rollback")]
+        Rollback,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -0,0 +1,37 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// <para>
+    /// ChangeType Kind of change
+    /// </para>
+    /// <para>
+    /// Can be one of:
+    /// </para>
+    /// <para>
+    /// - `0`: Modified (&quot;C&quot;)
+    /// - `1`: Added (&quot;A&quot;)
+    /// - `2`: Deleted (&quot;D&quot;)
+    /// </para>
+    /// <para>
+    /// swagger:model ChangeType
+    /// </para>
+    /// </summary>
+    public enum FileSystemChangeKind // (container.ChangeType)
+    {
+        /// <summary>
+        /// ChangeModify represents the modify operation.
+        /// </summary>
+        Modify = 0,
+
+        /// <summary>
+        /// ChangeAdd represents the add operation.
+        /// </summary>
+        Add = 1,
+
+        /// <summary>
+        /// ChangeDelete represents the delete operation.
+        /// </summary>
+        Delete = 2
+    }
+}
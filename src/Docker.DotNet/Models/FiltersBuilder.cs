@@ -0,0 +1,25 @@
+namespace Docker.DotNet.Models;
+
+/// <summary>
+/// The base of the generated builders of the <c>filters</c> query parameter, e.g. <see cref="ContainersListFilters"/>.
+/// A builder is the dictionary the parameter is declared as, so it can be assigned to the <c>Filters</c> property directly.
+/// </summary>
+/// <typeparam name="TFilters">The generated builder, which the methods return to chain calls.</typeparam>
+public abstract class FiltersBuilder<TFilters> : Dictionary<string, IDictionary<string, bool>>
+    where TFilters : FiltersBuilder<TFilters>
+{
+    /// <summary>
+    /// Adds a value to the values of a filter key. The daemon returns the objects that match any value of every key.
+    /// </summary>
+    protected TFilters AddFilter(string key, string value)
+    {
+        if (!TryGetValue(key, out var values))
+        {
+            values = new Dictionary<string, bool>();
+            this[key] = values;
+        }
+
+        values[value] = true;
+        return (TFilters)this;
+    }
+}
@@ -2,11 +2,14 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// GlobalJob is the type of a Service which executes a Task on every Node
     /// matching the Service&apos;s placement constraints. These tasks run to completion
     /// and then exit.
-    /// 
+    /// </para>
+    /// <para>
     /// This type is deliberately empty.
+    /// </para>
     /// </summary>
     public class GlobalJob // (swarm.GlobalJob)
     {
@@ -7,10 +7,10 @@ namespace Docker.DotNet.Models
     public class Health // (container.Health)
     {
         /// <summary>
-        /// Status is one of [Starting], [Healthy] or [Unhealthy].
+        /// Status is one of <see href="https://pkg.go.dev/github.com/moby/moby/api/types/container#Starting">container.Starting</see>, <see href="https://pkg.go.dev/github.com/moby/moby/api/types/container#Healthy">container.Healthy</see> or <see href="https://pkg.go.dev/github.com/moby/moby/api/types/container#Unhealthy">container.Unhealthy</see>.
         /// </summary>
         [JsonPropertyName("Status")]
-        public string Status { get; set; } = string.Empty;
+        public HealthStatus? Status { get; set; }
 
         /// <summary>
         /// FailingStreak is the number of consecutive failures
@@ -22,6 +22,6 @@ namespace Docker.DotNet.Models
         /// Log contains the last few results (oldest first)
         /// </summary>
         [JsonPropertyName("Log")]
-        public IList<HealthcheckResult> Log { get; set; } = default!;
+        public IList<HealthcheckResult>? Log { get; set; }
     }
 }
@@ -0,0 +1,37 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// HealthStatus is a string representation of the container&apos;s health.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<HealthStatus>))]
+    public enum HealthStatus // (container.HealthStatus)
+    {
+        /// <summary>
+        /// Indicates there is no healthcheck
+        /// </summary>
+        [EnumMember(Value = "none")]
+        None,
+
+        /// <summary>
+        /// Starting indicates that the container is not yet ready
+        /// </summary>
+        [EnumMember(Value = "starting")]
+        Starting,
+
+        /// <summary>
+        /// Healthy indicates that the container is running correctly
+        /// </summary>
+        [EnumMember(Value = "healthy")]
+        Healthy,
+
+        /// <summary>
+        /// Unhealthy indicates that the container has a problem
+        /// </summary>
+        [EnumMember(Value = "unhealthy")]
+        Unhealthy,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -7,18 +7,26 @@ namespace Docker.DotNet.Models
         public IList<string>? Test { get; set; }
 
         [JsonPropertyName("Interval")]
-        public TimeSpan? Interval { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        public TimeSpan Interval { get; set; } = default!;
 
         [JsonPropertyName("Timeout")]
-        public TimeSpan? Timeout { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        public TimeSpan Timeout { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.29 or later.</remarks>
         [JsonPropertyName("StartPeriod")]
-        public TimeSpan? StartPeriod { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        [MinimumApiVersion("1.29")]
+        public TimeSpan StartPeriod { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.44 or later.</remarks>
         [JsonPropertyName("StartInterval")]
-        public TimeSpan? StartInterval { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        [MinimumApiVersion("1.44")]
+        public TimeSpan StartInterval { get; set; } = default!;
 
         [JsonPropertyName("Retries")]
-        public long? Retries { get; set; }
+        public long Retries { get; set; } = default!;
     }
 }
@@ -10,13 +10,15 @@ namespace Docker.DotNet.Models
         /// Start is the time this check started
         /// </summary>
         [JsonPropertyName("Start")]
-        public DateTime Start { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Start { get; set; }
 
         /// <summary>
         /// End is the time this check ended
         /// </summary>
         [JsonPropertyName("End")]
-        public DateTime End { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? End { get; set; }
 
         /// <summary>
         /// ExitCode meanings: 0=healthy, 1=unhealthy, 2=reserved (considered unhealthy), else=error running probe
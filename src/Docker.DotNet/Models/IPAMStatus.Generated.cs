@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// IPAMStatus IPAM status
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model IPAMStatus
+    /// </para>
     /// </summary>
     public class IPAMStatus // (network.IPAMStatus)
     {
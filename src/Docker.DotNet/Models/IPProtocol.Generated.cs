@@ -0,0 +1,22 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// IPProtocol represents a network protocol for a port.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<IPProtocol>))]
+    public enum IPProtocol // (network.IPProtocol)
+    {
+        [EnumMember(Value = "tcp")]
+        Tcp,
+
+        [EnumMember(Value = "udp")]
+        Udp,
+
+        [EnumMember(Value = "sctp")]
+        Sctp,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -63,22 +63,33 @@ namespace Docker.DotNet.Models
         [QueryStringListParameter("cachefrom", false)]
         public IList<string>? CacheFrom { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.28 or later.</remarks>
         [QueryStringListParameter("extrahosts", false)]
+        [MinimumApiVersion("1.28")]
         public IList<string>? ExtraHosts { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.37 or later.</remarks>
         [QueryStringParameter("target", false)]
+        [MinimumApiVersion("1.37")]
         public string? Target { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.34 or later.</remarks>
         [QueryStringParameter("platform", false)]
+        [MinimumApiVersion("1.34")]
         public string? Platform { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.40 or later.</remarks>
         [QueryStringParameter("outputs", false)]
+        [MinimumApiVersion("1.40")]
         public string? Outputs { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.38 or later.</remarks>
         [QueryStringParameter("version", false)]
+        [MinimumApiVersion("1.38")]
         public string? Version { get; set; }
 
-        [JsonPropertyName("AuthConfigs")]
-        public IDictionary<string, AuthConfig> AuthConfigs { get; set; } = default!;
+        [HeaderBase64JsonParameter(typeof(IDictionary<string, AuthConfig>), "X-Registry-Config", false)]
+        [JsonIgnore]
+        public IDictionary<string, AuthConfig>? AuthConfigs { get; set; }
     }
 }
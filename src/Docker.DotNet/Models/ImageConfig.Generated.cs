@@ -4,7 +4,7 @@ namespace Docker.DotNet.Models
     public class ImageConfig // (v1.ImageConfig)
     {
         [JsonPropertyName("User")]
-        public string? User { get; set; }
+        public string User { get; set; } = string.Empty;
 
         [JsonPropertyName("ExposedPorts")]
         public IDictionary<string, EmptyStruct>? ExposedPorts { get; set; }
@@ -22,15 +22,15 @@ namespace Docker.DotNet.Models
         public IDictionary<string, EmptyStruct>? Volumes { get; set; }
 
         [JsonPropertyName("WorkingDir")]
-        public string? WorkingDir { get; set; }
+        public string WorkingDir { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
         public IDictionary<string, string>? Labels { get; set; }
 
         [JsonPropertyName("StopSignal")]
-        public string? StopSignal { get; set; }
+        public string StopSignal { get; set; } = string.Empty;
 
         [JsonPropertyName("ArgsEscaped")]
-        public bool? ArgsEscaped { get; set; }
+        public bool ArgsEscaped { get; set; } = default!;
     }
 }
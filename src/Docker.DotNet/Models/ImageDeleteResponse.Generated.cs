@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// DeleteResponse delete response
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model DeleteResponse
+    /// </para>
     /// </summary>
     public class ImageDeleteResponse // (image.DeleteResponse)
     {
@@ -12,12 +15,12 @@ namespace Docker.DotNet.Models
         /// The image ID of an image that was deleted
         /// </summary>
         [JsonPropertyName("Deleted")]
-        public string? Deleted { get; set; }
+        public string Deleted { get; set; } = string.Empty;
 
         /// <summary>
         /// The image ID of an image that was untagged
         /// </summary>
         [JsonPropertyName("Untagged")]
-        public string? Untagged { get; set; }
+        public string Untagged { get; set; } = string.Empty;
     }
 }
@@ -2,11 +2,15 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// HistoryResponseItem HistoryResponseItem
-    /// 
+    /// </para>
+    /// <para>
     /// individual image layer information in response to ImageHistory operation
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model HistoryResponseItem
+    /// </para>
     /// </summary>
     public class ImageHistoryResponse // (image.HistoryResponseItem)
     {
@@ -22,6 +26,7 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Created")]
+        [JsonConverter(typeof(JsonUnixTimestampConverter))]
         public DateTime Created { get; set; } = default!;
 
         /// <summary>
@@ -50,6 +55,6 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Tags")]
-        public IList<string> Tags { get; set; } = default!;
+        public IList<string>? Tags { get; set; }
     }
 }
@@ -8,57 +8,71 @@ namespace Docker.DotNet.Models
     public class ImageInspectResponse // (image.InspectResponse)
     {
         /// <summary>
+        /// <para>
         /// ID is the content-addressable ID of an image.
-        /// 
+        /// </para>
+        /// <para>
         /// This identifier is a content-addressable digest calculated from the
         /// image&apos;s configuration (which includes the digests of layers used by
         /// the image).
-        /// 
+        /// </para>
+        /// <para>
         /// Note that this digest differs from the `RepoDigests` below, which
         /// holds digests of image manifests that reference the image.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Id")]
         public string ID { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// RepoTags is a list of image names/tags in the local image cache that
         /// reference this image.
-        /// 
+        /// </para>
+        /// <para>
         /// Multiple image tags can refer to the same image, and this list may be
         /// empty if no tags reference the image, in which case the image is
         /// &quot;untagged&quot;, in which case it can still be referenced by its ID.
+        /// </para>
         /// </summary>
         [JsonPropertyName("RepoTags")]
-        public IList<string> RepoTags { get; set; } = default!;
+        public IList<string>? RepoTags { get; set; }
 
         /// <summary>
+        /// <para>
         /// RepoDigests is a list of content-addressable digests of locally available
         /// image manifests that the image is referenced from. Multiple manifests can
         /// refer to the same image.
-        /// 
+        /// </para>
+        /// <para>
         /// These digests are usually only available if the image was either pulled
         /// from a registry, or if the image was pushed to a registry, which is when
         /// the manifest is generated and its digest calculated.
+        /// </para>
         /// </summary>
         [JsonPropertyName("RepoDigests")]
-        public IList<string> RepoDigests { get; set; } = default!;
+        public IList<string>? RepoDigests { get; set; }
 
         /// <summary>
         /// Comment is an optional message that can be set when committing or
         /// importing the image. This field is omitted if not set.
         /// </summary>
         [JsonPropertyName("Comment")]
-        public string? Comment { get; set; }
+        public string Comment { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Created is the date and time at which the image was created, formatted in
         /// RFC 3339 nano-seconds (time.RFC3339Nano).
-        /// 
+        /// </para>
+        /// <para>
         /// This information is only available if present in the image,
         /// and omitted otherwise.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Created")]
-        public DateTime? Created { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Created { get; set; }
 
         /// <summary>
         /// Author is the name of the author that was specified when committing the
@@ -66,7 +80,7 @@ namespace Docker.DotNet.Models
         /// This field is omitted if not set.
         /// </summary>
         [JsonPropertyName("Author")]
-        public string? Author { get; set; }
+        public string Author { get; set; } = string.Empty;
 
         [JsonPropertyName("Config")]
         public DockerOCIImageConfig? Config { get; set; }
@@ -81,7 +95,7 @@ namespace Docker.DotNet.Models
         /// Variant is the CPU architecture variant (presently ARM-only).
         /// </summary>
         [JsonPropertyName("Variant")]
-        public string? Variant { get; set; }
+        public string Variant { get; set; } = string.Empty;
 
         /// <summary>
         /// OS is the Operating System the image is built to run on.
@@ -94,7 +108,7 @@ namespace Docker.DotNet.Models
         /// run on (especially for Windows).
         /// </summary>
         [JsonPropertyName("OsVersion")]
-        public string? OsVersion { get; set; }
+        public string OsVersion { get; set; } = string.Empty;
 
         /// <summary>
         /// Size is the total size of the image including all layers it is composed of.
@@ -117,35 +131,49 @@ namespace Docker.DotNet.Models
         public RootFS RootFS { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Metadata of the image in the local cache.
-        /// 
+        /// </para>
+        /// <para>
         /// This information is local to the daemon, and not part of the image itself.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Metadata")]
         public Metadata Metadata { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Descriptor is the OCI descriptor of the image target.
         /// It&apos;s only set if the daemon provides a multi-platform image store.
-        /// 
+        /// </para>
+        /// <para>
         /// WARNING: This is experimental and may change at any time without any backward
         /// compatibility.
+        /// </para>
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("Descriptor")]
+        [MinimumApiVersion("1.48")]
         public Descriptor? Descriptor { get; set; }
 
         /// <summary>
+        /// <para>
         /// Manifests is a list of image manifests available in this image. It
         /// provides a more detailed view of the platform-specific image manifests or
         /// other image-attached data like build attestations.
-        /// 
+        /// </para>
+        /// <para>
         /// Only available if the daemon provides a multi-platform image store, the client
         /// requests manifests AND does not request a specific platform.
-        /// 
+        /// </para>
+        /// <para>
         /// WARNING: This is experimental and may change at any time without any backward
         /// compatibility.
+        /// </para>
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("Manifests")]
+        [MinimumApiVersion("1.48")]
         public IList<ManifestSummary>? Manifests { get; set; }
 
         /// <summary>
@@ -153,7 +181,9 @@ namespace Docker.DotNet.Models
         /// This is trusted information verified by the daemon and cannot be modified
         /// by tagging an image to a different name.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.53 or later.</remarks>
         [JsonPropertyName("Identity")]
+        [MinimumApiVersion("1.53")]
         public Identity? Identity { get; set; }
     }
 }
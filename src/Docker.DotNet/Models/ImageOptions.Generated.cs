@@ -4,6 +4,6 @@ namespace Docker.DotNet.Models
     public class ImageOptions // (mount.ImageOptions)
     {
         [JsonPropertyName("Subpath")]
-        public string? Subpath { get; set; }
+        public string Subpath { get; set; } = string.Empty;
     }
 }
@@ -4,9 +4,12 @@ namespace Docker.DotNet.Models
     public class ImageProperties // (image.ImageProperties)
     {
         /// <summary>
+        /// <para>
         /// Platform is the OCI platform object describing the platform of the image.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Platform")]
         public Platform Platform { get; set; } = default!;
@@ -23,12 +26,15 @@ namespace Docker.DotNet.Models
         public ImagePropertiesSize Size { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Containers is an array containing the IDs of the containers that are
         /// using this image.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Containers")]
-        public IList<string> Containers { get; set; } = default!;
+        public IList<string>? Containers { get; set; }
     }
 }
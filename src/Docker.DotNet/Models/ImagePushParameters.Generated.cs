@@ -6,10 +6,13 @@ namespace Docker.DotNet.Models
         [QueryStringParameter("tag", false)]
         public string? Tag { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.46 or later.</remarks>
         [QueryStringParameter("platform", false)]
+        [MinimumApiVersion("1.46")]
         public string? Platform { get; set; }
 
-        [JsonPropertyName("RegistryAuth")]
-        public AuthConfig RegistryAuth { get; set; } = default!;
+        [HeaderBase64JsonParameter(typeof(AuthConfig), "X-Registry-Auth", false)]
+        [JsonIgnore]
+        public AuthConfig? RegistryAuth { get; set; }
     }
 }
@@ -26,10 +26,10 @@ namespace Docker.DotNet.Models
 
         /// <summary>
         /// IsAutomated indicates whether the result is automated.
-        /// 
-        /// Deprecated: the &quot;is_automated&quot; field is deprecated and will always be &quot;false&quot;.
         /// </summary>
+        /// <remarks>Deprecated: the &quot;is_automated&quot; field is deprecated and will always be &quot;false&quot;.</remarks>
         [JsonPropertyName("is_automated")]
+        [Obsolete("the \"is_automated\" field is deprecated and will always be \"false\".")]
         public bool IsAutomated { get; set; } = default!;
 
         /// <summary>
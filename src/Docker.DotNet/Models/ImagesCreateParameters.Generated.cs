@@ -15,16 +15,23 @@ namespace Docker.DotNet.Models
         [QueryStringParameter("tag", false)]
         public string? Tag { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.39 or later.</remarks>
         [QueryStringParameter("message", false)]
+        [MinimumApiVersion("1.39")]
         public string? Message { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.40 or later.</remarks>
         [QueryStringListParameter("changes", false)]
+        [MinimumApiVersion("1.40")]
         public IList<string>? Changes { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.32 or later.</remarks>
         [QueryStringParameter("platform", false)]
+        [MinimumApiVersion("1.32")]
         public string? Platform { get; set; }
 
-        [JsonPropertyName("RegistryAuth")]
-        public AuthConfig RegistryAuth { get; set; } = default!;
+        [HeaderBase64JsonParameter(typeof(AuthConfig), "X-Registry-Auth", false)]
+        [JsonIgnore]
+        public AuthConfig? RegistryAuth { get; set; }
     }
 }
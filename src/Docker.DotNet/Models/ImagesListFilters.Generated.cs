@@ -0,0 +1,44 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="ImagesListParameters.Filters"/> of <c>GET /images/json</c>.
+    /// </summary>
+    public sealed class ImagesListFilters : FiltersBuilder<ImagesListFilters> // (ImagesListParameters.Filters)
+    {
+        /// <summary>
+        /// `before`=(`&lt;image-name&gt;[:&lt;tag&gt;]`,  `&lt;image id&gt;` or `&lt;image@digest&gt;`)
+        /// </summary>
+        public ImagesListFilters Before(string value) => AddFilter("before", value);
+
+        /// <summary>
+        /// `dangling=true`
+        /// </summary>
+        public ImagesListFilters Dangling(bool value) => AddFilter("dangling", value ? "true" : "false");
+
+        /// <summary>
+        /// `label=key` or `label=&quot;key=value&quot;` of an image label
+        /// </summary>
+        public ImagesListFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public ImagesListFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `reference`=(`&lt;image-name&gt;[:&lt;tag&gt;]`)
+        /// </summary>
+        public ImagesListFilters Reference(string value) => AddFilter("reference", value);
+
+        /// <summary>
+        /// `since`=(`&lt;image-name&gt;[:&lt;tag&gt;]`,  `&lt;image id&gt;` or `&lt;image@digest&gt;`)
+        /// </summary>
+        public ImagesListFilters Since(string value) => AddFilter("since", value);
+
+        /// <summary>
+        /// `until=&lt;timestamp&gt;`
+        /// </summary>
+        public ImagesListFilters Until(string value) => AddFilter("until", value);
+    }
+}
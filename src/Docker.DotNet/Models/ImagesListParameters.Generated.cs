@@ -9,13 +9,17 @@ namespace Docker.DotNet.Models
         [QueryStringMapParameter(typeof(IDictionary<string, IDictionary<string, bool>>), "filters", false)]
         public IDictionary<string, IDictionary<string, bool>>? Filters { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.42 or later.</remarks>
         [QueryStringBoolParameter("shared-size", false)]
+        [MinimumApiVersion("1.42")]
         public bool? SharedSize { get; set; }
 
         [QueryStringBoolParameter("digests", false)]
         public bool? Digests { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.47 or later.</remarks>
         [QueryStringBoolParameter("manifests", false)]
+        [MinimumApiVersion("1.47")]
         public bool? Manifests { get; set; }
     }
 }
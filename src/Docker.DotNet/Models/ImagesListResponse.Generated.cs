@@ -4,37 +4,50 @@ namespace Docker.DotNet.Models
     public class ImagesListResponse // (image.Summary)
     {
         /// <summary>
+        /// <para>
         /// Number of containers using this image. Includes both stopped and running
         /// containers.
-        /// 
+        /// </para>
+        /// <para>
         /// This size is not calculated by default, and depends on which API endpoint
         /// is used. `-1` indicates that the value has not been set / calculated.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Containers")]
         public long Containers { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Date and time at which the image was created as a Unix timestamp
         /// (number of seconds since EPOCH).
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Created")]
+        [JsonConverter(typeof(JsonUnixTimestampConverter))]
         public DateTime Created { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// ID is the content-addressable ID of an image.
-        /// 
+        /// </para>
+        /// <para>
         /// This identifier is a content-addressable digest calculated from the
         /// image&apos;s configuration (which includes the digests of layers used by
         /// the image).
-        /// 
+        /// </para>
+        /// <para>
         /// Note that this digest differs from the `RepoDigests` below, which
         /// holds digests of image manifests that reference the image.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Id")]
         public string ID { get; set; } = string.Empty;
@@ -44,84 +57,113 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
+        /// <para>
         /// ID of the parent image.
-        /// 
+        /// </para>
+        /// <para>
         /// Depending on how the image was created, this field may be empty and
         /// is only set for images that were built/created locally. This field
         /// is empty if the image was pulled from an image registry.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("ParentId")]
         public string ParentID { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Descriptor is the OCI descriptor of the image target.
         /// It&apos;s only set if the daemon provides a multi-platform image store.
-        /// 
+        /// </para>
+        /// <para>
         /// WARNING: This is experimental and may change at any time without any backward
         /// compatibility.
+        /// </para>
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("Descriptor")]
+        [MinimumApiVersion("1.48")]
         public Descriptor? Descriptor { get; set; }
 
         /// <summary>
+        /// <para>
         /// Manifests is a list of image manifests available in this image.  It
         /// provides a more detailed view of the platform-specific image manifests or
         /// other image-attached data like build attestations.
-        /// 
+        /// </para>
+        /// <para>
         /// WARNING: This is experimental and may change at any time without any backward
         /// compatibility.
+        /// </para>
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.47 or later.</remarks>
         [JsonPropertyName("Manifests")]
+        [MinimumApiVersion("1.47")]
         public IList<ManifestSummary>? Manifests { get; set; }
 
         /// <summary>
+        /// <para>
         /// List of content-addressable digests of locally available image manifests
         /// that the image is referenced from. Multiple manifests can refer to the
         /// same image.
-        /// 
+        /// </para>
+        /// <para>
         /// These digests are usually only available if the image was either pulled
         /// from a registry, or if the image was pushed to a registry, which is when
         /// the manifest is generated and its digest calculated.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("RepoDigests")]
-        public IList<string> RepoDigests { get; set; } = default!;
+        public IList<string>? RepoDigests { get; set; }
 
         /// <summary>
+        /// <para>
         /// List of image names/tags in the local image cache that reference this
         /// image.
-        /// 
+        /// </para>
+        /// <para>
         /// Multiple image tags can refer to the same image, and this list may be
         /// empty if no tags reference the image, in which case the image is
         /// &quot;untagged&quot;, in which case it can still be referenced by its ID.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("RepoTags")]
-        public IList<string> RepoTags { get; set; } = default!;
+        public IList<string>? RepoTags { get; set; }
 
         /// <summary>
+        /// <para>
         /// Total size of image layers that are shared between this image and other
         /// images.
-        /// 
+        /// </para>
+        /// <para>
         /// This size is not calculated by default. `-1` indicates that the value
         /// has not been set / calculated.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("SharedSize")]
         public long SharedSize { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Total size of the image including all layers it is composed of.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Size")]
         public long Size { get; set; } = default!;
@@ -1,7 +0,0 @@
-#nullable enable
-namespace Docker.DotNet.Models
-{
-    public class ImagesLoadResponse // (main.ImageLoadResult)
-    {
-    }
-}
@@ -0,0 +1,29 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="ImagesPruneParameters.Filters"/> of <c>POST /images/prune</c>.
+    /// </summary>
+    public sealed class ImagesPruneFilters : FiltersBuilder<ImagesPruneFilters> // (ImagesPruneParameters.Filters)
+    {
+        /// <summary>
+        /// `dangling=&lt;boolean&gt;` When set to `true` (or `1`), prune only unused *and* untagged images. When set to `false` (or `0`), all unused images are pruned.
+        /// </summary>
+        public ImagesPruneFilters Dangling(bool value) => AddFilter("dangling", value ? "true" : "false");
+
+        /// <summary>
+        /// `until=&lt;string&gt;` Prune images created before this timestamp. The `&lt;timestamp&gt;` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
+        /// </summary>
+        public ImagesPruneFilters Until(string value) => AddFilter("until", value);
+
+        /// <summary>
+        /// `label` (`label=&lt;key&gt;`, `label=&lt;key&gt;=&lt;value&gt;`, `label!=&lt;key&gt;`, or `label!=&lt;key&gt;=&lt;value&gt;`) Prune images with (or without, in case `label!=...` is used) the specified labels.
+        /// </summary>
+        public ImagesPruneFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public ImagesPruneFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+    }
+}
@@ -8,7 +8,7 @@ namespace Docker.DotNet.Models
     public class ImagesPruneResponse // (image.PruneReport)
     {
         [JsonPropertyName("ImagesDeleted")]
-        public IList<ImageDeleteResponse> ImagesDeleted { get; set; } = default!;
+        public IList<ImageDeleteResponse>? ImagesDeleted { get; set; }
 
         [JsonPropertyName("SpaceReclaimed")]
         public ulong SpaceReclaimed { get; set; } = default!;
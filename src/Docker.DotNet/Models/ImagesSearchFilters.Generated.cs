@@ -0,0 +1,24 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="ImagesSearchParameters.Filters"/> of <c>GET /images/search</c>.
+    /// </summary>
+    public sealed class ImagesSearchFilters : FiltersBuilder<ImagesSearchFilters> // (ImagesSearchParameters.Filters)
+    {
+        /// <summary>
+        /// `is-official=(true|false)`
+        /// </summary>
+        public ImagesSearchFilters IsOfficial(bool value) => AddFilter("is-official", value ? "true" : "false");
+
+        /// <summary>
+        /// `stars=&lt;number&gt;` Matches images that has at least &apos;number&apos; stars.
+        /// </summary>
+        public ImagesSearchFilters Stars(string value) => AddFilter("stars", value);
+
+        /// <summary>
+        /// `stars=&lt;number&gt;` Matches images that has at least &apos;number&apos; stars.
+        /// </summary>
+        public ImagesSearchFilters Stars(int value) => AddFilter("stars", value.ToString(CultureInfo.InvariantCulture));
+    }
+}
@@ -2,35 +2,39 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// IndexInfo contains information about a registry
-    /// 
+    /// </para>
+    /// <para>
     /// RepositoryInfo Examples:
+    /// </para>
+    /// <code>
+    /// {
+    ///   &quot;Index&quot; : {
+    ///     &quot;Name&quot; : &quot;docker.io&quot;,
+    ///     &quot;Mirrors&quot; : [&quot;https://registry-2.docker.io/v1/&quot;, &quot;https://registry-3.docker.io/v1/&quot;],
+    ///     &quot;Secure&quot; : true,
+    ///     &quot;Official&quot; : true,
+    ///   },
+    ///   &quot;RemoteName&quot; : &quot;library/debian&quot;,
+    ///   &quot;LocalName&quot; : &quot;debian&quot;,
+    ///   &quot;CanonicalName&quot; : &quot;docker.io/debian&quot;
+    ///   &quot;Official&quot; : true,
+    /// }
     /// 
-    /// 	{
-    /// 	  &quot;Index&quot; : {
-    /// 	    &quot;Name&quot; : &quot;docker.io&quot;,
-    /// 	    &quot;Mirrors&quot; : [&quot;https://registry-2.docker.io/v1/&quot;, &quot;https://registry-3.docker.io/v1/&quot;],
-    /// 	    &quot;Secure&quot; : true,
-    /// 	    &quot;Official&quot; : true,
-    /// 	  },
-    /// 	  &quot;RemoteName&quot; : &quot;library/debian&quot;,
-    /// 	  &quot;LocalName&quot; : &quot;debian&quot;,
-    /// 	  &quot;CanonicalName&quot; : &quot;docker.io/debian&quot;
-    /// 	  &quot;Official&quot; : true,
-    /// 	}
-    /// 
-    /// 	{
-    /// 	  &quot;Index&quot; : {
-    /// 	    &quot;Name&quot; : &quot;127.0.0.1:5000&quot;,
-    /// 	    &quot;Mirrors&quot; : [],
-    /// 	    &quot;Secure&quot; : false,
-    /// 	    &quot;Official&quot; : false,
-    /// 	  },
-    /// 	  &quot;RemoteName&quot; : &quot;user/repo&quot;,
-    /// 	  &quot;LocalName&quot; : &quot;127.0.0.1:5000/user/repo&quot;,
-    /// 	  &quot;CanonicalName&quot; : &quot;127.0.0.1:5000/user/repo&quot;,
-    /// 	  &quot;Official&quot; : false,
-    /// 	}
+    /// {
+    ///   &quot;Index&quot; : {
+    ///     &quot;Name&quot; : &quot;127.0.0.1:5000&quot;,
+    ///     &quot;Mirrors&quot; : [],
+    ///     &quot;Secure&quot; : false,
+    ///     &quot;Official&quot; : false,
+    ///   },
+    ///   &quot;RemoteName&quot; : &quot;user/repo&quot;,
+    ///   &quot;LocalName&quot; : &quot;127.0.0.1:5000/user/repo&quot;,
+    ///   &quot;CanonicalName&quot; : &quot;127.0.0.1:5000/user/repo&quot;,
+    ///   &quot;Official&quot; : false,
+    /// }
+    /// </code>
     /// </summary>
     public class IndexInfo // (registry.IndexInfo)
     {
@@ -44,7 +48,7 @@ namespace Docker.DotNet.Models
         /// Mirrors is a list of mirrors, expressed as URIs
         /// </summary>
         [JsonPropertyName("Mirrors")]
-        public IList<string> Mirrors { get; set; } = default!;
+        public IList<string>? Mirrors { get; set; }
 
         /// <summary>
         /// Secure is set to false if the registry is part of the list of
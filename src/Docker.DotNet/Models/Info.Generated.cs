@@ -13,7 +13,7 @@ namespace Docker.DotNet.Models
         public string NodeAddr { get; set; } = string.Empty;
 
         [JsonPropertyName("LocalNodeState")]
-        public string LocalNodeState { get; set; } = string.Empty;
+        public LocalNodeState? LocalNodeState { get; set; }
 
         [JsonPropertyName("ControlAvailable")]
         public bool ControlAvailable { get; set; } = default!;
@@ -22,13 +22,13 @@ namespace Docker.DotNet.Models
         public string Error { get; set; } = string.Empty;
 
         [JsonPropertyName("RemoteManagers")]
-        public IList<Peer> RemoteManagers { get; set; } = default!;
+        public IList<Peer>? RemoteManagers { get; set; }
 
         [JsonPropertyName("Nodes")]
-        public long? Nodes { get; set; }
+        public long Nodes { get; set; } = default!;
 
         [JsonPropertyName("Managers")]
-        public long? Managers { get; set; }
+        public long Managers { get; set; } = default!;
 
         [JsonPropertyName("Cluster")]
         public ClusterInfo? Cluster { get; set; }
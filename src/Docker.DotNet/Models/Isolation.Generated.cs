@@ -0,0 +1,35 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Isolation represents the isolation technology of a container. The supported
+    /// values are platform specific
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<Isolation>))]
+    public enum Isolation // (container.Isolation)
+    {
+        /// <summary>
+        /// IsolationEmpty is unspecified (same behavior as default)
+        /// </summary>
+        [EnumMember(Value = "")]
+        Undefined,
+
+        /// <summary>
+        /// IsolationDefault is the default isolation mode on current daemon
+        /// </summary>
+        [EnumMember(Value = "default")]
+        Default,
+
+        /// <summary>
+        /// IsolationProcess is process isolation mode
+        /// </summary>
+        [EnumMember(Value = "process")]
+        Process,
+
+        /// <summary>
+        /// IsolationHyperV is HyperV isolation mode
+        /// </summary>
+        [EnumMember(Value = "hyperv")]
+        Hyperv
+    }
+}
@@ -8,9 +8,9 @@ namespace Docker.DotNet.Models
     public class JSONError // (jsonstream.Error)
     {
         [JsonPropertyName("code")]
-        public long? Code { get; set; }
+        public long Code { get; set; } = default!;
 
         [JsonPropertyName("message")]
-        public string? Message { get; set; }
+        public string Message { get; set; } = string.Empty;
     }
 }
@@ -9,16 +9,16 @@ namespace Docker.DotNet.Models
     public class JSONMessage // (jsonstream.Message)
     {
         [JsonPropertyName("stream")]
-        public string? Stream { get; set; }
+        public string Stream { get; set; } = string.Empty;
 
         [JsonPropertyName("status")]
-        public string? Status { get; set; }
+        public string Status { get; set; } = string.Empty;
 
         [JsonPropertyName("progressDetail")]
         public JSONProgress? Progress { get; set; }
 
         [JsonPropertyName("id")]
-        public string? ID { get; set; }
+        public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("errorDetail")]
         public JSONError? Error { get; set; }
@@ -27,6 +27,6 @@ namespace Docker.DotNet.Models
         /// Aux contains out-of-band data, such as digests for push signing and image id after building.
         /// </summary>
         [JsonPropertyName("aux")]
-        public ObjectExtensionData? Aux { get; set; }
+        public JSONMessageAux? Aux { get; set; }
     }
 }
@@ -0,0 +1,87 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Aux contains out-of-band data, such as digests for push signing and image id after building.
+    /// </summary>
+    /// <remarks>
+    /// A JSON object is read as the first of these types it has the property of, anything else as <see cref="JSONMessageAuxOther"/>:
+    /// <list type="bullet">
+    /// <item><description><see cref="ManifestPushedInsteadOfIndexNote"/>: <c>manifestPushedInsteadOfIndex</c></description></item>
+    /// <item><description><see cref="ContentMissingNote"/>: <c>contentMissing</c></description></item>
+    /// <item><description><see cref="PushResult"/>: <c>Digest</c></description></item>
+    /// <item><description><see cref="BuildResult"/>: <c>ID</c></description></item>
+    /// </list>
+    /// </remarks>
+    [JsonConverter(typeof(JSONMessageAuxJsonConverter))]
+    public abstract class JSONMessageAux // (jsonstream.Message.Aux)
+    {
+    }
+
+    /// <summary>
+    /// A <see cref="JSONMessageAux"/> that is none of its known types.
+    /// </summary>
+    public sealed class JSONMessageAuxOther : JSONMessageAux
+    {
+        public JsonElement Value { get; set; }
+    }
+
+    internal sealed class JSONMessageAuxJsonConverter : JsonConverter<JSONMessageAux>
+    {
+        public override JSONMessageAux? Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)
+        {
+            using var document = JsonDocument.ParseValue(ref reader);
+
+            var element = document.RootElement;
+
+            if (element.ValueKind == JsonValueKind.Object)
+            {
+                if (element.TryGetProperty("manifestPushedInsteadOfIndex", out _))
+                {
+                    return element.Deserialize((JsonTypeInfo<ManifestPushedInsteadOfIndexNote>)options.GetTypeInfo(typeof(ManifestPushedInsteadOfIndexNote)));
+                }
+
+                if (element.TryGetProperty("contentMissing", out _))
+                {
+                    return element.Deserialize((JsonTypeInfo<ContentMissingNote>)options.GetTypeInfo(typeof(ContentMissingNote)));
+                }
+
+                if (element.TryGetProperty("Digest", out _))
+                {
+                    return element.Deserialize((JsonTypeInfo<PushResult>)options.GetTypeInfo(typeof(PushResult)));
+                }
+
+                if (element.TryGetProperty("ID", out _))
+                {
+                    return element.Deserialize((JsonTypeInfo<BuildResult>)options.GetTypeInfo(typeof(BuildResult)));
+                }
+            }
+
+            return new JSONMessageAuxOther { Value = element.Clone() };
+        }
+
+        public override void Write(Utf8JsonWriter writer, JSONMessageAux value, JsonSerializerOptions options)
+        {
+            switch (value)
+            {
+                case ManifestPushedInsteadOfIndexNote member:
+                    System.Text.Json.JsonSerializer.Serialize(writer, member, (JsonTypeInfo<ManifestPushedInsteadOfIndexNote>)options.GetTypeInfo(typeof(ManifestPushedInsteadOfIndexNote)));
+                    break;
+                case ContentMissingNote member:
+                    System.Text.Json.JsonSerializer.Serialize(writer, member, (JsonTypeInfo<ContentMissingNote>)options.GetTypeInfo(typeof(ContentMissingNote)));
+                    break;
+                case PushResult member:
+                    System.Text.Json.JsonSerializer.Serialize(writer, member, (JsonTypeInfo<PushResult>)options.GetTypeInfo(typeof(PushResult)));
+                    break;
+                case BuildResult member:
+                    System.Text.Json.JsonSerializer.Serialize(writer, member, (JsonTypeInfo<BuildResult>)options.GetTypeInfo(typeof(BuildResult)));
+                    break;
+                case JSONMessageAuxOther other:
+                    other.Value.WriteTo(writer);
+                    break;
+                default:
+                    throw new JsonException($"Cannot write {value.GetType()} as JSONMessageAux.");
+            }
+        }
+    }
+}
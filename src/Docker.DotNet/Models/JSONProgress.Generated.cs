@@ -9,31 +9,35 @@ namespace Docker.DotNet.Models
         /// <summary>
         /// Current is the current status and value of the progress made towards Total.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.36 or later.</remarks>
         [JsonPropertyName("current")]
-        public long? Current { get; set; }
+        [MinimumApiVersion("1.36")]
+        public long Current { get; set; } = default!;
 
         /// <summary>
         /// Total is the end value describing when we made 100% progress for an operation.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.36 or later.</remarks>
         [JsonPropertyName("total")]
-        public long? Total { get; set; }
+        [MinimumApiVersion("1.36")]
+        public long Total { get; set; } = default!;
 
         /// <summary>
         /// Start is the initial value for the operation.
         /// </summary>
         [JsonPropertyName("start")]
-        public long? Start { get; set; }
+        public long Start { get; set; } = default!;
 
         /// <summary>
         /// HideCounts. if true, hides the progress count indicator (xB/yB).
         /// </summary>
         [JsonPropertyName("hidecounts")]
-        public bool? HideCounts { get; set; }
+        public bool HideCounts { get; set; } = default!;
 
         /// <summary>
         /// Units is the unit to print for progress. It defaults to &quot;bytes&quot; if empty.
         /// </summary>
         [JsonPropertyName("units")]
-        public string? Units { get; set; }
+        public string Units { get; set; } = string.Empty;
     }
 }
@@ -7,15 +7,18 @@ namespace Docker.DotNet.Models
     public class JobStatus // (swarm.JobStatus)
     {
         /// <summary>
+        /// <para>
         /// JobIteration is a value increased each time a Job is executed,
         /// successfully or otherwise. &quot;Executed&quot;, in this case, means the job as a
         /// whole has been started, not that an individual Task has been launched. A
         /// job is &quot;Executed&quot; when its ServiceSpec is updated. JobIteration can be
         /// used to disambiguate Tasks belonging to different executions of a job.
-        /// 
+        /// </para>
+        /// <para>
         /// Though JobIteration will increase with each subsequent execution, it may
         /// not necessarily increase by 1, and so JobIteration should not be used to
         /// keep track of the number of times a job has been executed.
+        /// </para>
         /// </summary>
         [JsonPropertyName("JobIteration")]
         public Version JobIteration { get; set; } = default!;
@@ -25,6 +28,7 @@ namespace Docker.DotNet.Models
         /// Swarm manager.
         /// </summary>
         [JsonPropertyName("LastExecution")]
-        public DateTime? LastExecution { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? LastExecution { get; set; }
     }
 }
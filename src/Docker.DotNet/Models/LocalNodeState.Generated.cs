@@ -0,0 +1,43 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// LocalNodeState represents the state of the local node.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<LocalNodeState>))]
+    public enum LocalNodeState // (swarm.LocalNodeState)
+    {
+        /// <summary>
+        /// LocalNodeStateInactive INACTIVE
+        /// </summary>
+        [EnumMember(Value = "inactive")]
+        Inactive,
+
+        /// <summary>
+        /// LocalNodeStatePending PENDING
+        /// </summary>
+        [EnumMember(Value = "pending")]
+        Pending,
+
+        /// <summary>
+        /// LocalNodeStateActive ACTIVE
+        /// </summary>
+        [EnumMember(Value = "active")]
+        Active,
+
+        /// <summary>
+        /// LocalNodeStateError ERROR
+        /// </summary>
+        [EnumMember(Value = "error")]
+        Error,
+
+        /// <summary>
+        /// LocalNodeStateLocked LOCKED
+        /// </summary>
+        [EnumMember(Value = "locked")]
+        Locked,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -7,12 +7,12 @@ namespace Docker.DotNet.Models
     public class ManagerStatus // (swarm.ManagerStatus)
     {
         [JsonPropertyName("Leader")]
-        public bool? Leader { get; set; }
+        public bool Leader { get; set; } = default!;
 
         [JsonPropertyName("Reachability")]
-        public string? Reachability { get; set; }
+        public Reachability? Reachability { get; set; }
 
         [JsonPropertyName("Addr")]
-        public string? Addr { get; set; }
+        public string Addr { get; set; } = string.Empty;
     }
 }
@@ -0,0 +1,19 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    [JsonConverter(typeof(JsonEnumMemberConverter<ManifestKind>))]
+    public enum ManifestKind // (image.ManifestKind)
+    {
+        [EnumMember(Value = "image")]
+        Image,
+
+        [EnumMember(Value = "attestation")]
+        Attestation,
+
+        [EnumMember(Value = "unknown")]
+        Unknown,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -0,0 +1,29 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// ManifestPushedInsteadOfIndex is a note that is sent when a manifest is pushed
+    /// instead of an index.  It is sent when the pushed image is an multi-platform
+    /// index, but the whole index couldn&apos;t be pushed.
+    /// </summary>
+    public class ManifestPushedInsteadOfIndexNote : JSONMessageAux // (auxprogress.ManifestPushedInsteadOfIndex)
+    {
+        /// <summary>
+        /// Always true
+        /// </summary>
+        [JsonPropertyName("manifestPushedInsteadOfIndex")]
+        public bool ManifestPushedInsteadOfIndex { get; set; } = default!;
+
+        /// <summary>
+        /// OriginalIndex is the descriptor of the original image index.
+        /// </summary>
+        [JsonPropertyName("originalIndex")]
+        public Descriptor OriginalIndex { get; set; } = default!;
+
+        /// <summary>
+        /// SelectedManifest is the descriptor of the manifest that was pushed instead.
+        /// </summary>
+        [JsonPropertyName("selectedManifest")]
+        public Descriptor SelectedManifest { get; set; } = default!;
+    }
+}
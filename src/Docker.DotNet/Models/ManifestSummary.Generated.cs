@@ -4,47 +4,62 @@ namespace Docker.DotNet.Models
     public class ManifestSummary // (image.ManifestSummary)
     {
         /// <summary>
+        /// <para>
         /// ID is the content-addressable ID of an image and is the same as the
         /// digest of the image manifest.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("ID")]
         public string ID { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Descriptor is the OCI descriptor of the image.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Descriptor")]
         public Descriptor Descriptor { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Indicates whether all the child content (image config, layers) is
         /// fully available locally
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Available")]
         public bool Available { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Size is the size information of the content related to this manifest.
         /// Note: These sizes only take the locally available content into account.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Size")]
         public ManifestSummarySize Size { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Kind is the kind of the image manifest.
-        /// 
+        /// </para>
+        /// <para>
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Kind")]
-        public string Kind { get; set; } = string.Empty;
+        public ManifestKind? Kind { get; set; }
 
         /// <summary>
         /// Present only if Kind == ManifestKindImage.
@@ -11,13 +11,13 @@ namespace Docker.DotNet.Models
         /// current res_counter usage for memory
         /// </summary>
         [JsonPropertyName("usage")]
-        public ulong? Usage { get; set; }
+        public ulong Usage { get; set; } = default!;
 
         /// <summary>
         /// maximum usage ever recorded.
         /// </summary>
         [JsonPropertyName("max_usage")]
-        public ulong? MaxUsage { get; set; }
+        public ulong MaxUsage { get; set; } = default!;
 
         /// <summary>
         /// TODO(vishh): Export these as stronger types.
@@ -30,27 +30,27 @@ namespace Docker.DotNet.Models
         /// number of times memory usage hits limits.
         /// </summary>
         [JsonPropertyName("failcnt")]
-        public ulong? Failcnt { get; set; }
+        public ulong Failcnt { get; set; } = default!;
 
         [JsonPropertyName("limit")]
-        public ulong? Limit { get; set; }
+        public ulong Limit { get; set; } = default!;
 
         /// <summary>
         /// committed bytes
         /// </summary>
         [JsonPropertyName("commitbytes")]
-        public ulong? Commit { get; set; }
+        public ulong Commit { get; set; } = default!;
 
         /// <summary>
         /// peak committed bytes
         /// </summary>
         [JsonPropertyName("commitpeakbytes")]
-        public ulong? CommitPeak { get; set; }
+        public ulong CommitPeak { get; set; } = default!;
 
         /// <summary>
         /// private working set
         /// </summary>
         [JsonPropertyName("privateworkingset")]
-        public ulong? PrivateWorkingSet { get; set; }
+        public ulong PrivateWorkingSet { get; set; } = default!;
     }
 }
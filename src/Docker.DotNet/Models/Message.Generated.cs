@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class Message // (events.Message)
     {
         [JsonPropertyName("Type")]
-        public string Type { get; set; } = string.Empty;
+        public EventType? Type { get; set; }
 
         [JsonPropertyName("Action")]
         public string Action { get; set; } = string.Empty;
@@ -19,12 +19,12 @@ namespace Docker.DotNet.Models
         /// Engine events are local scope. Cluster events are swarm scope.
         /// </summary>
         [JsonPropertyName("scope")]
-        public string? Scope { get; set; }
+        public string Scope { get; set; } = string.Empty;
 
         [JsonPropertyName("time")]
-        public long? Time { get; set; }
+        public long Time { get; set; } = default!;
 
         [JsonPropertyName("timeNano")]
-        public long? TimeNano { get; set; }
+        public long TimeNano { get; set; } = default!;
     }
 }
@@ -7,12 +7,14 @@ namespace Docker.DotNet.Models
     public class Meta // (swarm.Meta)
     {
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
     }
 }
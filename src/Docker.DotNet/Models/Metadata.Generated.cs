@@ -10,6 +10,7 @@ namespace Docker.DotNet.Models
         /// LastTagTime is the date and time at which the image was last tagged.
         /// </summary>
         [JsonPropertyName("LastTagTime")]
-        public DateTime? LastTagTime { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? LastTagTime { get; set; }
     }
 }
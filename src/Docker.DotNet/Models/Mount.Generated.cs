@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class Mount // (mount.Mount)
     {
         [JsonPropertyName("Type")]
-        public string? Type { get; set; }
+        public MountType? Type { get; set; }
 
         /// <summary>
         /// Source specifies the name of the mount. Depending on mount type, this
@@ -15,19 +15,21 @@ namespace Docker.DotNet.Models
         /// Source is not supported for tmpfs (must be an empty value)
         /// </summary>
         [JsonPropertyName("Source")]
-        public string? Source { get; set; }
+        public string Source { get; set; } = string.Empty;
 
         [JsonPropertyName("Target")]
-        public string? Target { get; set; }
+        public string Target { get; set; } = string.Empty;
 
         /// <summary>
         /// attempts recursive read-only if possible
         /// </summary>
         [JsonPropertyName("ReadOnly")]
-        public bool? ReadOnly { get; set; }
+        public bool ReadOnly { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.29 or later.</remarks>
         [JsonPropertyName("Consistency")]
-        public string? Consistency { get; set; }
+        [MinimumApiVersion("1.29")]
+        public Consistency? Consistency { get; set; }
 
         [JsonPropertyName("BindOptions")]
         public BindOptions? BindOptions { get; set; }
@@ -35,7 +37,9 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("VolumeOptions")]
         public VolumeOptions? VolumeOptions { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.48 or later.</remarks>
         [JsonPropertyName("ImageOptions")]
+        [MinimumApiVersion("1.48")]
         public ImageOptions? ImageOptions { get; set; }
 
         [JsonPropertyName("TmpfsOptions")]
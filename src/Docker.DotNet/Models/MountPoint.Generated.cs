@@ -8,25 +8,28 @@ namespace Docker.DotNet.Models
     public class MountPoint // (container.MountPoint)
     {
         /// <summary>
-        /// Type is the type of mount, see [mount.Type] definitions for details.
+        /// Type is the type of mount, see <see cref="MountType"/> definitions for details.
         /// </summary>
         [JsonPropertyName("Type")]
-        public string? Type { get; set; }
+        public MountType? Type { get; set; }
 
         /// <summary>
         /// Name is the name reference to the underlying data defined by `Source`
         /// e.g., the volume name.
         /// </summary>
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Source is the source location of the mount.
-        /// 
+        /// </para>
+        /// <para>
         /// For volumes, this contains the storage location of the volume (within
         /// `/var/lib/docker/volumes/`). For bind-mounts, and `npipe`, this contains
         /// the source (host) part of the bind-mount. For `tmpfs` mount points, this
         /// field is empty.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Source")]
         public string Source { get; set; } = string.Empty;
@@ -42,13 +45,16 @@ namespace Docker.DotNet.Models
         /// Driver is the volume driver used to create the volume (if it is a volume).
         /// </summary>
         [JsonPropertyName("Driver")]
-        public string? Driver { get; set; }
+        public string Driver { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Mode is a comma separated list of options supplied by the user when
         /// creating the bind/volume mount.
-        /// 
+        /// </para>
+        /// <para>
         /// The default is platform-specific (`&quot;z&quot;` on Linux, empty on Windows).
+        /// </para>
         /// </summary>
         [JsonPropertyName("Mode")]
         public string Mode { get; set; } = string.Empty;
@@ -60,14 +66,17 @@ namespace Docker.DotNet.Models
         public bool RW { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Propagation describes how mounts are propagated from the host into the
         /// mount point, and vice-versa. Refer to the Linux kernel documentation
         /// for details:
-        /// https://www.kernel.org/doc/Documentation/filesystems/sharedsubtree.txt
-        /// 
+        /// <see href="https://www.kernel.org/doc/Documentation/filesystems/sharedsubtree.txt"/>
+        /// </para>
+        /// <para>
         /// This field is not used on Windows.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Propagation")]
-        public string Propagation { get; set; } = string.Empty;
+        public Propagation? Propagation { get; set; }
     }
 }
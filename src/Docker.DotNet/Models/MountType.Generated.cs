@@ -0,0 +1,49 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Type represents the type of a mount.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<MountType>))]
+    public enum MountType // (mount.Type)
+    {
+        /// <summary>
+        /// TypeBind is the type for mounting host dir
+        /// </summary>
+        [EnumMember(Value = "bind")]
+        Bind,
+
+        /// <summary>
+        /// TypeVolume is the type for remote storage volumes
+        /// </summary>
+        [EnumMember(Value = "volume")]
+        Volume,
+
+        /// <summary>
+        /// TypeTmpfs is the type for mounting tmpfs
+        /// </summary>
+        [EnumMember(Value = "tmpfs")]
+        Tmpfs,
+
+        /// <summary>
+        /// TypeNamedPipe is the type for mounting Windows named pipes
+        /// </summary>
+        [EnumMember(Value = "npipe")]
+        Npipe,
+
+        /// <summary>
+        /// TypeCluster is the type for Swarm Cluster Volumes.
+        /// </summary>
+        [EnumMember(Value = "cluster")]
+        Cluster,
+
+        /// <summary>
+        /// TypeImage is the type for mounting another image&apos;s filesystem
+        /// </summary>
+        [EnumMember(Value = "image")]
+        Image,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -10,9 +10,9 @@ namespace Docker.DotNet.Models
     public class NamedGenericResource // (swarm.NamedGenericResource)
     {
         [JsonPropertyName("Kind")]
-        public string? Kind { get; set; }
+        public string Kind { get; set; } = string.Empty;
 
         [JsonPropertyName("Value")]
-        public string? Value { get; set; }
+        public string Value { get; set; } = string.Empty;
     }
 }
@@ -2,67 +2,94 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Network network
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Network
+    /// </para>
     /// </summary>
     public class Network // (network.Network)
     {
         /// <summary>
+        /// <para>
         /// Name of the network.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: my_network
+        /// </para>
         /// </summary>
         [JsonPropertyName("Name")]
         public string Name { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// ID that uniquely identifies a network on a single machine.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: 7d86d31b1478e7cca9ebed7e73aa0fdeec46c5ca29497431d3007d2d9e15ed99
+        /// </para>
         /// </summary>
         [JsonPropertyName("Id")]
         public string ID { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Date and time at which the network was created in
-        /// [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format with nano-seconds.
-        /// 
+        /// [RFC 3339](<see href="https://www.ietf.org/rfc/rfc3339.txt"/>) format with nano-seconds.
+        /// </para>
+        /// <para>
         /// Example: 2016-10-19T04:33:30.360899459Z
+        /// </para>
         /// </summary>
         [JsonPropertyName("Created")]
-        public DateTime Created { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Created { get; set; }
 
         /// <summary>
+        /// <para>
         /// The level at which the network exists (e.g. `swarm` for cluster-wide
         /// or `local` for machine level)
-        /// 
+        /// </para>
+        /// <para>
         /// Example: local
+        /// </para>
         /// </summary>
         [JsonPropertyName("Scope")]
         public string Scope { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// The name of the driver used to create the network (e.g. `bridge`,
         /// `overlay`).
-        /// 
+        /// </para>
+        /// <para>
         /// Example: overlay
+        /// </para>
         /// </summary>
         [JsonPropertyName("Driver")]
         public string Driver { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Whether the network was created with IPv4 enabled.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: true
+        /// </para>
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.47 or later.</remarks>
         [JsonPropertyName("EnableIPv4")]
+        [MinimumApiVersion("1.47")]
         public bool EnableIPv4 { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether the network was created with IPv6 enabled.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
         [JsonPropertyName("EnableIPv6")]
         public bool EnableIPv6 { get; set; } = default!;
@@ -74,35 +101,50 @@ namespace Docker.DotNet.Models
         public IPAM IPAM { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether the network is created to only allow internal networking
         /// connectivity.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
         [JsonPropertyName("Internal")]
         public bool Internal { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether a global / swarm scope network is manually attachable by regular
         /// containers from workers in swarm mode.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.27 or later.</remarks>
         [JsonPropertyName("Attachable")]
+        [MinimumApiVersion("1.27")]
         public bool Attachable { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether the network is providing the routing-mesh for the swarm cluster.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.29 or later.</remarks>
         [JsonPropertyName("Ingress")]
+        [MinimumApiVersion("1.29")]
         public bool Ingress { get; set; } = default!;
 
         /// <summary>
         /// config from
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("ConfigFrom")]
+        [MinimumApiVersion("1.41")]
         public ConfigReference ConfigFrom { get; set; } = default!;
 
         /// <summary>
@@ -111,30 +153,40 @@ namespace Docker.DotNet.Models
         /// networks. Config-only networks cannot be used directly to run containers
         /// or services.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("ConfigOnly")]
+        [MinimumApiVersion("1.41")]
         public bool ConfigOnly { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Network-specific options uses when creating the network.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;com.docker.network.bridge.default_bridge&quot;:&quot;true&quot;,&quot;com.docker.network.bridge.enable_icc&quot;:&quot;true&quot;,&quot;com.docker.network.bridge.enable_ip_masquerade&quot;:&quot;true&quot;,&quot;com.docker.network.bridge.host_binding_ipv4&quot;:&quot;0.0.0.0&quot;,&quot;com.docker.network.bridge.name&quot;:&quot;docker0&quot;,&quot;com.docker.network.driver.mtu&quot;:&quot;1500&quot;}
+        /// </para>
         /// </summary>
         [JsonPropertyName("Options")]
-        public IDictionary<string, string> Options { get; set; } = default!;
+        public IDictionary<string, string>? Options { get; set; }
 
         /// <summary>
+        /// <para>
         /// Metadata specific to the network being created.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;com.example.some-label&quot;:&quot;some-value&quot;,&quot;com.example.some-other-label&quot;:&quot;some-other-value&quot;}
+        /// </para>
         /// </summary>
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
         /// List of peer nodes for an overlay network. This field is only present
         /// for overlay networks, and omitted for other network types.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("Peers")]
+        [MinimumApiVersion("1.41")]
         public IList<PeerInfo>? Peers { get; set; }
     }
 }
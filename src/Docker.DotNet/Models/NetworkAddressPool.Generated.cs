@@ -2,7 +2,7 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
-    /// NetworkAddressPool is a temp struct used by [Info] struct.
+    /// NetworkAddressPool is a temp struct used by <see cref="SystemInfoResponse"/> struct.
     /// </summary>
     public class NetworkAddressPool // (system.NetworkAddressPool)
     {
@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class NetworkAttachment // (swarm.NetworkAttachment)
     {
         [JsonPropertyName("Network")]
-        public SwarmNetwork? Network { get; set; }
+        public SwarmNetwork Network { get; set; } = default!;
 
         /// <summary>
         /// Addresses contains the IP addresses associated with the endpoint in the network.
@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class NetworkAttachmentConfig // (swarm.NetworkAttachmentConfig)
     {
         [JsonPropertyName("Target")]
-        public string? Target { get; set; }
+        public string Target { get; set; } = string.Empty;
 
         [JsonPropertyName("Aliases")]
         public IList<string>? Aliases { get; set; }
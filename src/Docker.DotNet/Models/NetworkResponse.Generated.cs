@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Inspect The body of the &quot;get network&quot; http response message.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Inspect
+    /// </para>
     /// </summary>
     public class NetworkResponse // (network.Inspect)
     {
@@ -36,60 +39,82 @@ namespace Docker.DotNet.Models
         }
 
         /// <summary>
+        /// <para>
         /// Name of the network.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: my_network
+        /// </para>
         /// </summary>
         [JsonPropertyName("Name")]
         public string Name { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// ID that uniquely identifies a network on a single machine.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: 7d86d31b1478e7cca9ebed7e73aa0fdeec46c5ca29497431d3007d2d9e15ed99
+        /// </para>
         /// </summary>
         [JsonPropertyName("Id")]
         public string ID { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Date and time at which the network was created in
-        /// [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format with nano-seconds.
-        /// 
+        /// [RFC 3339](<see href="https://www.ietf.org/rfc/rfc3339.txt"/>) format with nano-seconds.
+        /// </para>
+        /// <para>
         /// Example: 2016-10-19T04:33:30.360899459Z
+        /// </para>
         /// </summary>
         [JsonPropertyName("Created")]
-        public DateTime Created { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Created { get; set; }
 
         /// <summary>
+        /// <para>
         /// The level at which the network exists (e.g. `swarm` for cluster-wide
         /// or `local` for machine level)
-        /// 
+        /// </para>
+        /// <para>
         /// Example: local
+        /// </para>
         /// </summary>
         [JsonPropertyName("Scope")]
         public string Scope { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// The name of the driver used to create the network (e.g. `bridge`,
         /// `overlay`).
-        /// 
+        /// </para>
+        /// <para>
         /// Example: overlay
+        /// </para>
         /// </summary>
         [JsonPropertyName("Driver")]
         public string Driver { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Whether the network was created with IPv4 enabled.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("EnableIPv4")]
         public bool EnableIPv4 { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether the network was created with IPv6 enabled.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
         [JsonPropertyName("EnableIPv6")]
         public bool EnableIPv6 { get; set; } = default!;
@@ -101,27 +126,36 @@ namespace Docker.DotNet.Models
         public IPAM IPAM { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether the network is created to only allow internal networking
         /// connectivity.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
         [JsonPropertyName("Internal")]
         public bool Internal { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether a global / swarm scope network is manually attachable by regular
         /// containers from workers in swarm mode.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
         [JsonPropertyName("Attachable")]
         public bool Attachable { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Whether the network is providing the routing-mesh for the swarm cluster.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: false
+        /// </para>
         /// </summary>
         [JsonPropertyName("Ingress")]
         public bool Ingress { get; set; } = default!;
@@ -142,20 +176,26 @@ namespace Docker.DotNet.Models
         public bool ConfigOnly { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Network-specific options uses when creating the network.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;com.docker.network.bridge.default_bridge&quot;:&quot;true&quot;,&quot;com.docker.network.bridge.enable_icc&quot;:&quot;true&quot;,&quot;com.docker.network.bridge.enable_ip_masquerade&quot;:&quot;true&quot;,&quot;com.docker.network.bridge.host_binding_ipv4&quot;:&quot;0.0.0.0&quot;,&quot;com.docker.network.bridge.name&quot;:&quot;docker0&quot;,&quot;com.docker.network.driver.mtu&quot;:&quot;1500&quot;}
+        /// </para>
         /// </summary>
         [JsonPropertyName("Options")]
-        public IDictionary<string, string> Options { get; set; } = default!;
+        public IDictionary<string, string>? Options { get; set; }
 
         /// <summary>
+        /// <para>
         /// Metadata specific to the network being created.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;com.example.some-label&quot;:&quot;some-value&quot;,&quot;com.example.some-other-label&quot;:&quot;some-other-value&quot;}
+        /// </para>
         /// </summary>
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
         /// List of peer nodes for an overlay network. This field is only present
@@ -165,12 +205,15 @@ namespace Docker.DotNet.Models
         public IList<PeerInfo>? Peers { get; set; }
 
         /// <summary>
+        /// <para>
         /// Contains endpoints attached to the network.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;19a4d5d687db25203351ed79d478946f861258f018fe384f229f2efa4b23513c&quot;:{&quot;EndpointID&quot;:&quot;628cadb8bcb92de107b2a1e516cbffe463e321f548feb37697cce00ad694f21a&quot;,&quot;IPv4Address&quot;:&quot;172.19.0.2/16&quot;,&quot;IPv6Address&quot;:&quot;&quot;,&quot;MacAddress&quot;:&quot;02:42:ac:13:00:02&quot;,&quot;Name&quot;:&quot;test&quot;}}
+        /// </para>
         /// </summary>
         [JsonPropertyName("Containers")]
-        public IDictionary<string, EndpointResource> Containers { get; set; } = default!;
+        public IDictionary<string, EndpointResource>? Containers { get; set; }
 
         /// <summary>
         /// List of services using the network. This field is only present for
@@ -19,12 +19,12 @@ namespace Docker.DotNet.Models
         public string SandboxKey { get; set; } = string.Empty;
 
         /// <summary>
-        /// Ports is a collection of [network.PortBinding] indexed by [network.Port]
+        /// Ports is a collection of <see cref="PortBinding"/> indexed by <see href="https://pkg.go.dev/github.com/moby/moby/api/types/network#Port">network.Port</see>
         /// </summary>
         [JsonPropertyName("Ports")]
-        public IDictionary<string, IList<PortBinding>> Ports { get; set; } = default!;
+        public IDictionary<string, IList<PortBinding>>? Ports { get; set; }
 
         [JsonPropertyName("Networks")]
-        public IDictionary<string, EndpointSettings> Networks { get; set; } = default!;
+        public IDictionary<string, EndpointSettings>? Networks { get; set; }
     }
 }
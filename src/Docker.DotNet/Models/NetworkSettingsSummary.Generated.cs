@@ -8,6 +8,6 @@ namespace Docker.DotNet.Models
     public class NetworkSettingsSummary // (container.NetworkSettingsSummary)
     {
         [JsonPropertyName("Networks")]
-        public IDictionary<string, EndpointSettings> Networks { get; set; } = default!;
+        public IDictionary<string, EndpointSettings>? Networks { get; set; }
     }
 }
@@ -20,25 +20,25 @@ namespace Docker.DotNet.Models
         }
 
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         [JsonPropertyName("DriverConfiguration")]
         public SwarmDriver? DriverConfiguration { get; set; }
 
         [JsonPropertyName("IPv6Enabled")]
-        public bool? IPv6Enabled { get; set; }
+        public bool IPv6Enabled { get; set; } = default!;
 
         [JsonPropertyName("Internal")]
-        public bool? Internal { get; set; }
+        public bool Internal { get; set; } = default!;
 
         [JsonPropertyName("Attachable")]
-        public bool? Attachable { get; set; }
+        public bool Attachable { get; set; } = default!;
 
         [JsonPropertyName("Ingress")]
-        public bool? Ingress { get; set; }
+        public bool Ingress { get; set; } = default!;
 
         [JsonPropertyName("IPAMOptions")]
         public IPAMOptions? IPAMOptions { get; set; }
@@ -47,6 +47,6 @@ namespace Docker.DotNet.Models
         public ConfigReference? ConfigFrom { get; set; }
 
         [JsonPropertyName("Scope")]
-        public string? Scope { get; set; }
+        public string Scope { get; set; } = string.Empty;
     }
 }
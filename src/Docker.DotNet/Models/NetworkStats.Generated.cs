@@ -60,12 +60,12 @@ namespace Docker.DotNet.Models
         /// Endpoint ID. Not used on Linux.
         /// </summary>
         [JsonPropertyName("endpoint_id")]
-        public string? EndpointID { get; set; }
+        public string EndpointID { get; set; } = string.Empty;
 
         /// <summary>
         /// Instance ID. Not used on Linux.
         /// </summary>
         [JsonPropertyName("instance_id")]
-        public string? InstanceID { get; set; }
+        public string InstanceID { get; set; } = string.Empty;
     }
 }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Task carries the information about one backend task
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Task
+    /// </para>
     /// </summary>
     public class NetworkTask // (network.Task)
     {
@@ -30,6 +33,6 @@ namespace Docker.DotNet.Models
         /// info
         /// </summary>
         [JsonPropertyName("Info")]
-        public IDictionary<string, string> Info { get; set; } = default!;
+        public IDictionary<string, string>? Info { get; set; }
     }
 }
@@ -67,7 +67,7 @@ namespace Docker.DotNet.Models
         public bool ConfigOnly { get; set; } = default!;
 
         /// <summary>
-        /// ConfigFrom specifies the source which will provide the configuration for this network. The specified network must be a config-only network; see [CreateOptions.ConfigOnly].
+        /// ConfigFrom specifies the source which will provide the configuration for this network. The specified network must be a config-only network; see <see href="https://pkg.go.dev/github.com/moby/moby/api/types/network#CreateOptions.ConfigOnly">network.CreateOptions.ConfigOnly</see>.
         /// </summary>
         [JsonPropertyName("ConfigFrom")]
         public ConfigReference? ConfigFrom { get; set; }
@@ -76,12 +76,12 @@ namespace Docker.DotNet.Models
         /// Options specifies the network-specific options to use for when creating the network.
         /// </summary>
         [JsonPropertyName("Options")]
-        public IDictionary<string, string> Options { get; set; } = default!;
+        public IDictionary<string, string>? Options { get; set; }
 
         /// <summary>
         /// Labels holds metadata specific to the network being created.
         /// </summary>
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
     }
 }
@@ -2,11 +2,13 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// CreateResponse NetworkCreateResponse
-    /// 
-    /// # OK response to NetworkCreate operation
-    /// 
+    /// </para>
+    /// <para><b>OK response to NetworkCreate operation</b></para>
+    /// <para>
     /// swagger:model CreateResponse
+    /// </para>
     /// </summary>
     public class NetworksCreateResponse // (network.CreateResponse)
     {
@@ -0,0 +1,24 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="NetworksDeleteUnusedParameters.Filters"/> of <c>POST /networks/prune</c>.
+    /// </summary>
+    public sealed class NetworksDeleteUnusedFilters : FiltersBuilder<NetworksDeleteUnusedFilters> // (NetworksDeleteUnusedParameters.Filters)
+    {
+        /// <summary>
+        /// `until=&lt;timestamp&gt;` Prune networks created before this timestamp. The `&lt;timestamp&gt;` can be Unix timestamps, date formatted timestamps, or Go duration strings (e.g. `10m`, `1h30m`) computed relative to the daemon machine’s time.
+        /// </summary>
+        public NetworksDeleteUnusedFilters Until(string value) => AddFilter("until", value);
+
+        /// <summary>
+        /// `label` (`label=&lt;key&gt;`, `label=&lt;key&gt;=&lt;value&gt;`, `label!=&lt;key&gt;`, or `label!=&lt;key&gt;=&lt;value&gt;`) Prune networks with (or without, in case `label!=...` is used) the specified labels.
+        /// </summary>
+        public NetworksDeleteUnusedFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public NetworksDeleteUnusedFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+    }
+}
@@ -0,0 +1,49 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="NetworksListParameters.Filters"/> of <c>GET /networks</c>.
+    /// </summary>
+    public sealed class NetworksListFilters : FiltersBuilder<NetworksListFilters> // (NetworksListParameters.Filters)
+    {
+        /// <summary>
+        /// `dangling=&lt;boolean&gt;` When set to `true` (or `1`), returns all networks that are not in use by a container. When set to `false` (or `0`), only networks that are in use by one or more containers are returned.
+        /// </summary>
+        public NetworksListFilters Dangling(bool value) => AddFilter("dangling", value ? "true" : "false");
+
+        /// <summary>
+        /// `driver=&lt;driver-name&gt;` Matches a network&apos;s driver.
+        /// </summary>
+        public NetworksListFilters Driver(string value) => AddFilter("driver", value);
+
+        /// <summary>
+        /// `id=&lt;network-id&gt;` Matches all or part of a network ID.
+        /// </summary>
+        public NetworksListFilters Id(string value) => AddFilter("id", value);
+
+        /// <summary>
+        /// `label=&lt;key&gt;` or `label=&lt;key&gt;=&lt;value&gt;` of a network label.
+        /// </summary>
+        public NetworksListFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public NetworksListFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `name=&lt;network-name&gt;` Matches all or part of a network name.
+        /// </summary>
+        public NetworksListFilters Name(string value) => AddFilter("name", value);
+
+        /// <summary>
+        /// `scope=[&quot;swarm&quot;|&quot;global&quot;|&quot;local&quot;]` Filters networks by scope (`swarm`, `global`, or `local`).
+        /// </summary>
+        public NetworksListFilters Scope(string value) => AddFilter("scope", value);
+
+        /// <summary>
+        /// `type=[&quot;custom&quot;|&quot;builtin&quot;]` Filters networks by type. The `custom` keyword returns all user-defined networks.
+        /// </summary>
+        public NetworksListFilters Type(string value) => AddFilter("type", value);
+    }
+}
@@ -8,6 +8,6 @@ namespace Docker.DotNet.Models
     public class NetworksPruneResponse // (network.PruneReport)
     {
         [JsonPropertyName("NetworksDeleted")]
-        public IList<string> NetworksDeleted { get; set; } = default!;
+        public IList<string>? NetworksDeleted { get; set; }
     }
 }
@@ -0,0 +1,31 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// NodeAvailability represents the availability of a node.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<NodeAvailability>))]
+    public enum NodeAvailability // (swarm.NodeAvailability)
+    {
+        /// <summary>
+        /// NodeAvailabilityActive ACTIVE
+        /// </summary>
+        [EnumMember(Value = "active")]
+        Active,
+
+        /// <summary>
+        /// NodeAvailabilityPause PAUSE
+        /// </summary>
+        [EnumMember(Value = "pause")]
+        Pause,
+
+        /// <summary>
+        /// NodeAvailabilityDrain DRAIN
+        /// </summary>
+        [EnumMember(Value = "drain")]
+        Drain,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
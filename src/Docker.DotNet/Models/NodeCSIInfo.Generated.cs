@@ -10,21 +10,21 @@ namespace Docker.DotNet.Models
         /// PluginName is the name of the CSI plugin.
         /// </summary>
         [JsonPropertyName("PluginName")]
-        public string? PluginName { get; set; }
+        public string PluginName { get; set; } = string.Empty;
 
         /// <summary>
         /// NodeID is the ID of the node as reported by the CSI plugin. This is
         /// different from the swarm node ID.
         /// </summary>
         [JsonPropertyName("NodeID")]
-        public string? NodeID { get; set; }
+        public string NodeID { get; set; } = string.Empty;
 
         /// <summary>
         /// MaxVolumesPerNode is the maximum number of volumes that may be published
         /// to this node
         /// </summary>
         [JsonPropertyName("MaxVolumesPerNode")]
-        public long? MaxVolumesPerNode { get; set; }
+        public long MaxVolumesPerNode { get; set; } = default!;
 
         /// <summary>
         /// AccessibleTopology indicates the location of this node in the CSI
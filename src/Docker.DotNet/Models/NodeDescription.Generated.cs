@@ -7,19 +7,19 @@ namespace Docker.DotNet.Models
     public class NodeDescription // (swarm.NodeDescription)
     {
         [JsonPropertyName("Hostname")]
-        public string? Hostname { get; set; }
+        public string Hostname { get; set; } = string.Empty;
 
         [JsonPropertyName("Platform")]
-        public SwarmPlatform? Platform { get; set; }
+        public SwarmPlatform Platform { get; set; } = default!;
 
         [JsonPropertyName("Resources")]
-        public SwarmResources? Resources { get; set; }
+        public SwarmResources Resources { get; set; } = default!;
 
         [JsonPropertyName("Engine")]
-        public EngineDescription? Engine { get; set; }
+        public EngineDescription Engine { get; set; } = default!;
 
         [JsonPropertyName("TLSInfo")]
-        public TLSInfo? TLSInfo { get; set; }
+        public TLSInfo TLSInfo { get; set; } = default!;
 
         [JsonPropertyName("CSIInfo")]
         public IList<NodeCSIInfo>? CSIInfo { get; set; }
@@ -24,39 +24,45 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
 
         /// <summary>
         /// Spec defines the desired state of the node as specified by the user.
         /// The system will honor this and will *never* modify it.
         /// </summary>
         [JsonPropertyName("Spec")]
-        public NodeUpdateParameters? Spec { get; set; }
+        public NodeUpdateParameters Spec { get; set; } = default!;
 
         /// <summary>
         /// Description encapsulates the properties of the Node as reported by the
         /// agent.
         /// </summary>
         [JsonPropertyName("Description")]
-        public NodeDescription? Description { get; set; }
+        public NodeDescription Description { get; set; } = default!;
 
         /// <summary>
         /// Status provides the current status of the node, as seen by the manager.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.32 or later.</remarks>
         [JsonPropertyName("Status")]
-        public NodeStatus? Status { get; set; }
+        [MinimumApiVersion("1.32")]
+        public NodeStatus Status { get; set; } = default!;
 
         /// <summary>
         /// ManagerStatus provides the current status of the node&apos;s manager
         /// component, if the node is a manager.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.32 or later.</remarks>
         [JsonPropertyName("ManagerStatus")]
+        [MinimumApiVersion("1.32")]
         public ManagerStatus? ManagerStatus { get; set; }
     }
 }
@@ -0,0 +1,25 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// NodeRole represents the role of a node.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<NodeRole>))]
+    public enum NodeRole // (swarm.NodeRole)
+    {
+        /// <summary>
+        /// NodeRoleWorker WORKER
+        /// </summary>
+        [EnumMember(Value = "worker")]
+        Worker,
+
+        /// <summary>
+        /// NodeRoleManager MANAGER
+        /// </summary>
+        [EnumMember(Value = "manager")]
+        Manager,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -0,0 +1,37 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// NodeState represents the state of a node.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<NodeState>))]
+    public enum NodeState // (swarm.NodeState)
+    {
+        /// <summary>
+        /// NodeStateUnknown UNKNOWN
+        /// </summary>
+        [EnumMember(Value = "unknown")]
+        Unknown,
+
+        /// <summary>
+        /// NodeStateDown DOWN
+        /// </summary>
+        [EnumMember(Value = "down")]
+        Down,
+
+        /// <summary>
+        /// NodeStateReady READY
+        /// </summary>
+        [EnumMember(Value = "ready")]
+        Ready,
+
+        /// <summary>
+        /// NodeStateDisconnected DISCONNECTED
+        /// </summary>
+        [EnumMember(Value = "disconnected")]
+        Disconnected,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
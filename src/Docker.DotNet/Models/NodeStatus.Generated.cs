@@ -7,12 +7,12 @@ namespace Docker.DotNet.Models
     public class NodeStatus // (swarm.NodeStatus)
     {
         [JsonPropertyName("State")]
-        public string? State { get; set; }
+        public NodeState? State { get; set; }
 
         [JsonPropertyName("Message")]
-        public string? Message { get; set; }
+        public string Message { get; set; } = string.Empty;
 
         [JsonPropertyName("Addr")]
-        public string? Addr { get; set; }
+        public string Addr { get; set; } = string.Empty;
     }
 }
@@ -20,15 +20,15 @@ namespace Docker.DotNet.Models
         }
 
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         [JsonPropertyName("Role")]
-        public string? Role { get; set; }
+        public NodeRole? Role { get; set; }
 
         [JsonPropertyName("Availability")]
-        public string? Availability { get; set; }
+        public NodeAvailability? Availability { get; set; }
     }
 }
@@ -1,7 +0,0 @@
-namespace Docker.DotNet.Models;
-
-public class ObjectExtensionData
-{
-    [JsonExtensionData]
-    public IDictionary<string, object>? ExtensionData { get; set; }
-}
\ No newline at end of file
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// PeerInfo represents one peer of an overlay network.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model PeerInfo
+    /// </para>
     /// </summary>
     public class PeerInfo // (network.PeerInfo)
     {
@@ -10,13 +10,13 @@ namespace Docker.DotNet.Models
         /// Current is the number of pids in the cgroup
         /// </summary>
         [JsonPropertyName("current")]
-        public ulong? Current { get; set; }
+        public ulong Current { get; set; } = default!;
 
         /// <summary>
         /// Limit is the hard limit on the number of pids in the cgroup.
         /// A &quot;Limit&quot; of 0 means that there is no limit.
         /// </summary>
         [JsonPropertyName("limit")]
-        public ulong? Limit { get; set; }
+        public ulong Limit { get; set; } = default!;
     }
 }
@@ -13,7 +13,7 @@ namespace Docker.DotNet.Models
         public IList<PlacementPreference>? Preferences { get; set; }
 
         [JsonPropertyName("MaxReplicas")]
-        public ulong? MaxReplicas { get; set; }
+        public ulong MaxReplicas { get; set; } = default!;
 
         /// <summary>
         /// Platforms stores all the platforms that the image can run on.
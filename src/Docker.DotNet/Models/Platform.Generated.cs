@@ -10,12 +10,12 @@ namespace Docker.DotNet.Models
         public string OS { get; set; } = string.Empty;
 
         [JsonPropertyName("os.version")]
-        public string? OSVersion { get; set; }
+        public string OSVersion { get; set; } = string.Empty;
 
         [JsonPropertyName("os.features")]
         public IList<string>? OSFeatures { get; set; }
 
         [JsonPropertyName("variant")]
-        public string? Variant { get; set; }
+        public string Variant { get; set; } = string.Empty;
     }
 }
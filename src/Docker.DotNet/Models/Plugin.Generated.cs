@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Plugin A plugin for the Engine API
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Plugin
+    /// </para>
     /// </summary>
     public class Plugin // (plugin.Plugin)
     {
@@ -28,7 +31,7 @@ namespace Docker.DotNet.Models
         /// Example: 5724e2c8652da337ab2eedd19fc6fc0ec908e4bd907c7421bf6a8dfc70c4c078
         /// </summary>
         [JsonPropertyName("Id")]
-        public string? ID { get; set; }
+        public string ID { get; set; } = string.Empty;
 
         /// <summary>
         /// name
@@ -42,8 +45,10 @@ namespace Docker.DotNet.Models
         /// plugin remote reference used to push/pull the plugin
         /// Example: localhost:5000/tiborvass/sample-volume-plugin:latest
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.26 or later.</remarks>
         [JsonPropertyName("PluginReference")]
-        public string? PluginReference { get; set; }
+        [MinimumApiVersion("1.26")]
+        public string PluginReference { get; set; } = string.Empty;
 
         /// <summary>
         /// settings
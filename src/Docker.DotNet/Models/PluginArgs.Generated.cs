@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Args args
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Args
+    /// </para>
     /// </summary>
     public class PluginArgs // (plugin.Args)
     {
@@ -29,13 +32,13 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Settable")]
-        public IList<string> Settable { get; set; } = default!;
+        public IList<string>? Settable { get; set; }
 
         /// <summary>
         /// value
         /// Required: true
         /// </summary>
         [JsonPropertyName("Value")]
-        public IList<string> Value { get; set; } = default!;
+        public IList<string>? Value { get; set; }
     }
 }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Config The config of a plugin.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Config
+    /// </para>
     /// </summary>
     public class PluginConfig // (plugin.Config)
     {
@@ -25,7 +28,7 @@ namespace Docker.DotNet.Models
 
         /// <summary>
         /// documentation
-        /// Example: https://docs.docker.com/engine/extend/plugins/
+        /// Example: <see href="https://docs.docker.com/engine/extend/plugins/"/>
         /// Required: true
         /// </summary>
         [JsonPropertyName("Documentation")]
@@ -37,7 +40,7 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Entrypoint")]
-        public IList<string> Entrypoint { get; set; } = default!;
+        public IList<string>? Entrypoint { get; set; }
 
         /// <summary>
         /// env
@@ -45,7 +48,7 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Env")]
-        public IList<PluginEnv> Env { get; set; } = default!;
+        public IList<PluginEnv>? Env { get; set; }
 
         /// <summary>
         /// interface
@@ -74,7 +77,7 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Mounts")]
-        public IList<PluginMount> Mounts { get; set; } = default!;
+        public IList<PluginMount>? Mounts { get; set; }
 
         /// <summary>
         /// network
@@ -103,7 +106,7 @@ namespace Docker.DotNet.Models
         /// user
         /// </summary>
         [JsonPropertyName("User")]
-        public PluginUser? User { get; set; }
+        public PluginUser User { get; set; } = default!;
 
         /// <summary>
         /// work dir
@@ -4,6 +4,6 @@ namespace Docker.DotNet.Models
     public class PluginConfigureParameters // (main.PluginConfigureParameters)
     {
         [JsonPropertyName("Args")]
-        public IList<string> Args { get; set; } = default!;
+        public IList<string>? Args { get; set; }
     }
 }
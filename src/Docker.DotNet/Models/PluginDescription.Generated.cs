@@ -7,9 +7,9 @@ namespace Docker.DotNet.Models
     public class PluginDescription // (swarm.PluginDescription)
     {
         [JsonPropertyName("Type")]
-        public string? Type { get; set; }
+        public string Type { get; set; } = string.Empty;
 
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
     }
 }
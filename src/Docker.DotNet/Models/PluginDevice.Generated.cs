@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Device device
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Device
+    /// </para>
     /// </summary>
     public class PluginDevice // (plugin.Device)
     {
@@ -35,6 +38,6 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Settable")]
-        public IList<string> Settable { get; set; } = default!;
+        public IList<string>? Settable { get; set; }
     }
 }
@@ -3,7 +3,9 @@ namespace Docker.DotNet.Models
 {
     public class PluginDisableParameters // (main.PluginDisableParameters)
     {
+        /// <remarks>Requires Docker Engine API v1.28 or later.</remarks>
         [QueryStringBoolParameter("force", false)]
+        [MinimumApiVersion("1.28")]
         public bool? Force { get; set; }
     }
 }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Env env
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Env
+    /// </para>
     /// </summary>
     public class PluginEnv // (plugin.Env)
     {
@@ -27,7 +30,7 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Settable")]
-        public IList<string> Settable { get; set; } = default!;
+        public IList<string>? Settable { get; set; }
 
         /// <summary>
         /// value
@@ -3,7 +3,9 @@ namespace Docker.DotNet.Models
 {
     public class PluginGetPrivilegeParameters // (main.PluginGetPrivilegeParameters)
     {
+        /// <remarks>Requires Docker Engine API v1.27 or later.</remarks>
         [QueryStringParameter("remote", true)]
+        [MinimumApiVersion("1.27")]
         public string Remote { get; set; } = string.Empty;
     }
 }
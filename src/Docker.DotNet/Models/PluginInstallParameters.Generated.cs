@@ -9,10 +9,11 @@ namespace Docker.DotNet.Models
         [QueryStringParameter("name", false)]
         public string? Name { get; set; }
 
-        [JsonPropertyName("RegistryAuth")]
-        public AuthConfig RegistryAuth { get; set; } = default!;
+        [HeaderBase64JsonParameter(typeof(AuthConfig), "X-Registry-Auth", false)]
+        [JsonIgnore]
+        public AuthConfig? RegistryAuth { get; set; }
 
         [JsonPropertyName("Privileges")]
-        public IList<PluginPrivilege> Privileges { get; set; } = default!;
+        public IList<PluginPrivilege>? Privileges { get; set; }
     }
 }
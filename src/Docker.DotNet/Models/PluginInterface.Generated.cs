@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Interface The interface between Docker and the plugin
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Interface
+    /// </para>
     /// </summary>
     public class PluginInterface // (plugin.Interface)
     {
@@ -14,7 +17,7 @@ namespace Docker.DotNet.Models
         /// Enum: [&quot;&quot;,&quot;moby.plugins.http/v1&quot;]
         /// </summary>
         [JsonPropertyName("ProtocolScheme")]
-        public string? ProtocolScheme { get; set; }
+        public string ProtocolScheme { get; set; } = string.Empty;
 
         /// <summary>
         /// socket
@@ -30,6 +33,6 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Types")]
-        public IList<string> Types { get; set; } = default!;
+        public IList<string>? Types { get; set; }
     }
 }
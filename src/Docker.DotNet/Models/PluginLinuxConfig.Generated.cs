@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// LinuxConfig linux config
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model LinuxConfig
+    /// </para>
     /// </summary>
     public class PluginLinuxConfig // (plugin.LinuxConfig)
     {
@@ -22,13 +25,13 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Capabilities")]
-        public IList<string> Capabilities { get; set; } = default!;
+        public IList<string>? Capabilities { get; set; }
 
         /// <summary>
         /// devices
         /// Required: true
         /// </summary>
         [JsonPropertyName("Devices")]
-        public IList<PluginDevice> Devices { get; set; } = default!;
+        public IList<PluginDevice>? Devices { get; set; }
     }
 }
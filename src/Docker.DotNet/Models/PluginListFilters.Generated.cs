@@ -0,0 +1,19 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="PluginListParameters.Filters"/> of <c>GET /plugins</c>.
+    /// </summary>
+    public sealed class PluginListFilters : FiltersBuilder<PluginListFilters> // (PluginListParameters.Filters)
+    {
+        /// <summary>
+        /// `capability=&lt;capability name&gt;`
+        /// </summary>
+        public PluginListFilters Capability(string value) => AddFilter("capability", value);
+
+        /// <summary>
+        /// `enable=&lt;true&gt;|&lt;false&gt;`
+        /// </summary>
+        public PluginListFilters Enable(bool value) => AddFilter("enable", value ? "true" : "false");
+    }
+}
@@ -3,7 +3,9 @@ namespace Docker.DotNet.Models
 {
     public class PluginListParameters // (main.PluginListParameters)
     {
+        /// <remarks>Requires Docker Engine API v1.28 or later.</remarks>
         [QueryStringMapParameter(typeof(IDictionary<string, IDictionary<string, bool>>), "filters", false)]
+        [MinimumApiVersion("1.28")]
         public IDictionary<string, IDictionary<string, bool>>? Filters { get; set; }
     }
 }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Mount mount
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Mount
+    /// </para>
     /// </summary>
     public class PluginMount // (plugin.Mount)
     {
@@ -38,14 +41,14 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Options")]
-        public IList<string> Options { get; set; } = default!;
+        public IList<string>? Options { get; set; }
 
         /// <summary>
         /// settable
         /// Required: true
         /// </summary>
         [JsonPropertyName("Settable")]
-        public IList<string> Settable { get; set; } = default!;
+        public IList<string>? Settable { get; set; }
 
         /// <summary>
         /// source
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// NetworkConfig network config
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model NetworkConfig
+    /// </para>
     /// </summary>
     public class PluginNetworkConfig // (plugin.NetworkConfig)
     {
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Settings user-configurable settings for the plugin.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Settings
+    /// </para>
     /// </summary>
     public class PluginSettings // (plugin.Settings)
     {
@@ -13,14 +16,14 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Args")]
-        public IList<string> Args { get; set; } = default!;
+        public IList<string>? Args { get; set; }
 
         /// <summary>
         /// devices
         /// Required: true
         /// </summary>
         [JsonPropertyName("Devices")]
-        public IList<PluginDevice> Devices { get; set; } = default!;
+        public IList<PluginDevice>? Devices { get; set; }
 
         /// <summary>
         /// env
@@ -28,13 +31,13 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Env")]
-        public IList<string> Env { get; set; } = default!;
+        public IList<string>? Env { get; set; }
 
         /// <summary>
         /// mounts
         /// Required: true
         /// </summary>
         [JsonPropertyName("Mounts")]
-        public IList<PluginMount> Mounts { get; set; } = default!;
+        public IList<PluginMount>? Mounts { get; set; }
     }
 }
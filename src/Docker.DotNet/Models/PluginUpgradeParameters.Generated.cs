@@ -1,15 +1,18 @@
 #nullable enable
 namespace Docker.DotNet.Models
 {
+    /// <remarks>Requires Docker Engine API v1.26 or later.</remarks>
+    [MinimumApiVersion("1.26")]
     public class PluginUpgradeParameters // (main.PluginUpgradeParameters)
     {
         [QueryStringParameter("remote", true)]
         public string Remote { get; set; } = string.Empty;
 
-        [JsonPropertyName("RegistryAuth")]
-        public AuthConfig RegistryAuth { get; set; } = default!;
+        [HeaderBase64JsonParameter(typeof(AuthConfig), "X-Registry-Auth", false)]
+        [JsonIgnore]
+        public AuthConfig? RegistryAuth { get; set; }
 
         [JsonPropertyName("Privileges")]
-        public IList<PluginPrivilege> Privileges { get; set; } = default!;
+        public IList<PluginPrivilege>? Privileges { get; set; }
     }
 }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// User user
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model User
+    /// </para>
     /// </summary>
     public class PluginUser // (plugin.User)
     {
@@ -13,13 +16,13 @@ namespace Docker.DotNet.Models
         /// Example: 1000
         /// </summary>
         [JsonPropertyName("GID")]
-        public uint? GID { get; set; }
+        public uint GID { get; set; } = default!;
 
         /// <summary>
         /// UID
         /// Example: 1000
         /// </summary>
         [JsonPropertyName("UID")]
-        public uint? UID { get; set; }
+        public uint UID { get; set; } = default!;
     }
 }
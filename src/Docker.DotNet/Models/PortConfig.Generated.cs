@@ -7,27 +7,29 @@ namespace Docker.DotNet.Models
     public class PortConfig // (swarm.PortConfig)
     {
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Protocol")]
-        public string? Protocol { get; set; }
+        public IPProtocol? Protocol { get; set; }
 
         /// <summary>
         /// TargetPort is the port inside the container
         /// </summary>
         [JsonPropertyName("TargetPort")]
-        public uint? TargetPort { get; set; }
+        public uint TargetPort { get; set; } = default!;
 
         /// <summary>
         /// PublishedPort is the port on the swarm hosts
         /// </summary>
         [JsonPropertyName("PublishedPort")]
-        public uint? PublishedPort { get; set; }
+        public uint PublishedPort { get; set; } = default!;
 
         /// <summary>
         /// PublishMode is the mode in which port is published
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.34 or later.</remarks>
         [JsonPropertyName("PublishMode")]
-        public string? PublishMode { get; set; }
+        [MinimumApiVersion("1.34")]
+        public PortConfigPublishMode? PublishMode { get; set; }
     }
 }
@@ -0,0 +1,28 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// PortConfigPublishMode represents the mode in which the port is to
+    /// be published.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<PortConfigPublishMode>))]
+    public enum PortConfigPublishMode // (swarm.PortConfigPublishMode)
+    {
+        /// <summary>
+        /// PortConfigPublishModeIngress is used for ports published
+        /// for ingress load balancing using routing mesh.
+        /// </summary>
+        [EnumMember(Value = "ingress")]
+        Ingress,
+
+        /// <summary>
+        /// PortConfigPublishModeHost is used for ports published
+        /// for direct host level access on the host where the task is running.
+        /// </summary>
+        [EnumMember(Value = "host")]
+        Host,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -2,11 +2,15 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// PortSummary Describes a port-mapping between the container and the host.
-    /// 
+    /// </para>
+    /// <para>
     /// Example: {&quot;PrivatePort&quot;:8080,&quot;PublicPort&quot;:80,&quot;Type&quot;:&quot;tcp&quot;}
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model PortSummary
+    /// </para>
     /// </summary>
     public class PortSummary // (container.PortSummary)
     {
@@ -14,7 +18,7 @@ namespace Docker.DotNet.Models
         /// Host IP address that the container&apos;s port is mapped to
         /// </summary>
         [JsonPropertyName("IP")]
-        public string? IP { get; set; }
+        public string IP { get; set; } = string.Empty;
 
         /// <summary>
         /// Port on the container
@@ -27,7 +31,7 @@ namespace Docker.DotNet.Models
         /// Port exposed on the host
         /// </summary>
         [JsonPropertyName("PublicPort")]
-        public ushort? PublicPort { get; set; }
+        public ushort PublicPort { get; set; } = default!;
 
         /// <summary>
         /// type
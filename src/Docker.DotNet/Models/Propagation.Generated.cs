@@ -0,0 +1,49 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Propagation represents the propagation of a mount.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<Propagation>))]
+    public enum Propagation // (mount.Propagation)
+    {
+        /// <summary>
+        /// PropagationRPrivate RPRIVATE
+        /// </summary>
+        [EnumMember(Value = "rprivate")]
+        Rprivate,
+
+        /// <summary>
+        /// PropagationPrivate PRIVATE
+        /// </summary>
+        [EnumMember(Value = "private")]
+        Private,
+
+        /// <summary>
+        /// PropagationRShared RSHARED
+        /// </summary>
+        [EnumMember(Value = "rshared")]
+        Rshared,
+
+        /// <summary>
+        /// PropagationShared SHARED
+        /// </summary>
+        [EnumMember(Value = "shared")]
+        Shared,
+
+        /// <summary>
+        /// PropagationRSlave RSLAVE
+        /// </summary>
+        [EnumMember(Value = "rslave")]
+        Rslave,
+
+        /// <summary>
+        /// PropagationSlave SLAVE
+        /// </summary>
+        [EnumMember(Value = "slave")]
+        Slave,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
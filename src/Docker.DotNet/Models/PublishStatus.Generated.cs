@@ -11,13 +11,13 @@ namespace Docker.DotNet.Models
         /// NodeID is the ID of the swarm node this Volume is published to.
         /// </summary>
         [JsonPropertyName("NodeID")]
-        public string? NodeID { get; set; }
+        public string NodeID { get; set; } = string.Empty;
 
         /// <summary>
         /// State is the publish state of the volume.
         /// </summary>
         [JsonPropertyName("State")]
-        public string? State { get; set; }
+        public VolumePublishState? State { get; set; }
 
         /// <summary>
         /// PublishContext is the PublishContext returned by the CSI plugin when
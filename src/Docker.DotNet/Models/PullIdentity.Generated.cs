@@ -11,6 +11,6 @@ namespace Docker.DotNet.Models
         /// Repository is the remote repository location the image was pulled from.
         /// </summary>
         [JsonPropertyName("Repository")]
-        public string? Repository { get; set; }
+        public string Repository { get; set; } = string.Empty;
     }
 }
@@ -0,0 +1,15 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    public class PushResult : JSONMessageAux // (main.PushResult)
+    {
+        [JsonPropertyName("Tag")]
+        public string Tag { get; set; } = string.Empty;
+
+        [JsonPropertyName("Digest")]
+        public string Digest { get; set; } = string.Empty;
+
+        [JsonPropertyName("Size")]
+        public long Size { get; set; } = default!;
+    }
+}
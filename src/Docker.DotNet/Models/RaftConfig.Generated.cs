@@ -10,7 +10,7 @@ namespace Docker.DotNet.Models
         /// SnapshotInterval is the number of log entries between snapshots.
         /// </summary>
         [JsonPropertyName("SnapshotInterval")]
-        public ulong? SnapshotInterval { get; set; }
+        public ulong SnapshotInterval { get; set; } = default!;
 
         /// <summary>
         /// KeepOldSnapshots is the number of snapshots to keep beyond the
@@ -24,26 +24,32 @@ namespace Docker.DotNet.Models
         /// around to sync up slow followers after a snapshot is created.
         /// </summary>
         [JsonPropertyName("LogEntriesForSlowFollowers")]
-        public ulong? LogEntriesForSlowFollowers { get; set; }
+        public ulong LogEntriesForSlowFollowers { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// ElectionTick is the number of ticks that a follower will wait for a message
         /// from the leader before becoming a candidate and starting an election.
         /// ElectionTick must be greater than HeartbeatTick.
-        /// 
+        /// </para>
+        /// <para>
         /// A tick currently defaults to one second, so these translate directly to
         /// seconds currently, but this is NOT guaranteed.
+        /// </para>
         /// </summary>
         [JsonPropertyName("ElectionTick")]
         public long ElectionTick { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// HeartbeatTick is the number of ticks between heartbeats. Every
         /// HeartbeatTick ticks, the leader will send a heartbeat to the
         /// followers.
-        /// 
+        /// </para>
+        /// <para>
         /// A tick currently defaults to one second, so these translate directly to
         /// seconds currently, but this is NOT guaranteed.
+        /// </para>
         /// </summary>
         [JsonPropertyName("HeartbeatTick")]
         public long HeartbeatTick { get; set; } = default!;
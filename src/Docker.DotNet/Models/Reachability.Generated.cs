@@ -0,0 +1,31 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Reachability represents the reachability of a node.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<Reachability>))]
+    public enum Reachability // (swarm.Reachability)
+    {
+        /// <summary>
+        /// ReachabilityUnknown UNKNOWN
+        /// </summary>
+        [EnumMember(Value = "unknown")]
+        Unknown,
+
+        /// <summary>
+        /// ReachabilityUnreachable UNREACHABLE
+        /// </summary>
+        [EnumMember(Value = "unreachable")]
+        Unreachable,
+
+        /// <summary>
+        /// ReachabilityReachable REACHABLE
+        /// </summary>
+        [EnumMember(Value = "reachable")]
+        Reachable,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
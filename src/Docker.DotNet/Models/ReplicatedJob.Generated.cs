@@ -8,22 +8,28 @@ namespace Docker.DotNet.Models
     public class ReplicatedJob // (swarm.ReplicatedJob)
     {
         /// <summary>
+        /// <para>
         /// MaxConcurrent indicates the maximum number of Tasks that should be
         /// executing simultaneously for this job at any given time. There may be
         /// fewer Tasks that MaxConcurrent executing simultaneously; for example, if
         /// there are fewer than MaxConcurrent tasks needed to reach
         /// TotalCompletions.
-        /// 
+        /// </para>
+        /// <para>
         /// If this field is empty, it will default to a max concurrency of 1.
+        /// </para>
         /// </summary>
         [JsonPropertyName("MaxConcurrent")]
         public ulong? MaxConcurrent { get; set; }
 
         /// <summary>
+        /// <para>
         /// TotalCompletions is the total number of Tasks desired to run to
         /// completion.
-        /// 
+        /// </para>
+        /// <para>
         /// If this field is empty, the value of MaxConcurrent will be used.
+        /// </para>
         /// </summary>
         [JsonPropertyName("TotalCompletions")]
         public ulong? TotalCompletions { get; set; }
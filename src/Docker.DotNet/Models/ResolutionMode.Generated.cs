@@ -0,0 +1,25 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// ResolutionMode represents a resolution mode.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<ResolutionMode>))]
+    public enum ResolutionMode // (swarm.ResolutionMode)
+    {
+        /// <summary>
+        /// ResolutionModeVIP VIP
+        /// </summary>
+        [EnumMember(Value = "vip")]
+        Vip,
+
+        /// <summary>
+        /// ResolutionModeDNSRR DNSRR
+        /// </summary>
+        [EnumMember(Value = "dnsrr")]
+        Dnsrr,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -0,0 +1,31 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// RestartPolicyCondition represents when to restart.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<RestartPolicyCondition>))]
+    public enum RestartPolicyCondition // (swarm.RestartPolicyCondition)
+    {
+        /// <summary>
+        /// RestartPolicyConditionNone NONE
+        /// </summary>
+        [EnumMember(Value = "none")]
+        None,
+
+        /// <summary>
+        /// RestartPolicyConditionOnFailure ON_FAILURE
+        /// </summary>
+        [EnumMember(Value = "on-failure")]
+        OnFailure,
+
+        /// <summary>
+        /// RestartPolicyConditionAny ANY
+        /// </summary>
+        [EnumMember(Value = "any")]
+        Any,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
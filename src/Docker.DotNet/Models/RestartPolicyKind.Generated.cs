@@ -0,0 +1,22 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    [JsonConverter(typeof(JsonEnumMemberConverter<RestartPolicyKind>))]
+    public enum RestartPolicyKind // (container.RestartPolicyMode)
+    {
+        [EnumMember(Value = "")]
+        Undefined,
+
+        [EnumMember(Value = "no")]
+        No,
+
+        [EnumMember(Value = "always")]
+        Always,
+
+        [EnumMember(Value = "on-failure")]
+        OnFailure,
+
+        [EnumMember(Value = "unless-stopped")]
+        UnlessStopped
+    }
+}
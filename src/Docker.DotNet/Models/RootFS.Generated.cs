@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class RootFS // (image.RootFS)
     {
         [JsonPropertyName("Type")]
-        public string? Type { get; set; }
+        public string Type { get; set; } = string.Empty;
 
         [JsonPropertyName("Layers")]
         public IList<string>? Layers { get; set; }
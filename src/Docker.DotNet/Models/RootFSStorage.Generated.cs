@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// RootFSStorage Information about the storage used for the container&apos;s root filesystem.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model RootFSStorage
+    /// </para>
     /// </summary>
     public class RootFSStorage // (storage.RootFSStorage)
     {
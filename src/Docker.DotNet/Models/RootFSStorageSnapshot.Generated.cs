@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// RootFSStorageSnapshot Information about a snapshot backend of the container&apos;s root filesystem.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model RootFSStorageSnapshot
+    /// </para>
     /// </summary>
     public class RootFSStorageSnapshot // (storage.RootFSStorageSnapshot)
     {
@@ -12,6 +15,6 @@ namespace Docker.DotNet.Models
         /// Name of the snapshotter.
         /// </summary>
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
     }
 }
@@ -7,13 +7,13 @@ namespace Docker.DotNet.Models
     public class Runtime // (system.Runtime)
     {
         [JsonPropertyName("path")]
-        public string? Path { get; set; }
+        public string Path { get; set; } = string.Empty;
 
         [JsonPropertyName("runtimeArgs")]
         public IList<string>? Args { get; set; }
 
         [JsonPropertyName("runtimeType")]
-        public string? Type { get; set; }
+        public string Type { get; set; } = string.Empty;
 
         [JsonPropertyName("options")]
         public IDictionary<string, object>? Options { get; set; }
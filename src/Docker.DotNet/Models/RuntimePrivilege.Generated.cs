@@ -8,10 +8,10 @@ namespace Docker.DotNet.Models
     public class RuntimePrivilege // (swarm.RuntimePrivilege)
     {
         [JsonPropertyName("name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("description")]
-        public string? Description { get; set; }
+        public string Description { get; set; } = string.Empty;
 
         [JsonPropertyName("value")]
         public IList<string>? Value { get; set; }
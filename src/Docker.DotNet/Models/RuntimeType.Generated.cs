@@ -0,0 +1,31 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// RuntimeType is the type of runtime used for the TaskSpec
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<RuntimeType>))]
+    public enum RuntimeType // (swarm.RuntimeType)
+    {
+        /// <summary>
+        /// RuntimeContainer is the container based runtime
+        /// </summary>
+        [EnumMember(Value = "container")]
+        Container,
+
+        /// <summary>
+        /// RuntimePlugin is the plugin based runtime
+        /// </summary>
+        [EnumMember(Value = "plugin")]
+        Plugin,
+
+        /// <summary>
+        /// RuntimeNetworkAttachment is the network attachment runtime
+        /// </summary>
+        [EnumMember(Value = "attachment")]
+        Attachment,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
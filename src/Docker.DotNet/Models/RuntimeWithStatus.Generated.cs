@@ -2,7 +2,7 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
-    /// RuntimeWithStatus extends [Runtime] to hold [RuntimeStatus].
+    /// RuntimeWithStatus extends <see cref="Runtime"/> to hold <see href="https://pkg.go.dev/github.com/moby/moby/api/types/system#RuntimeStatus">system.RuntimeStatus</see>.
     /// </summary>
     public class RuntimeWithStatus // (system.RuntimeWithStatus)
     {
@@ -22,13 +22,13 @@ namespace Docker.DotNet.Models
         }
 
         [JsonPropertyName("path")]
-        public string? Path { get; set; }
+        public string Path { get; set; } = string.Empty;
 
         [JsonPropertyName("runtimeArgs")]
         public IList<string>? Args { get; set; }
 
         [JsonPropertyName("runtimeType")]
-        public string? Type { get; set; }
+        public string Type { get; set; } = string.Empty;
 
         [JsonPropertyName("options")]
         public IDictionary<string, object>? Options { get; set; }
@@ -0,0 +1,23 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// SeccompMode is the type used for the enumeration of possible seccomp modes
+    /// in SeccompOpts
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<SeccompMode>))]
+    public enum SeccompMode // (swarm.SeccompMode)
+    {
+        [EnumMember(Value = "default")]
+        Default,
+
+        [EnumMember(Value = "unconfined")]
+        Unconfined,
+
+        [EnumMember(Value = "custom")]
+        Custom,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
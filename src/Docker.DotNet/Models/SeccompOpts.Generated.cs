@@ -11,7 +11,7 @@ namespace Docker.DotNet.Models
         /// Mode is the SeccompMode used for the container.
         /// </summary>
         [JsonPropertyName("Mode")]
-        public string? Mode { get; set; }
+        public SeccompMode? Mode { get; set; }
 
         /// <summary>
         /// Profile is the custom seccomp profile as a json object to be used with
@@ -6,7 +6,8 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("Service")]
         public ServiceSpec Service { get; set; } = default!;
 
-        [JsonPropertyName("RegistryAuth")]
-        public AuthConfig RegistryAuth { get; set; } = default!;
+        [HeaderBase64JsonParameter(typeof(AuthConfig), "X-Registry-Auth", false)]
+        [JsonIgnore]
+        public AuthConfig? RegistryAuth { get; set; }
     }
 }
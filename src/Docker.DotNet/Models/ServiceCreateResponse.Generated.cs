@@ -2,10 +2,13 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// ServiceCreateResponse contains the information returned to a client on the
     /// creation of a new service.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model ServiceCreateResponse
+    /// </para>
     /// </summary>
     public class ServiceCreateResponse // (swarm.ServiceCreateResponse)
     {
@@ -14,16 +17,20 @@ namespace Docker.DotNet.Models
         /// Example: ak7w3gjqoa3kuz8xcpnyy0pvl
         /// </summary>
         [JsonPropertyName("ID")]
-        public string? ID { get; set; }
+        public string ID { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Optional warning message.
-        /// 
+        /// </para>
+        /// <para>
         /// FIXME(thaJeztah): this should have &quot;omitempty&quot; in the generated type.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: [&quot;unable to pin image doesnotexist:latest to digest: image library/doesnotexist:latest not found&quot;]
+        /// </para>
         /// </summary>
         [JsonPropertyName("Warnings")]
-        public IList<string> Warnings { get; set; } = default!;
+        public IList<string>? Warnings { get; set; }
     }
 }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// ServiceInfo represents service parameters with the list of service&apos;s tasks
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model ServiceInfo
+    /// </para>
     /// </summary>
     public class ServiceInfo // (network.ServiceInfo)
     {
@@ -18,7 +21,7 @@ namespace Docker.DotNet.Models
         /// ports
         /// </summary>
         [JsonPropertyName("Ports")]
-        public IList<string> Ports { get; set; } = default!;
+        public IList<string>? Ports { get; set; }
 
         /// <summary>
         /// local l b index
@@ -30,6 +33,6 @@ namespace Docker.DotNet.Models
         /// tasks
         /// </summary>
         [JsonPropertyName("Tasks")]
-        public IList<NetworkTask> Tasks { get; set; } = default!;
+        public IList<NetworkTask>? Tasks { get; set; }
     }
 }
@@ -0,0 +1,34 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="ServiceListParameters.Filters"/> of <c>GET /services</c>.
+    /// </summary>
+    public sealed class ServiceListFilters : FiltersBuilder<ServiceListFilters> // (ServiceListParameters.Filters)
+    {
+        /// <summary>
+        /// `id=&lt;service id&gt;`
+        /// </summary>
+        public ServiceListFilters Id(string value) => AddFilter("id", value);
+
+        /// <summary>
+        /// `label=&lt;service label&gt;`
+        /// </summary>
+        public ServiceListFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public ServiceListFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `mode=[&quot;replicated&quot;|&quot;global&quot;]`
+        /// </summary>
+        public ServiceListFilters Mode(string value) => AddFilter("mode", value);
+
+        /// <summary>
+        /// `name=&lt;service name&gt;`
+        /// </summary>
+        public ServiceListFilters Name(string value) => AddFilter("name", value);
+    }
+}
@@ -6,7 +6,9 @@ namespace Docker.DotNet.Models
         [QueryStringMapParameter(typeof(IDictionary<string, IDictionary<string, bool>>), "filters", false)]
         public IDictionary<string, IDictionary<string, bool>>? Filters { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [QueryStringBoolParameter("status", false)]
+        [MinimumApiVersion("1.41")]
         public bool? Status { get; set; }
     }
 }
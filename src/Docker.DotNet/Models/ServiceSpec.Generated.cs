@@ -20,25 +20,27 @@ namespace Docker.DotNet.Models
         }
 
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
         /// TaskTemplate defines how the service should construct new tasks when
         /// orchestrating this service.
         /// </summary>
         [JsonPropertyName("TaskTemplate")]
-        public TaskSpec? TaskTemplate { get; set; }
+        public TaskSpec TaskTemplate { get; set; } = default!;
 
         [JsonPropertyName("Mode")]
-        public ServiceMode? Mode { get; set; }
+        public ServiceMode Mode { get; set; } = default!;
 
         [JsonPropertyName("UpdateConfig")]
         public SwarmUpdateConfig? UpdateConfig { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.28 or later.</remarks>
         [JsonPropertyName("RollbackConfig")]
+        [MinimumApiVersion("1.28")]
         public SwarmUpdateConfig? RollbackConfig { get; set; }
 
         [JsonPropertyName("EndpointSpec")]
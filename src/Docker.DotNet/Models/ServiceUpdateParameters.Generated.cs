@@ -12,10 +12,13 @@ namespace Docker.DotNet.Models
         [QueryStringParameter("registryauthfrom", false)]
         public string? RegistryAuthFrom { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.28 or later.</remarks>
         [QueryStringParameter("rollback", false)]
+        [MinimumApiVersion("1.28")]
         public string? Rollback { get; set; }
 
-        [JsonPropertyName("RegistryAuth")]
-        public AuthConfig RegistryAuth { get; set; } = default!;
+        [HeaderBase64JsonParameter(typeof(AuthConfig), "X-Registry-Auth", false)]
+        [JsonIgnore]
+        public AuthConfig? RegistryAuth { get; set; }
     }
 }
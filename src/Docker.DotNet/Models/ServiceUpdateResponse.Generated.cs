@@ -2,10 +2,13 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// ServiceUpdateResponse service update response
     /// Example: {&quot;Warnings&quot;:[&quot;unable to pin image doesnotexist:latest to digest: image library/doesnotexist:latest not found&quot;]}
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model ServiceUpdateResponse
+    /// </para>
     /// </summary>
     public class ServiceUpdateResponse // (swarm.ServiceUpdateResponse)
     {
@@ -13,6 +16,6 @@ namespace Docker.DotNet.Models
         /// Optional warning messages
         /// </summary>
         [JsonPropertyName("Warnings")]
-        public IList<string> Warnings { get; set; } = default!;
+        public IList<string>? Warnings { get; set; }
     }
 }
@@ -10,26 +10,26 @@ namespace Docker.DotNet.Models
         /// Name is a textual description summarizing the type of signature.
         /// </summary>
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         /// <summary>
         /// Timestamps contains a list of verified signed timestamps for the signature.
         /// </summary>
         [JsonPropertyName("Timestamps")]
-        public IList<SignatureTimestamp> Timestamps { get; set; } = default!;
+        public IList<SignatureTimestamp>? Timestamps { get; set; }
 
         /// <summary>
         /// KnownSigner is an identifier for a special signer identity that is known to the implementation.
         /// </summary>
         [JsonPropertyName("KnownSigner")]
-        public string? KnownSigner { get; set; }
+        public string KnownSigner { get; set; } = string.Empty;
 
         /// <summary>
         /// DockerReference is the Docker image reference associated with the signature.
         /// This is an optional field only present in older hashedrecord signatures.
         /// </summary>
         [JsonPropertyName("DockerReference")]
-        public string? DockerReference { get; set; }
+        public string DockerReference { get; set; } = string.Empty;
 
         /// <summary>
         /// Signer contains information about the signer certificate used to sign the image.
@@ -41,14 +41,14 @@ namespace Docker.DotNet.Models
         /// SignatureType is the type of signature format. E.g. &quot;bundle-v0.3&quot; or &quot;hashedrecord&quot;.
         /// </summary>
         [JsonPropertyName("SignatureType")]
-        public string? SignatureType { get; set; }
+        public SignatureType? SignatureType { get; set; }
 
         /// <summary>
         /// Error contains error information if signature verification failed.
         /// Other fields will be empty in this case.
         /// </summary>
         [JsonPropertyName("Error")]
-        public string? Error { get; set; }
+        public string Error { get; set; } = string.Empty;
 
         /// <summary>
         /// Warnings contains any warnings that occurred during signature verification.
@@ -56,6 +56,6 @@ namespace Docker.DotNet.Models
         /// Warning does not indicate a failed verification but may point to configuration issues.
         /// </summary>
         [JsonPropertyName("Warnings")]
-        public IList<string> Warnings { get; set; } = default!;
+        public IList<string>? Warnings { get; set; }
     }
 }
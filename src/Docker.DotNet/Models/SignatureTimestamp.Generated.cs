@@ -7,12 +7,13 @@ namespace Docker.DotNet.Models
     public class SignatureTimestamp // (image.SignatureTimestamp)
     {
         [JsonPropertyName("Type")]
-        public string Type { get; set; } = string.Empty;
+        public SignatureTimestampType? Type { get; set; }
 
         [JsonPropertyName("URI")]
         public string URI { get; set; } = string.Empty;
 
         [JsonPropertyName("Timestamp")]
-        public DateTime Timestamp { get; set; } = default!;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Timestamp { get; set; }
     }
 }
@@ -0,0 +1,19 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// SignatureTimestampType is the type of timestamp used in the signature.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<SignatureTimestampType>))]
+    public enum SignatureTimestampType // (image.SignatureTimestampType)
+    {
+        [EnumMember(Value = "Tlog")]
+        Tlog,
+
+        [EnumMember(Value = "TimestampAuthority")]
+        TimestampAuthority,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -0,0 +1,19 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// SignatureType is the type of signature format.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<SignatureType>))]
+    public enum SignatureType // (image.SignatureType)
+    {
+        [EnumMember(Value = "bundle-v0.3")]
+        BundleV03,
+
+        [EnumMember(Value = "simplesigning-v1")]
+        SimplesigningV1,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
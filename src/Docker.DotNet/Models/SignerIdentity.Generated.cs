@@ -3,9 +3,7 @@ namespace Docker.DotNet.Models
 {
     /// <summary>
     /// SignerIdentity contains information about the signer certificate used to sign the image.
-    /// This is [certificate.Summary] with deprecated fields removed and keys in Moby uppercase style.
-    /// 
-    /// [certificate.Summary]: https://pkg.go.dev/github.com/sigstore/sigstore-go/pkg/fulcio/certificate#Summary
+    /// This is <see href="https://pkg.go.dev/github.com/sigstore/sigstore-go/pkg/fulcio/certificate#Summary">certificate.Summary</see> with deprecated fields removed and keys in Moby uppercase style.
     /// </summary>
     public class SignerIdentity // (image.SignerIdentity)
     {
@@ -22,90 +20,90 @@ namespace Docker.DotNet.Models
         /// will fail to render.
         /// </summary>
         [JsonPropertyName("Issuer")]
-        public string? Issuer { get; set; }
+        public string Issuer { get; set; } = string.Empty;
 
         /// <summary>
         /// Reference to specific build instructions that are responsible for signing.
         /// </summary>
         [JsonPropertyName("BuildSignerURI")]
-        public string? BuildSignerURI { get; set; }
+        public string BuildSignerURI { get; set; } = string.Empty;
 
         /// <summary>
         /// Immutable reference to the specific version of the build instructions that is responsible for signing.
         /// </summary>
         [JsonPropertyName("BuildSignerDigest")]
-        public string? BuildSignerDigest { get; set; }
+        public string BuildSignerDigest { get; set; } = string.Empty;
 
         /// <summary>
         /// Specifies whether the build took place in platform-hosted cloud infrastructure or customer/self-hosted infrastructure.
         /// </summary>
         [JsonPropertyName("RunnerEnvironment")]
-        public string? RunnerEnvironment { get; set; }
+        public string RunnerEnvironment { get; set; } = string.Empty;
 
         /// <summary>
         /// Source repository URL that the build was based on.
         /// </summary>
         [JsonPropertyName("SourceRepositoryURI")]
-        public string? SourceRepositoryURI { get; set; }
+        public string SourceRepositoryURI { get; set; } = string.Empty;
 
         /// <summary>
         /// Immutable reference to a specific version of the source code that the build was based upon.
         /// </summary>
         [JsonPropertyName("SourceRepositoryDigest")]
-        public string? SourceRepositoryDigest { get; set; }
+        public string SourceRepositoryDigest { get; set; } = string.Empty;
 
         /// <summary>
         /// Source Repository Ref that the build run was based upon.
         /// </summary>
         [JsonPropertyName("SourceRepositoryRef")]
-        public string? SourceRepositoryRef { get; set; }
+        public string SourceRepositoryRef { get; set; } = string.Empty;
 
         /// <summary>
         /// Immutable identifier for the source repository the workflow was based upon.
         /// </summary>
         [JsonPropertyName("SourceRepositoryIdentifier")]
-        public string? SourceRepositoryIdentifier { get; set; }
+        public string SourceRepositoryIdentifier { get; set; } = string.Empty;
 
         /// <summary>
         /// Source repository owner URL of the owner of the source repository that the build was based on.
         /// </summary>
         [JsonPropertyName("SourceRepositoryOwnerURI")]
-        public string? SourceRepositoryOwnerURI { get; set; }
+        public string SourceRepositoryOwnerURI { get; set; } = string.Empty;
 
         /// <summary>
         /// Immutable identifier for the owner of the source repository that the workflow was based upon.
         /// </summary>
         [JsonPropertyName("SourceRepositoryOwnerIdentifier")]
-        public string? SourceRepositoryOwnerIdentifier { get; set; }
+        public string SourceRepositoryOwnerIdentifier { get; set; } = string.Empty;
 
         /// <summary>
         /// Build Config URL to the top-level/initiating build instructions.
         /// </summary>
         [JsonPropertyName("BuildConfigURI")]
-        public string? BuildConfigURI { get; set; }
+        public string BuildConfigURI { get; set; } = string.Empty;
 
         /// <summary>
         /// Immutable reference to the specific version of the top-level/initiating build instructions.
         /// </summary>
         [JsonPropertyName("BuildConfigDigest")]
-        public string? BuildConfigDigest { get; set; }
+        public string BuildConfigDigest { get; set; } = string.Empty;
 
         /// <summary>
         /// Event or action that initiated the build.
         /// </summary>
         [JsonPropertyName("BuildTrigger")]
-        public string? BuildTrigger { get; set; }
+        public string BuildTrigger { get; set; } = string.Empty;
 
         /// <summary>
         /// Run Invocation URL to uniquely identify the build execution.
         /// </summary>
         [JsonPropertyName("RunInvocationURI")]
-        public string? RunInvocationURI { get; set; }
+        public string RunInvocationURI { get; set; } = string.Empty;
 
         /// <summary>
         /// Source repository visibility at the time of signing the certificate.
         /// </summary>
         [JsonPropertyName("SourceRepositoryVisibilityAtSigning")]
-        public string? SourceRepositoryVisibilityAtSigning { get; set; }
+        public string SourceRepositoryVisibilityAtSigning { get; set; } = string.Empty;
     }
 }
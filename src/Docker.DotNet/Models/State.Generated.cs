@@ -11,7 +11,7 @@ namespace Docker.DotNet.Models
         /// String representation of the container state. Can be one of &quot;created&quot;, &quot;running&quot;, &quot;paused&quot;, &quot;restarting&quot;, &quot;removing&quot;, &quot;exited&quot;, or &quot;dead&quot;
         /// </summary>
         [JsonPropertyName("Status")]
-        public string Status { get; set; } = string.Empty;
+        public ContainerState? Status { get; set; }
 
         [JsonPropertyName("Running")]
         public bool Running { get; set; } = default!;
@@ -38,10 +38,12 @@ namespace Docker.DotNet.Models
         public string Error { get; set; } = string.Empty;
 
         [JsonPropertyName("StartedAt")]
-        public string StartedAt { get; set; } = string.Empty;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? StartedAt { get; set; }
 
         [JsonPropertyName("FinishedAt")]
-        public string FinishedAt { get; set; } = string.Empty;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? FinishedAt { get; set; }
 
         [JsonPropertyName("Health")]
         public Health? Health { get; set; }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Status provides runtime information about the network such as the number of allocated IPs.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Status
+    /// </para>
     /// </summary>
     public class Status // (network.Status)
     {
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Storage Information about the storage used by the container.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Storage
+    /// </para>
     /// </summary>
     public class Storage // (storage.Storage)
     {
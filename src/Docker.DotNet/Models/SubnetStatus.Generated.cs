@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// SubnetStatus subnet status
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model SubnetStatus
+    /// </para>
     /// </summary>
     public class SubnetStatus // (network.SubnetStatus)
     {
@@ -4,7 +4,7 @@ namespace Docker.DotNet.Models
     public class SummaryHostConfig // (container.Summary.HostConfig)
     {
         [JsonPropertyName("NetworkMode")]
-        public string? NetworkMode { get; set; }
+        public string NetworkMode { get; set; } = string.Empty;
 
         [JsonPropertyName("Annotations")]
         public IDictionary<string, string>? Annotations { get; set; }
@@ -1,6 +1,8 @@
 #nullable enable
 namespace Docker.DotNet.Models
 {
+    /// <remarks>Requires Docker Engine API v1.30 or later.</remarks>
+    [MinimumApiVersion("1.30")]
     public class SwarmConfig // (main.SwarmConfig)
     {
         public SwarmConfig()
@@ -21,13 +23,15 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
 
         [JsonPropertyName("Spec")]
         public SwarmConfigSpec Spec { get; set; } = default!;
@@ -4,6 +4,8 @@ namespace Docker.DotNet.Models
     /// <summary>
     /// ConfigSpec represents a config specification from a config in swarm
     /// </summary>
+    /// <remarks>Requires Docker Engine API v1.30 or later.</remarks>
+    [MinimumApiVersion("1.30")]
     public class SwarmConfigSpec // (swarm.ConfigSpec)
     {
         public SwarmConfigSpec()
@@ -20,17 +22,18 @@ namespace Docker.DotNet.Models
         }
 
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
+        /// <para>
         /// Data is the data to store as a config.
-        /// 
-        /// The maximum allowed size is 1000KB, as defined in [MaxConfigSize].
-        /// 
-        /// [MaxConfigSize]: https://pkg.go.dev/github.com/moby/swarmkit/v2@v2.0.0-20250103191802-8c1959736554/manager/controlapi#MaxConfigSize
+        /// </para>
+        /// <para>
+        /// The maximum allowed size is 1000KB, as defined in <see href="https://pkg.go.dev/github.com/moby/swarmkit/v2@v2.0.0-20250103191802-8c1959736554/manager/controlapi#MaxConfigSize">MaxConfigSize</see>.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Data")]
         public byte[]? Data { get; set; }
@@ -39,7 +42,9 @@ namespace Docker.DotNet.Models
         /// Templating controls whether and how to evaluate the config payload as
         /// a template. If it is not set, no templating is used.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.37 or later.</remarks>
         [JsonPropertyName("Templating")]
+        [MinimumApiVersion("1.37")]
         public SwarmDriver? Templating { get; set; }
     }
 }
@@ -1,6 +1,8 @@
 #nullable enable
 namespace Docker.DotNet.Models
 {
+    /// <remarks>Requires Docker Engine API v1.30 or later.</remarks>
+    [MinimumApiVersion("1.30")]
     public class SwarmCreateConfigParameters // (main.SwarmCreateConfigParameters)
     {
         [JsonPropertyName("Config")]
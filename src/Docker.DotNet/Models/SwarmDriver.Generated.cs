@@ -7,7 +7,7 @@ namespace Docker.DotNet.Models
     public class SwarmDriver // (swarm.Driver)
     {
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Options")]
         public IDictionary<string, string>? Options { get; set; }
@@ -28,10 +28,10 @@ namespace Docker.DotNet.Models
         public bool AutoLockManagers { get; set; } = default!;
 
         [JsonPropertyName("Availability")]
-        public string Availability { get; set; } = string.Empty;
+        public NodeAvailability? Availability { get; set; }
 
         [JsonPropertyName("DefaultAddrPool")]
-        public IList<string> DefaultAddrPool { get; set; } = default!;
+        public IList<string>? DefaultAddrPool { get; set; }
 
         [JsonPropertyName("SubnetSize")]
         public uint SubnetSize { get; set; } = default!;
@@ -31,13 +31,15 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
 
         [JsonPropertyName("Spec")]
         public Spec Spec { get; set; } = default!;
@@ -48,13 +50,19 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("RootRotationInProgress")]
         public bool RootRotationInProgress { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.39 or later.</remarks>
         [JsonPropertyName("DefaultAddrPool")]
-        public IList<string> DefaultAddrPool { get; set; } = default!;
+        [MinimumApiVersion("1.39")]
+        public IList<string>? DefaultAddrPool { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.39 or later.</remarks>
         [JsonPropertyName("SubnetSize")]
+        [MinimumApiVersion("1.39")]
         public uint SubnetSize { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.40 or later.</remarks>
         [JsonPropertyName("DataPathPort")]
+        [MinimumApiVersion("1.40")]
         public uint DataPathPort { get; set; } = default!;
 
         [JsonPropertyName("JoinTokens")]
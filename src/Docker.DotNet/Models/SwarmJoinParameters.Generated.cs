@@ -16,7 +16,7 @@ namespace Docker.DotNet.Models
         public string DataPathAddr { get; set; } = string.Empty;
 
         [JsonPropertyName("RemoteAddrs")]
-        public IList<string> RemoteAddrs { get; set; } = default!;
+        public IList<string>? RemoteAddrs { get; set; }
 
         /// <summary>
         /// accept by secret
@@ -25,6 +25,6 @@ namespace Docker.DotNet.Models
         public string JoinToken { get; set; } = string.Empty;
 
         [JsonPropertyName("Availability")]
-        public string Availability { get; set; } = string.Empty;
+        public NodeAvailability? Availability { get; set; }
     }
 }
@@ -7,12 +7,12 @@ namespace Docker.DotNet.Models
     public class SwarmLimit // (swarm.Limit)
     {
         [JsonPropertyName("NanoCPUs")]
-        public long? NanoCPUs { get; set; }
+        public long NanoCPUs { get; set; } = default!;
 
         [JsonPropertyName("MemoryBytes")]
-        public long? MemoryBytes { get; set; }
+        public long MemoryBytes { get; set; } = default!;
 
         [JsonPropertyName("Pids")]
-        public long? Pids { get; set; }
+        public long Pids { get; set; } = default!;
     }
 }
@@ -24,19 +24,21 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
 
         [JsonPropertyName("Spec")]
-        public NetworkSpec? Spec { get; set; }
+        public NetworkSpec Spec { get; set; } = default!;
 
         [JsonPropertyName("DriverState")]
-        public SwarmDriver? DriverState { get; set; }
+        public SwarmDriver DriverState { get; set; } = default!;
 
         [JsonPropertyName("IPAMOptions")]
         public IPAMOptions? IPAMOptions { get; set; }
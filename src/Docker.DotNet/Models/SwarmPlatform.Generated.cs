@@ -7,9 +7,9 @@ namespace Docker.DotNet.Models
     public class SwarmPlatform // (swarm.Platform)
     {
         [JsonPropertyName("Architecture")]
-        public string? Architecture { get; set; }
+        public string Architecture { get; set; } = string.Empty;
 
         [JsonPropertyName("OS")]
-        public string? OS { get; set; }
+        public string OS { get; set; } = string.Empty;
     }
 }
@@ -7,15 +7,17 @@ namespace Docker.DotNet.Models
     public class SwarmRestartPolicy // (swarm.RestartPolicy)
     {
         [JsonPropertyName("Condition")]
-        public string? Condition { get; set; }
+        public RestartPolicyCondition? Condition { get; set; }
 
         [JsonPropertyName("Delay")]
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
         public TimeSpan? Delay { get; set; }
 
         [JsonPropertyName("MaxAttempts")]
         public ulong? MaxAttempts { get; set; }
 
         [JsonPropertyName("Window")]
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
         public TimeSpan? Window { get; set; }
     }
 }
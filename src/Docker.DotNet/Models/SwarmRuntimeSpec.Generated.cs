@@ -8,16 +8,16 @@ namespace Docker.DotNet.Models
     public class SwarmRuntimeSpec // (swarm.RuntimeSpec)
     {
         [JsonPropertyName("name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("remote")]
-        public string? Remote { get; set; }
+        public string Remote { get; set; } = string.Empty;
 
         [JsonPropertyName("privileges")]
         public IList<RuntimePrivilege>? Privileges { get; set; }
 
         [JsonPropertyName("disabled")]
-        public bool? Disabled { get; set; }
+        public bool Disabled { get; set; } = default!;
 
         [JsonPropertyName("env")]
         public IList<string>? Env { get; set; }
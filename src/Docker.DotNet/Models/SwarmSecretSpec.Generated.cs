@@ -20,21 +20,22 @@ namespace Docker.DotNet.Models
         }
 
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
+        /// <para>
         /// Data is the data to store as a secret. It must be empty if a
-        /// [Driver] is used, in which case the data is loaded from an external
+        /// <see cref="SwarmDriver"/> is used, in which case the data is loaded from an external
         /// secret store. The maximum allowed size is 500KB, as defined in
-        /// [MaxSecretSize].
-        /// 
+        /// <see href="https://pkg.go.dev/github.com/moby/swarmkit/v2@v2.0.0/api/validation#MaxSecretSize">MaxSecretSize</see>.
+        /// </para>
+        /// <para>
         /// This field is only used to create the secret, and is not returned
         /// by other endpoints.
-        /// 
-        /// [MaxSecretSize]: https://pkg.go.dev/github.com/moby/swarmkit/v2@v2.0.0/api/validation#MaxSecretSize
+        /// </para>
         /// </summary>
         [JsonPropertyName("Data")]
         public byte[]? Data { get; set; }
@@ -44,14 +45,18 @@ namespace Docker.DotNet.Models
         /// value from an external secret store. If not set, the default built-in
         /// store is used.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.31 or later.</remarks>
         [JsonPropertyName("Driver")]
+        [MinimumApiVersion("1.31")]
         public SwarmDriver? Driver { get; set; }
 
         /// <summary>
         /// Templating controls whether and how to evaluate the secret payload as
         /// a template. If it is not set, no templating is used.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.37 or later.</remarks>
         [JsonPropertyName("Templating")]
+        [MinimumApiVersion("1.37")]
         public SwarmDriver? Templating { get; set; }
     }
 }
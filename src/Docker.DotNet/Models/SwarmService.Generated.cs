@@ -24,22 +24,24 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
 
         [JsonPropertyName("Spec")]
-        public ServiceSpec? Spec { get; set; }
+        public ServiceSpec Spec { get; set; } = default!;
 
         [JsonPropertyName("PreviousSpec")]
         public ServiceSpec? PreviousSpec { get; set; }
 
         [JsonPropertyName("Endpoint")]
-        public Endpoint? Endpoint { get; set; }
+        public Endpoint Endpoint { get; set; } = default!;
 
         [JsonPropertyName("UpdateStatus")]
         public UpdateStatus? UpdateStatus { get; set; }
@@ -51,14 +53,18 @@ namespace Docker.DotNet.Models
         /// listing all tasks for a service, an operation that could be
         /// computation and network expensive.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("ServiceStatus")]
+        [MinimumApiVersion("1.41")]
         public ServiceStatus? ServiceStatus { get; set; }
 
         /// <summary>
         /// JobStatus is the status of a Service which is in one of ReplicatedJob or
         /// GlobalJob modes. It is absent on Replicated and Global services.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("JobStatus")]
+        [MinimumApiVersion("1.41")]
         public JobStatus? JobStatus { get; set; }
     }
 }
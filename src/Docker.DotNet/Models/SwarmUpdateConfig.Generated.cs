@@ -17,13 +17,14 @@ namespace Docker.DotNet.Models
         /// Amount of time between updates.
         /// </summary>
         [JsonPropertyName("Delay")]
-        public TimeSpan? Delay { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        public TimeSpan Delay { get; set; } = default!;
 
         /// <summary>
         /// FailureAction is the action to take when an update failures.
         /// </summary>
         [JsonPropertyName("FailureAction")]
-        public string? FailureAction { get; set; }
+        public FailureAction? FailureAction { get; set; }
 
         /// <summary>
         /// Monitor indicates how long to monitor a task for failure after it is
@@ -34,9 +35,11 @@ namespace Docker.DotNet.Models
         /// be used.
         /// </summary>
         [JsonPropertyName("Monitor")]
-        public TimeSpan? Monitor { get; set; }
+        [JsonConverter(typeof(JsonTimeSpanNanosecondsConverter))]
+        public TimeSpan Monitor { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// MaxFailureRatio is the fraction of tasks that may fail during
         /// an update before the failure action is invoked. Any task created by
         /// the current update which ends up in one of the states REJECTED,
@@ -44,10 +47,12 @@ namespace Docker.DotNet.Models
         /// failure. The number of failures is divided by the number of tasks
         /// being updated, and if this fraction is greater than
         /// MaxFailureRatio, the failure action is invoked.
-        /// 
+        /// </para>
+        /// <para>
         /// If the failure action is CONTINUE, there is no effect.
         /// If the failure action is PAUSE, no more tasks will be updated until
         /// another update is started.
+        /// </para>
         /// </summary>
         [JsonPropertyName("MaxFailureRatio")]
         public float MaxFailureRatio { get; set; } = default!;
@@ -58,6 +63,6 @@ namespace Docker.DotNet.Models
         /// started, or the new task is started before the old task is shut down.
         /// </summary>
         [JsonPropertyName("Order")]
-        public string Order { get; set; } = string.Empty;
+        public UpdateOrder? Order { get; set; }
     }
 }
@@ -29,7 +29,7 @@ namespace Docker.DotNet.Models
         public string Driver { get; set; } = string.Empty;
 
         [JsonPropertyName("DriverStatus")]
-        public IList<string[]> DriverStatus { get; set; } = default!;
+        public IList<string[]>? DriverStatus { get; set; }
 
         /// <summary>
         /// SystemStatus is only propagated by the Swarm standalone API
@@ -58,7 +58,9 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("CPUSet")]
         public bool CPUSet { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.40 or later.</remarks>
         [JsonPropertyName("PidsLimit")]
+        [MinimumApiVersion("1.40")]
         public bool PidsLimit { get; set; } = default!;
 
         [JsonPropertyName("IPv4Forwarding")]
@@ -77,7 +79,8 @@ namespace Docker.DotNet.Models
         public long NGoroutines { get; set; } = default!;
 
         [JsonPropertyName("SystemTime")]
-        public string SystemTime { get; set; } = string.Empty;
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? SystemTime { get; set; }
 
         [JsonPropertyName("LoggingDriver")]
         public string LoggingDriver { get; set; } = string.Empty;
@@ -85,8 +88,10 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("CgroupDriver")]
         public string CgroupDriver { get; set; } = string.Empty;
 
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("CgroupVersion")]
-        public string? CgroupVersion { get; set; }
+        [MinimumApiVersion("1.41")]
+        public string CgroupVersion { get; set; } = string.Empty;
 
         [JsonPropertyName("NEventsListener")]
         public long NEventsListener { get; set; } = default!;
@@ -97,7 +102,9 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("OperatingSystem")]
         public string OperatingSystem { get; set; } = string.Empty;
 
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("OSVersion")]
+        [MinimumApiVersion("1.41")]
         public string OSVersion { get; set; } = string.Empty;
 
         [JsonPropertyName("OSType")]
@@ -119,7 +126,7 @@ namespace Docker.DotNet.Models
         public long MemTotal { get; set; } = default!;
 
         [JsonPropertyName("GenericResources")]
-        public IList<GenericResource> GenericResources { get; set; } = default!;
+        public IList<GenericResource>? GenericResources { get; set; }
 
         [JsonPropertyName("DockerRootDir")]
         public string DockerRootDir { get; set; } = string.Empty;
@@ -137,7 +144,7 @@ namespace Docker.DotNet.Models
         public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IList<string> Labels { get; set; } = default!;
+        public IList<string>? Labels { get; set; }
 
         [JsonPropertyName("ExperimentalBuild")]
         public bool ExperimentalBuild { get; set; } = default!;
@@ -146,7 +153,7 @@ namespace Docker.DotNet.Models
         public string ServerVersion { get; set; } = string.Empty;
 
         [JsonPropertyName("Runtimes")]
-        public IDictionary<string, RuntimeWithStatus> Runtimes { get; set; } = default!;
+        public IDictionary<string, RuntimeWithStatus>? Runtimes { get; set; }
 
         [JsonPropertyName("DefaultRuntime")]
         public string DefaultRuntime { get; set; } = string.Empty;
@@ -163,7 +170,7 @@ namespace Docker.DotNet.Models
         public bool LiveRestoreEnabled { get; set; } = default!;
 
         [JsonPropertyName("Isolation")]
-        public string Isolation { get; set; } = string.Empty;
+        public Isolation Isolation { get; set; } = default!;
 
         [JsonPropertyName("InitBinary")]
         public string InitBinary { get; set; } = string.Empty;
@@ -178,27 +185,41 @@ namespace Docker.DotNet.Models
         public Commit InitCommit { get; set; } = default!;
 
         [JsonPropertyName("SecurityOptions")]
-        public IList<string> SecurityOptions { get; set; } = default!;
+        public IList<string>? SecurityOptions { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.39 or later.</remarks>
         [JsonPropertyName("ProductLicense")]
-        public string? ProductLicense { get; set; }
+        [MinimumApiVersion("1.39")]
+        public string ProductLicense { get; set; } = string.Empty;
 
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("DefaultAddressPools")]
+        [MinimumApiVersion("1.41")]
         public IList<NetworkAddressPool>? DefaultAddressPools { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.49 or later.</remarks>
         [JsonPropertyName("FirewallBackend")]
+        [MinimumApiVersion("1.49")]
         public FirewallInfo? FirewallBackend { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.44 or later.</remarks>
         [JsonPropertyName("CDISpecDirs")]
-        public IList<string> CDISpecDirs { get; set; } = default!;
+        [MinimumApiVersion("1.44")]
+        public IList<string>? CDISpecDirs { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.50 or later.</remarks>
         [JsonPropertyName("DiscoveredDevices")]
+        [MinimumApiVersion("1.50")]
         public IList<DeviceInfo>? DiscoveredDevices { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.53 or later.</remarks>
         [JsonPropertyName("NRI")]
+        [MinimumApiVersion("1.53")]
         public NRIInfo? NRI { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.46 or later.</remarks>
         [JsonPropertyName("Containerd")]
+        [MinimumApiVersion("1.46")]
         public ContainerdInfo? Containerd { get; set; }
 
         /// <summary>
@@ -207,7 +228,9 @@ namespace Docker.DotNet.Models
         /// messages for the user, and are not intended to be parsed / used for
         /// other purposes, as they do not have a fixed format.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.39 or later.</remarks>
         [JsonPropertyName("Warnings")]
-        public IList<string> Warnings { get; set; } = default!;
+        [MinimumApiVersion("1.39")]
+        public IList<string>? Warnings { get; set; }
     }
 }
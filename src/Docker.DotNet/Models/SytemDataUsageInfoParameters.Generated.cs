@@ -3,10 +3,14 @@ namespace Docker.DotNet.Models
 {
     public class SytemDataUsageInfoParameters // (main.SytemDataUsageInfoParameters)
     {
+        /// <remarks>Requires Docker Engine API v1.42 or later.</remarks>
         [QueryStringListParameter("type", false)]
+        [MinimumApiVersion("1.42")]
         public IList<string>? Type { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.52 or later.</remarks>
         [QueryStringBoolParameter("verbose", false)]
+        [MinimumApiVersion("1.52")]
         public bool? Verbose { get; set; }
     }
 }
@@ -11,7 +11,7 @@ namespace Docker.DotNet.Models
         /// TrustRoot is the trusted CA root certificate in PEM format
         /// </summary>
         [JsonPropertyName("TrustRoot")]
-        public string? TrustRoot { get; set; }
+        public string TrustRoot { get; set; } = string.Empty;
 
         /// <summary>
         /// CertIssuer is the raw subject bytes of the issuer
@@ -7,12 +7,15 @@ namespace Docker.DotNet.Models
     public class TaskDefaults // (swarm.TaskDefaults)
     {
         /// <summary>
+        /// <para>
         /// LogDriver selects the log driver to use for tasks created in the
         /// orchestrator if unspecified by a service.
-        /// 
+        /// </para>
+        /// <para>
         /// Updating this value will only have an affect on new tasks. Old tasks
         /// will continue use their previously configured log driver until
         /// recreated.
+        /// </para>
         /// </summary>
         [JsonPropertyName("LogDriver")]
         public SwarmDriver? LogDriver { get; set; }
@@ -30,34 +30,36 @@ namespace Docker.DotNet.Models
         public string ID { get; set; } = string.Empty;
 
         [JsonPropertyName("Version")]
-        public Version? Version { get; set; }
+        public Version Version { get; set; } = default!;
 
         [JsonPropertyName("CreatedAt")]
-        public DateTime? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         [JsonPropertyName("UpdatedAt")]
-        public DateTime? UpdatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? UpdatedAt { get; set; }
 
         [JsonPropertyName("Name")]
-        public string? Name { get; set; }
+        public string Name { get; set; } = string.Empty;
 
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         [JsonPropertyName("Spec")]
-        public TaskSpec? Spec { get; set; }
+        public TaskSpec Spec { get; set; } = default!;
 
         [JsonPropertyName("ServiceID")]
-        public string? ServiceID { get; set; }
+        public string ServiceID { get; set; } = string.Empty;
 
         [JsonPropertyName("Slot")]
-        public long? Slot { get; set; }
+        public long Slot { get; set; } = default!;
 
         [JsonPropertyName("NodeID")]
-        public string? NodeID { get; set; }
+        public string NodeID { get; set; } = string.Empty;
 
         [JsonPropertyName("Status")]
-        public TaskStatus? Status { get; set; }
+        public TaskStatus Status { get; set; } = default!;
 
         [JsonPropertyName("DesiredState")]
         public TaskState? DesiredState { get; set; }
@@ -74,7 +76,9 @@ namespace Docker.DotNet.Models
         /// used to determine which Tasks belong to which run of the job. This field
         /// is absent if the Service mode is Replicated or Global.
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.41 or later.</remarks>
         [JsonPropertyName("JobIteration")]
+        [MinimumApiVersion("1.41")]
         public Version? JobIteration { get; set; }
 
         /// <summary>
@@ -83,6 +87,6 @@ namespace Docker.DotNet.Models
         /// fulfilling what mounts in the spec.
         /// </summary>
         [JsonPropertyName("Volumes")]
-        public IList<VolumeAttachment> Volumes { get; set; } = default!;
+        public IList<VolumeAttachment>? Volumes { get; set; }
     }
 }
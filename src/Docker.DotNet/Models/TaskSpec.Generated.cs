@@ -15,10 +15,14 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("ContainerSpec")]
         public ContainerSpec? ContainerSpec { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.31 or later.</remarks>
         [JsonPropertyName("PluginSpec")]
+        [MinimumApiVersion("1.31")]
         public SwarmRuntimeSpec? PluginSpec { get; set; }
 
+        /// <remarks>Requires Docker Engine API v1.38 or later.</remarks>
         [JsonPropertyName("NetworkAttachmentSpec")]
+        [MinimumApiVersion("1.38")]
         public NetworkAttachmentSpec? NetworkAttachmentSpec { get; set; }
 
         [JsonPropertyName("Resources")]
@@ -48,7 +52,9 @@ namespace Docker.DotNet.Models
         [JsonPropertyName("ForceUpdate")]
         public ulong ForceUpdate { get; set; } = default!;
 
+        /// <remarks>Requires Docker Engine API v1.30 or later.</remarks>
         [JsonPropertyName("Runtime")]
-        public string? Runtime { get; set; }
+        [MinimumApiVersion("1.30")]
+        public RuntimeType? Runtime { get; set; }
     }
 }
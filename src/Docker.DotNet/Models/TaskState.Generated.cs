@@ -0,0 +1,103 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// TaskState represents the state of a task.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<TaskState>))]
+    public enum TaskState // (swarm.TaskState)
+    {
+        /// <summary>
+        /// TaskStateNew NEW
+        /// </summary>
+        [EnumMember(Value = "new")]
+        New,
+
+        /// <summary>
+        /// TaskStateAllocated ALLOCATED
+        /// </summary>
+        [EnumMember(Value = "allocated")]
+        Allocated,
+
+        /// <summary>
+        /// TaskStatePending PENDING
+        /// </summary>
+        [EnumMember(Value = "pending")]
+        Pending,
+
+        /// <summary>
+        /// TaskStateAssigned ASSIGNED
+        /// </summary>
+        [EnumMember(Value = "assigned")]
+        Assigned,
+
+        /// <summary>
+        /// TaskStateAccepted ACCEPTED
+        /// </summary>
+        [EnumMember(Value = "accepted")]
+        Accepted,
+
+        /// <summary>
+        /// TaskStatePreparing PREPARING
+        /// </summary>
+        [EnumMember(Value = "preparing")]
+        Preparing,
+
+        /// <summary>
+        /// TaskStateReady READY
+        /// </summary>
+        [EnumMember(Value = "ready")]
+        Ready,
+
+        /// <summary>
+        /// TaskStateStarting STARTING
+        /// </summary>
+        [EnumMember(Value = "starting")]
+        Starting,
+
+        /// <summary>
+        /// TaskStateRunning RUNNING
+        /// </summary>
+        [EnumMember(Value = "running")]
+        Running,
+
+        /// <summary>
+        /// TaskStateComplete COMPLETE
+        /// </summary>
+        [EnumMember(Value = "complete")]
+        Complete,
+
+        /// <summary>
+        /// TaskStateShutdown SHUTDOWN
+        /// </summary>
+        [EnumMember(Value = "shutdown")]
+        Shutdown,
+
+        /// <summary>
+        /// TaskStateFailed FAILED
+        /// </summary>
+        [EnumMember(Value = "failed")]
+        Failed,
+
+        /// <summary>
+        /// TaskStateRejected REJECTED
+        /// </summary>
+        [EnumMember(Value = "rejected")]
+        Rejected,
+
+        /// <summary>
+        /// TaskStateRemove REMOVE
+        /// </summary>
+        [EnumMember(Value = "remove")]
+        Remove,
+
+        /// <summary>
+        /// TaskStateOrphaned ORPHANED
+        /// </summary>
+        [EnumMember(Value = "orphaned")]
+        Orphaned,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
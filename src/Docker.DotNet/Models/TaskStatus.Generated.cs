@@ -7,21 +7,22 @@ namespace Docker.DotNet.Models
     public class TaskStatus // (swarm.TaskStatus)
     {
         [JsonPropertyName("Timestamp")]
-        public DateTime? Timestamp { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? Timestamp { get; set; }
 
         [JsonPropertyName("State")]
         public TaskState? State { get; set; }
 
         [JsonPropertyName("Message")]
-        public string? Message { get; set; }
+        public string Message { get; set; } = string.Empty;
 
         [JsonPropertyName("Err")]
-        public string? Err { get; set; }
+        public string Err { get; set; } = string.Empty;
 
         [JsonPropertyName("ContainerStatus")]
         public ContainerStatus? ContainerStatus { get; set; }
 
         [JsonPropertyName("PortStatus")]
-        public PortStatus? PortStatus { get; set; }
+        public PortStatus PortStatus { get; set; } = default!;
     }
 }
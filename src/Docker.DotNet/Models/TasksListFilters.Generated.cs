@@ -0,0 +1,62 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="TasksListParameters.Filters"/> of <c>GET /tasks</c>.
+    /// </summary>
+    public sealed class TasksListFilters : FiltersBuilder<TasksListFilters> // (TasksListParameters.Filters)
+    {
+        /// <summary>
+        /// `desired-state=(running | shutdown | accepted)`
+        /// </summary>
+        public TasksListFilters DesiredState(TaskState value) => AddFilter("desired-state", value switch
+        {
+            TaskState.New => "new",
+            TaskState.Allocated => "allocated",
+            TaskState.Pending => "pending",
+            TaskState.Assigned => "assigned",
+            TaskState.Accepted => "accepted",
+            TaskState.Preparing => "preparing",
+            TaskState.Ready => "ready",
+            TaskState.Starting => "starting",
+            TaskState.Running => "running",
+            TaskState.Complete => "complete",
+            TaskState.Shutdown => "shutdown",
+            TaskState.Failed => "failed",
+            TaskState.Rejected => "rejected",
+            TaskState.Remove => "remove",
+            TaskState.Orphaned => "orphaned",
+            _ => throw new ArgumentOutOfRangeException(nameof(value), value, null),
+        });
+
+        /// <summary>
+        /// `id=&lt;task id&gt;`
+        /// </summary>
+        public TasksListFilters Id(string value) => AddFilter("id", value);
+
+        /// <summary>
+        /// `label=key` or `label=&quot;key=value&quot;`
+        /// </summary>
+        public TasksListFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public TasksListFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `name=&lt;task name&gt;`
+        /// </summary>
+        public TasksListFilters Name(string value) => AddFilter("name", value);
+
+        /// <summary>
+        /// `node=&lt;node id or name&gt;`
+        /// </summary>
+        public TasksListFilters Node(string value) => AddFilter("node", value);
+
+        /// <summary>
+        /// `service=&lt;service name&gt;`
+        /// </summary>
+        public TasksListFilters Service(string value) => AddFilter("service", value);
+    }
+}
@@ -7,23 +7,27 @@ namespace Docker.DotNet.Models
     public class TmpfsOptions // (mount.TmpfsOptions)
     {
         /// <summary>
+        /// <para>
         /// Size sets the size of the tmpfs, in bytes.
-        /// 
+        /// </para>
+        /// <para>
         /// This will be converted to an operating system specific value
         /// depending on the host. For example, on linux, it will be converted to
         /// use a &apos;k&apos;, &apos;m&apos; or &apos;g&apos; syntax. BSD, though not widely supported with
         /// docker, uses a straight byte value.
-        /// 
+        /// </para>
+        /// <para>
         /// Percentages are not supported.
+        /// </para>
         /// </summary>
         [JsonPropertyName("SizeBytes")]
-        public long? SizeBytes { get; set; }
+        public long SizeBytes { get; set; } = default!;
 
         /// <summary>
         /// Mode of the tmpfs upon creation
         /// </summary>
         [JsonPropertyName("Mode")]
-        public uint? Mode { get; set; }
+        public uint Mode { get; set; } = default!;
 
         /// <summary>
         /// Options to be passed to the tmpfs mount. An array of arrays. Flag
@@ -8,56 +8,85 @@ namespace Docker.DotNet.Models
     public class TopologyRequirement // (volume.TopologyRequirement)
     {
         /// <summary>
+        /// <para>
         /// Requisite specifies a list of Topologies, at least one of which the
         /// volume must be accessible from.
-        /// 
+        /// </para>
+        /// <para>
         /// Taken verbatim from the CSI Spec:
-        /// 
+        /// </para>
+        /// <para>
         /// Specifies the list of topologies the provisioned volume MUST be
         /// accessible from.
         /// This field is OPTIONAL. If TopologyRequirement is specified either
         /// requisite or preferred or both MUST be specified.
-        /// 
+        /// </para>
+        /// <para>
         /// If requisite is specified, the provisioned volume MUST be
         /// accessible from at least one of the requisite topologies.
-        /// 
+        /// </para>
+        /// <para>
         /// Given
-        ///   x = number of topologies provisioned volume is accessible from
-        ///   n = number of requisite topologies
+        /// </para>
+        /// <code>
+        /// x = number of topologies provisioned volume is accessible from
+        /// n = number of requisite topologies
+        /// </code>
+        /// <para>
         /// The CO MUST ensure n &gt;= 1. The SP MUST ensure x &gt;= 1
         /// If x==n, then the SP MUST make the provisioned volume available to
         /// all topologies from the list of requisite topologies. If it is
         /// unable to do so, the SP MUST fail the CreateVolume call.
         /// For example, if a volume should be accessible from a single zone,
         /// and requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;}
+        /// </code>
+        /// <para>
         /// then the provisioned volume MUST be accessible from the &quot;region&quot;
         /// &quot;R1&quot; and the &quot;zone&quot; &quot;Z2&quot;.
         /// Similarly, if a volume should be accessible from two zones, and
         /// requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </code>
+        /// <para>
         /// then the provisioned volume MUST be accessible from the &quot;region&quot;
         /// &quot;R1&quot; and both &quot;zone&quot; &quot;Z2&quot; and &quot;zone&quot; &quot;Z3&quot;.
-        /// 
+        /// </para>
+        /// <para>
         /// If x&lt;n, then the SP SHALL choose x unique topologies from the list
         /// of requisite topologies. If it is unable to do so, the SP MUST fail
         /// the CreateVolume call.
         /// For example, if a volume should be accessible from a single zone,
         /// and requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </code>
+        /// <para>
         /// then the SP may choose to make the provisioned volume available in
         /// either the &quot;zone&quot; &quot;Z2&quot; or the &quot;zone&quot; &quot;Z3&quot; in the &quot;region&quot; &quot;R1&quot;.
         /// Similarly, if a volume should be accessible from two zones, and
         /// requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;}
+        /// </code>
+        /// <para>
         /// then the provisioned volume MUST be accessible from any combination
         /// of two unique topologies: e.g. &quot;R1/Z2&quot; and &quot;R1/Z3&quot;, or &quot;R1/Z2&quot; and
-        ///  &quot;R1/Z4&quot;, or &quot;R1/Z3&quot; and &quot;R1/Z4&quot;.
-        /// 
+        /// </para>
+        /// <code>
+        /// &quot;R1/Z4&quot;, or &quot;R1/Z3&quot; and &quot;R1/Z4&quot;.
+        /// </code>
+        /// <para>
         /// If x&gt;n, then the SP MUST make the provisioned volume available from
         /// all topologies from the list of requisite topologies and MAY choose
         /// the remaining x-n unique topologies from the list of all possible
@@ -65,32 +94,44 @@ namespace Docker.DotNet.Models
         /// CreateVolume call.
         /// For example, if a volume should be accessible from two zones, and
         /// requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;}
+        /// </code>
+        /// <para>
         /// then the provisioned volume MUST be accessible from the &quot;region&quot;
         /// &quot;R1&quot; and the &quot;zone&quot; &quot;Z2&quot; and the SP may select the second zone
         /// independently, e.g. &quot;R1/Z4&quot;.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Requisite")]
         public IList<VolumeTopology>? Requisite { get; set; }
 
         /// <summary>
+        /// <para>
         /// Preferred is a list of Topologies that the volume should attempt to be
         /// provisioned in.
-        /// 
+        /// </para>
+        /// <para>
         /// Taken from the CSI spec:
-        /// 
+        /// </para>
+        /// <para>
         /// Specifies the list of topologies the CO would prefer the volume to
         /// be provisioned in.
-        /// 
+        /// </para>
+        /// <para>
         /// This field is OPTIONAL. If TopologyRequirement is specified either
         /// requisite or preferred or both MUST be specified.
-        /// 
+        /// </para>
+        /// <para>
         /// An SP MUST attempt to make the provisioned volume available using
         /// the preferred topologies in order from first to last.
-        /// 
+        /// </para>
+        /// <para>
         /// If requisite is specified, all topologies in preferred list MUST
         /// also be present in the list of requisite topologies.
-        /// 
+        /// </para>
+        /// <para>
         /// If the SP is unable to make the provisioned volume available
         /// from any of the preferred topologies, the SP MAY choose a topology
         /// from the list of requisite topologies.
@@ -99,47 +140,74 @@ namespace Docker.DotNet.Models
         /// If the list of requisite topologies is specified and the SP is
         /// unable to make the provisioned volume available from any of the
         /// requisite topologies it MUST fail the CreateVolume call.
-        /// 
+        /// </para>
+        /// <para>
         /// Example 1:
         /// Given a volume should be accessible from a single zone, and
         /// requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </code>
+        /// <para>
         /// preferred =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </code>
+        /// <para>
         /// then the SP SHOULD first attempt to make the provisioned volume
         /// available from &quot;zone&quot; &quot;Z3&quot; in the &quot;region&quot; &quot;R1&quot; and fall back to
         /// &quot;zone&quot; &quot;Z2&quot; in the &quot;region&quot; &quot;R1&quot; if that is not possible.
-        /// 
+        /// </para>
+        /// <para>
         /// Example 2:
         /// Given a volume should be accessible from a single zone, and
         /// requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z5&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z5&quot;}
+        /// </code>
+        /// <para>
         /// preferred =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;}
+        /// </code>
+        /// <para>
         /// then the SP SHOULD first attempt to make the provisioned volume
         /// accessible from &quot;zone&quot; &quot;Z4&quot; in the &quot;region&quot; &quot;R1&quot; and fall back to
         /// &quot;zone&quot; &quot;Z2&quot; in the &quot;region&quot; &quot;R1&quot; if that is not possible. If that
         /// is not possible, the SP may choose between either the &quot;zone&quot;
         /// &quot;Z3&quot; or &quot;Z5&quot; in the &quot;region&quot; &quot;R1&quot;.
-        /// 
+        /// </para>
+        /// <para>
         /// Example 3:
         /// Given a volume should be accessible from TWO zones (because an
         /// opaque parameter in CreateVolumeRequest, for example, specifies
         /// the volume is accessible from two zones, aka synchronously
         /// replicated), and
         /// requisite =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z5&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z2&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z4&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z5&quot;}
+        /// </code>
+        /// <para>
         /// preferred =
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z5&quot;},
-        ///   {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </para>
+        /// <code>
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z5&quot;},
+        /// {&quot;region&quot;: &quot;R1&quot;, &quot;zone&quot;: &quot;Z3&quot;}
+        /// </code>
+        /// <para>
         /// then the SP SHOULD first attempt to make the provisioned volume
         /// accessible from the combination of the two &quot;zones&quot; &quot;Z5&quot; and &quot;Z3&quot; in
         /// the &quot;region&quot; &quot;R1&quot;. If that&apos;s not possible, it should fall back to
@@ -148,6 +216,7 @@ namespace Docker.DotNet.Models
         /// combination of &quot;Z3&quot; and other possibilities from the list of
         /// requisite. If that&apos;s not possible, it should fall back  to a
         /// combination of other possibilities from the list of requisite.
+        /// </para>
         /// </summary>
         [JsonPropertyName("Preferred")]
         public IList<VolumeTopology>? Preferred { get; set; }
@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// TypeBlock defines options for using a volume as a block-type volume.
-    /// 
+    /// </para>
+    /// <para>
     /// Intentionally empty.
+    /// </para>
     /// </summary>
     public class TypeBlock // (volume.TypeBlock)
     {
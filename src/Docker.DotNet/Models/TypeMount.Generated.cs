@@ -11,7 +11,7 @@ namespace Docker.DotNet.Models
         /// FsType specifies the filesystem type for the mount volume. Optional.
         /// </summary>
         [JsonPropertyName("FsType")]
-        public string? FsType { get; set; }
+        public string FsType { get; set; } = string.Empty;
 
         /// <summary>
         /// MountFlags defines flags to pass when mounting the volume. Optional.
@@ -0,0 +1,26 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// UpdateOrder is the order of operations when rolling out or rolling back
+    /// an updated tasks for a service.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<UpdateOrder>))]
+    public enum UpdateOrder // (swarm.UpdateOrder)
+    {
+        /// <summary>
+        /// UpdateOrderStopFirst STOP_FIRST
+        /// </summary>
+        [EnumMember(Value = "stop-first")]
+        StopFirst,
+
+        /// <summary>
+        /// UpdateOrderStartFirst START_FIRST
+        /// </summary>
+        [EnumMember(Value = "start-first")]
+        StartFirst,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
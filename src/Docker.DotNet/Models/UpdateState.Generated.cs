@@ -0,0 +1,49 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// UpdateState is the state of a service update.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<UpdateState>))]
+    public enum UpdateState // (swarm.UpdateState)
+    {
+        /// <summary>
+        /// UpdateStateUpdating is the updating state.
+        /// </summary>
+        [EnumMember(Value = "updating")]
+        Updating,
+
+        /// <summary>
+        /// UpdateStatePaused is the paused state.
+        /// </summary>
+        [EnumMember(Value = "paused")]
+        Paused,
+
+        /// <summary>
+        /// UpdateStateCompleted is the completed state.
+        /// </summary>
+        [EnumMember(Value = "completed")]
+        Completed,
+
+        /// <summary>
+        /// UpdateStateRollbackStarted is the state with a rollback in progress.
+        /// </summary>
+        [EnumMember(Value = "rollback_started")]
+        RollbackStarted,
+
+        /// <summary>
+        /// UpdateStateRollbackPaused is the state with a rollback in progress.
+        /// </summary>
+        [EnumMember(Value = "rollback_paused")]
+        RollbackPaused,
+
+        /// <summary>
+        /// UpdateStateRollbackCompleted is the state with a rollback in progress.
+        /// </summary>
+        [EnumMember(Value = "rollback_completed")]
+        RollbackCompleted,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -7,15 +7,17 @@ namespace Docker.DotNet.Models
     public class UpdateStatus // (swarm.UpdateStatus)
     {
         [JsonPropertyName("State")]
-        public string? State { get; set; }
+        public UpdateState? State { get; set; }
 
         [JsonPropertyName("StartedAt")]
-        public DateTime? StartedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? StartedAt { get; set; }
 
         [JsonPropertyName("CompletedAt")]
-        public DateTime? CompletedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        public DateTimeOffset? CompletedAt { get; set; }
 
         [JsonPropertyName("Message")]
-        public string? Message { get; set; }
+        public string Message { get; set; } = string.Empty;
     }
 }
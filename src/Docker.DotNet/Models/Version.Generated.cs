@@ -7,6 +7,6 @@ namespace Docker.DotNet.Models
     public class Version // (swarm.Version)
     {
         [JsonPropertyName("Index")]
-        public ulong? Index { get; set; }
+        public ulong Index { get; set; } = default!;
     }
 }
@@ -11,7 +11,7 @@ namespace Docker.DotNet.Models
         /// Platform is the platform (product name) the server is running on.
         /// </summary>
         [JsonPropertyName("Platform")]
-        public PlatformInfo? Platform { get; set; }
+        public PlatformInfo Platform { get; set; } = default!;
 
         /// <summary>
         /// Version is the version of the daemon.
@@ -29,7 +29,7 @@ namespace Docker.DotNet.Models
         /// MinAPIVersion is the minimum API version the server supports.
         /// </summary>
         [JsonPropertyName("MinAPIVersion")]
-        public string? MinAPIVersion { get; set; }
+        public string MinAPIVersion { get; set; } = string.Empty;
 
         /// <summary>
         /// Os is the operating system the server runs on.
@@ -52,18 +52,18 @@ namespace Docker.DotNet.Models
         public IList<ComponentVersion>? Components { get; set; }
 
         [JsonPropertyName("GitCommit")]
-        public string? GitCommit { get; set; }
+        public string GitCommit { get; set; } = string.Empty;
 
         [JsonPropertyName("GoVersion")]
-        public string? GoVersion { get; set; }
+        public string GoVersion { get; set; } = string.Empty;
 
         [JsonPropertyName("KernelVersion")]
-        public string? KernelVersion { get; set; }
+        public string KernelVersion { get; set; } = string.Empty;
 
         [JsonPropertyName("Experimental")]
-        public bool? Experimental { get; set; }
+        public bool Experimental { get; set; } = default!;
 
         [JsonPropertyName("BuildTime")]
-        public string? BuildTime { get; set; }
+        public string BuildTime { get; set; } = string.Empty;
     }
 }
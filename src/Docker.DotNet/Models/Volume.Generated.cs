@@ -2,24 +2,32 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Volume volume
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model Volume
+    /// </para>
     /// </summary>
     public class Volume // (volume.Volume)
     {
         /// <summary>
         /// cluster volume
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.42 or later.</remarks>
         [JsonPropertyName("ClusterVolume")]
+        [MinimumApiVersion("1.42")]
         public ClusterVolume? ClusterVolume { get; set; }
 
         /// <summary>
         /// Date/Time the volume was created.
         /// Example: 2016-06-07T20:31:11.853781916Z
         /// </summary>
+        /// <remarks>Requires Docker Engine API v1.31 or later.</remarks>
         [JsonPropertyName("CreatedAt")]
-        public string? CreatedAt { get; set; }
+        [JsonConverter(typeof(JsonGoTimeConverter))]
+        [MinimumApiVersion("1.31")]
+        public DateTimeOffset? CreatedAt { get; set; }
 
         /// <summary>
         /// Name of the volume driver used by the volume.
@@ -35,7 +43,7 @@ namespace Docker.DotNet.Models
         /// Required: true
         /// </summary>
         [JsonPropertyName("Labels")]
-        public IDictionary<string, string> Labels { get; set; } = default!;
+        public IDictionary<string, string>? Labels { get; set; }
 
         /// <summary>
         /// Mount path of the volume on the host.
@@ -54,34 +62,44 @@ namespace Docker.DotNet.Models
         public string Name { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// The driver specific options used when creating the volume.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;device&quot;:&quot;tmpfs&quot;,&quot;o&quot;:&quot;size=100m,uid=1000&quot;,&quot;type&quot;:&quot;tmpfs&quot;}
         /// Required: true
+        /// </para>
         /// </summary>
         [JsonPropertyName("Options")]
-        public IDictionary<string, string> Options { get; set; } = default!;
+        public IDictionary<string, string>? Options { get; set; }
 
         /// <summary>
+        /// <para>
         /// The level at which the volume exists. Either `global` for cluster-wide,
         /// or `local` for machine level.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: local
         /// Required: true
         /// Enum: [&quot;local&quot;,&quot;global&quot;]
+        /// </para>
         /// </summary>
         [JsonPropertyName("Scope")]
         public string Scope { get; set; } = string.Empty;
 
         /// <summary>
+        /// <para>
         /// Low-level details about the volume, provided by the volume driver.
         /// Details are returned as a map with key/value pairs:
         /// `{&quot;key&quot;:&quot;value&quot;,&quot;key2&quot;:&quot;value2&quot;}`.
-        /// 
+        /// </para>
+        /// <para>
         /// The `Status` field is optional, and is omitted if the volume driver
         /// does not support this feature.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: {&quot;hello&quot;:&quot;world&quot;}
+        /// </para>
         /// </summary>
         [JsonPropertyName("Status")]
         public IDictionary<string, object>? Status { get; set; }
@@ -10,29 +10,35 @@ namespace Docker.DotNet.Models
         /// Scope defines the set of nodes this volume can be used on at one time.
         /// </summary>
         [JsonPropertyName("Scope")]
-        public string? Scope { get; set; }
+        public VolumeScope? Scope { get; set; }
 
         /// <summary>
         /// Sharing defines the number and way that different tasks can use this
         /// volume at one time.
         /// </summary>
         [JsonPropertyName("Sharing")]
-        public string? Sharing { get; set; }
+        public VolumeSharingMode? Sharing { get; set; }
 
         /// <summary>
+        /// <para>
         /// MountVolume defines options for using this volume as a Mount-type
         /// volume.
-        /// 
+        /// </para>
+        /// <para>
         /// Either BlockVolume or MountVolume, but not both, must be present.
+        /// </para>
         /// </summary>
         [JsonPropertyName("MountVolume")]
         public TypeMount? MountVolume { get; set; }
 
         /// <summary>
+        /// <para>
         /// BlockVolume defines options for using this volume as a Block-type
         /// volume.
-        /// 
+        /// </para>
+        /// <para>
         /// Either BlockVolume or MountVolume, but not both, must be present.
+        /// </para>
         /// </summary>
         [JsonPropertyName("BlockVolume")]
         public TypeBlock? BlockVolume { get; set; }
@@ -10,20 +10,20 @@ namespace Docker.DotNet.Models
         /// ID is the Swarmkit ID of the Volume. This is not the CSI VolumeId.
         /// </summary>
         [JsonPropertyName("ID")]
-        public string? ID { get; set; }
+        public string ID { get; set; } = string.Empty;
 
         /// <summary>
         /// Source, together with Target, indicates the Mount, as specified in the
         /// ContainerSpec, that this volume fulfills.
         /// </summary>
         [JsonPropertyName("Source")]
-        public string? Source { get; set; }
+        public string Source { get; set; } = string.Empty;
 
         /// <summary>
         /// Target, together with Source, indicates the Mount, as specified
         /// in the ContainerSpec, that this volume fulfills.
         /// </summary>
         [JsonPropertyName("Target")]
-        public string? Target { get; set; }
+        public string Target { get; set; } = string.Empty;
     }
 }
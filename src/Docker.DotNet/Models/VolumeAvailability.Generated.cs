@@ -0,0 +1,34 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Availability specifies the availability of the volume.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<VolumeAvailability>))]
+    public enum VolumeAvailability // (volume.Availability)
+    {
+        /// <summary>
+        /// AvailabilityActive indicates that the volume is active and fully
+        /// schedulable on the cluster.
+        /// </summary>
+        [EnumMember(Value = "active")]
+        Active,
+
+        /// <summary>
+        /// AvailabilityPause indicates that no new workloads should use the
+        /// volume, but existing workloads can continue to use it.
+        /// </summary>
+        [EnumMember(Value = "pause")]
+        Pause,
+
+        /// <summary>
+        /// AvailabilityDrain indicates that all workloads using this volume
+        /// should be rescheduled, and the volume unpublished from all nodes.
+        /// </summary>
+        [EnumMember(Value = "drain")]
+        Drain,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -2,19 +2,25 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// DiskUsage represents system data usage for volume resources.
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model DiskUsage
+    /// </para>
     /// </summary>
     public class VolumeDiskUsage // (volume.DiskUsage)
     {
         /// <summary>
+        /// <para>
         /// Count of active volumes.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: 1
+        /// </para>
         /// </summary>
         [JsonPropertyName("ActiveCount")]
-        public long? ActiveCount { get; set; }
+        public long ActiveCount { get; set; } = default!;
 
         /// <summary>
         /// List of volumes.
@@ -23,27 +29,36 @@ namespace Docker.DotNet.Models
         public IList<Volume>? Items { get; set; }
 
         /// <summary>
+        /// <para>
         /// Disk space that can be reclaimed by removing inactive volumes.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: 12345678
+        /// </para>
         /// </summary>
         [JsonPropertyName("Reclaimable")]
-        public long? Reclaimable { get; set; }
+        public long Reclaimable { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Count of all volumes.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: 4
+        /// </para>
         /// </summary>
         [JsonPropertyName("TotalCount")]
-        public long? TotalCount { get; set; }
+        public long TotalCount { get; set; } = default!;
 
         /// <summary>
+        /// <para>
         /// Disk space in use by volumes.
-        /// 
+        /// </para>
+        /// <para>
         /// Example: 98765432
+        /// </para>
         /// </summary>
         [JsonPropertyName("TotalSize")]
-        public long? TotalSize { get; set; }
+        public long TotalSize { get; set; } = default!;
     }
 }
@@ -12,7 +12,7 @@ namespace Docker.DotNet.Models
         /// indicates that the capacity is unknown.
         /// </summary>
         [JsonPropertyName("CapacityBytes")]
-        public long? CapacityBytes { get; set; }
+        public long CapacityBytes { get; set; } = default!;
 
         /// <summary>
         /// VolumeContext is the context originating from the CSI storage plugin
@@ -28,7 +28,7 @@ namespace Docker.DotNet.Models
         /// the Volume has not been successfully created yet.
         /// </summary>
         [JsonPropertyName("VolumeID")]
-        public string? VolumeID { get; set; }
+        public string VolumeID { get; set; } = string.Empty;
 
         /// <summary>
         /// AccessibleTopology is the topology this volume is actually accessible
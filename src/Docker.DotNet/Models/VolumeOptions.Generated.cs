@@ -7,13 +7,13 @@ namespace Docker.DotNet.Models
     public class VolumeOptions // (mount.VolumeOptions)
     {
         [JsonPropertyName("NoCopy")]
-        public bool? NoCopy { get; set; }
+        public bool NoCopy { get; set; } = default!;
 
         [JsonPropertyName("Labels")]
         public IDictionary<string, string>? Labels { get; set; }
 
         [JsonPropertyName("Subpath")]
-        public string? Subpath { get; set; }
+        public string Subpath { get; set; } = string.Empty;
 
         [JsonPropertyName("DriverConfig")]
         public Driver? DriverConfig { get; set; }
@@ -0,0 +1,44 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// PublishState represents the state of a Volume as it pertains to its
+    /// use on a particular Node.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<VolumePublishState>))]
+    public enum VolumePublishState // (volume.PublishState)
+    {
+        /// <summary>
+        /// StatePending indicates that the volume should be published on
+        /// this node, but the call to ControllerPublishVolume has not been
+        /// successfully completed yet and the result recorded by swarmkit.
+        /// </summary>
+        [EnumMember(Value = "pending-publish")]
+        PendingPublish,
+
+        /// <summary>
+        /// StatePublished means the volume is published successfully to the node.
+        /// </summary>
+        [EnumMember(Value = "published")]
+        Published,
+
+        /// <summary>
+        /// StatePendingNodeUnpublish indicates that the Volume should be
+        /// unpublished on the Node, and we&apos;re waiting for confirmation that it has
+        /// done so.  After the Node has confirmed that the Volume has been
+        /// unpublished, the state will move to StatePendingUnpublish.
+        /// </summary>
+        [EnumMember(Value = "pending-node-unpublish")]
+        PendingNodeUnpublish,
+
+        /// <summary>
+        /// StatePendingUnpublish means the volume is still published to the node
+        /// by the controller, awaiting the operation to unpublish it.
+        /// </summary>
+        [EnumMember(Value = "pending-controller-unpublish")]
+        PendingControllerUnpublish,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
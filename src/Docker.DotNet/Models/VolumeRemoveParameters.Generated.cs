@@ -0,0 +1,9 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    public class VolumeRemoveParameters // (main.VolumeRemoveParameters)
+    {
+        [QueryStringBoolParameter("force", false)]
+        public bool? Force { get; set; }
+    }
+}
@@ -0,0 +1,28 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Scope defines the Scope of a Cluster Volume. This is how many nodes a
+    /// Volume can be accessed simultaneously on.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<VolumeScope>))]
+    public enum VolumeScope // (volume.Scope)
+    {
+        /// <summary>
+        /// ScopeSingleNode indicates the volume can be used on one node at a
+        /// time.
+        /// </summary>
+        [EnumMember(Value = "single")]
+        Single,
+
+        /// <summary>
+        /// ScopeMultiNode indicates the volume can be used on many nodes at
+        /// the same time.
+        /// </summary>
+        [EnumMember(Value = "multi")]
+        Multi,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
@@ -0,0 +1,42 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// SharingMode defines the Sharing of a Cluster Volume. This is how Tasks using a
+    /// Volume at the same time can use it.
+    /// </summary>
+    [JsonConverter(typeof(JsonEnumMemberConverter<VolumeSharingMode>))]
+    public enum VolumeSharingMode // (volume.SharingMode)
+    {
+        /// <summary>
+        /// SharingNone indicates that only one Task may use the Volume at a
+        /// time.
+        /// </summary>
+        [EnumMember(Value = "none")]
+        None,
+
+        /// <summary>
+        /// SharingReadOnly indicates that the Volume may be shared by any
+        /// number of Tasks, but they must be read-only.
+        /// </summary>
+        [EnumMember(Value = "readonly")]
+        Readonly,
+
+        /// <summary>
+        /// SharingOneWriter indicates that the Volume may be shared by any
+        /// number of Tasks, but all after the first must be read-only.
+        /// </summary>
+        [EnumMember(Value = "onewriter")]
+        Onewriter,
+
+        /// <summary>
+        /// SharingAll means that the Volume may be shared by any number of
+        /// Tasks, as readers or writers.
+        /// </summary>
+        [EnumMember(Value = "all")]
+        All,
+
+        [EnumMember(Value = "")]
+        Undefined
+    }
+}
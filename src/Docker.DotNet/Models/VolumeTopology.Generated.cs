@@ -2,10 +2,13 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// Topology is a map of topological domains to topological segments.
-    /// 
+    /// </para>
+    /// <para>
     /// This description is taken verbatim from the CSI Spec:
-    /// 
+    /// </para>
+    /// <para>
     /// A topological domain is a sub-division of a cluster, like &quot;region&quot;,
     /// &quot;zone&quot;, &quot;rack&quot;, etc.
     /// A topological segment is a specific instance of a topological domain,
@@ -22,7 +25,7 @@ namespace Docker.DotNet.Models
     /// lower-case alphanumeric character ([a-z0-9]), contain only
     /// dashes (-), dots (.), or lower-case alphanumerics in between, and
     /// follow domain name notation format
-    /// (https://tools.ietf.org/html/rfc1035#section-2.3.1).
+    /// (<see href="https://tools.ietf.org/html/rfc1035#section-2.3.1"/>).
     /// The key prefix SHOULD include the plugin&apos;s host company name and/or
     /// the plugin name, to minimize the possibility of collisions with keys
     /// from other plugins.
@@ -34,6 +37,7 @@ namespace Docker.DotNet.Models
     /// Each string MUST be 63 characters or less and begin and end with an
     /// alphanumeric character with &apos;-&apos;, &apos;_&apos;, &apos;.&apos;, or alphanumerics in
     /// between.
+    /// </para>
     /// </summary>
     public class VolumeTopology // (volume.Topology)
     {
@@ -0,0 +1,34 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="VolumesListParameters.Filters"/> of <c>GET /volumes</c>.
+    /// </summary>
+    public sealed class VolumesListFilters : FiltersBuilder<VolumesListFilters> // (VolumesListParameters.Filters)
+    {
+        /// <summary>
+        /// `dangling=&lt;boolean&gt;` When set to `true` (or `1`), returns all volumes that are not in use by a container. When set to `false` (or `0`), only volumes that are in use by one or more containers are returned.
+        /// </summary>
+        public VolumesListFilters Dangling(bool value) => AddFilter("dangling", value ? "true" : "false");
+
+        /// <summary>
+        /// `driver=&lt;volume-driver-name&gt;` Matches volumes based on their driver.
+        /// </summary>
+        public VolumesListFilters Driver(string value) => AddFilter("driver", value);
+
+        /// <summary>
+        /// `label=&lt;key&gt;` or `label=&lt;key&gt;:&lt;value&gt;` Matches volumes based on the presence of a `label` alone or a `label` and a value.
+        /// </summary>
+        public VolumesListFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public VolumesListFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `name=&lt;volume-name&gt;` Matches all or part of a volume name.
+        /// </summary>
+        public VolumesListFilters Name(string value) => AddFilter("name", value);
+    }
+}
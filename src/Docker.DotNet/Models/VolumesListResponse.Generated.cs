@@ -4,9 +4,9 @@ namespace Docker.DotNet.Models
     public class VolumesListResponse // (main.VolumesListResponse)
     {
         [JsonPropertyName("Volumes")]
-        public IList<VolumeResponse> Volumes { get; set; } = default!;
+        public IList<VolumeResponse>? Volumes { get; set; }
 
         [JsonPropertyName("Warnings")]
-        public IList<string> Warnings { get; set; } = default!;
+        public IList<string>? Warnings { get; set; }
     }
 }
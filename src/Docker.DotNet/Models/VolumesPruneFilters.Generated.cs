@@ -0,0 +1,24 @@
+#nullable enable
+namespace Docker.DotNet.Models
+{
+    /// <summary>
+    /// Builds the <see cref="VolumesPruneParameters.Filters"/> of <c>POST /volumes/prune</c>.
+    /// </summary>
+    public sealed class VolumesPruneFilters : FiltersBuilder<VolumesPruneFilters> // (VolumesPruneParameters.Filters)
+    {
+        /// <summary>
+        /// `label` (`label=&lt;key&gt;`, `label=&lt;key&gt;=&lt;value&gt;`, `label!=&lt;key&gt;`, or `label!=&lt;key&gt;=&lt;value&gt;`) Prune volumes with (or without, in case `label!=...` is used) the specified labels.
+        /// </summary>
+        public VolumesPruneFilters Label(string value) => AddFilter("label", value);
+
+        /// <summary>
+        /// Matches the label key with the value, the same as label=key=value.
+        /// </summary>
+        public VolumesPruneFilters Label(string key, string value) => AddFilter("label", key + "=" + value);
+
+        /// <summary>
+        /// `all` (`all=true`) - Consider all (local) volumes for pruning and not just anonymous volumes.
+        /// </summary>
+        public VolumesPruneFilters All(bool value) => AddFilter("all", value ? "true" : "false");
+    }
+}
@@ -8,7 +8,7 @@ namespace Docker.DotNet.Models
     public class VolumesPruneResponse // (volume.PruneReport)
     {
         [JsonPropertyName("VolumesDeleted")]
-        public IList<string> VolumesDeleted { get; set; } = default!;
+        public IList<string>? VolumesDeleted { get; set; }
 
         [JsonPropertyName("SpaceReclaimed")]
         public ulong SpaceReclaimed { get; set; } = default!;
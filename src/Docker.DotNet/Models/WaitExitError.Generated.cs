@@ -2,9 +2,12 @@
 namespace Docker.DotNet.Models
 {
     /// <summary>
+    /// <para>
     /// WaitExitError container waiting error, if any
-    /// 
+    /// </para>
+    /// <para>
     /// swagger:model WaitExitError
+    /// </para>
     /// </summary>
     public class WaitExitError // (container.WaitExitError)
     {
@@ -12,6 +15,6 @@ namespace Docker.DotNet.Models
         /// Details of an error
         /// </summary>
         [JsonPropertyName("Message")]
-        public string? Message { get; set; }
+        public string Message { get; set; } = string.Empty;
     }
 }
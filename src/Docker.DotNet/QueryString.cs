@@ -43,8 +43,11 @@ T> : IQueryString where T : class
                 throw new ArgumentException("Got null/unset value for a required query parameter.", propertyFullName);
             }
 
-            // Serialization
-            if (attribute.IsRequired || !IsDefaultOfType(value))
+            // Serialization, a nullable property is sent whenever it is set. A boxed bool? of false is the
+            // default of bool, but the daemon's defaults are not the C# ones: pause=0 has to be sent not to
+            // pause the container during a commit. Non-nullable properties are only sent if not the default.
+            var isSet = Nullable.GetUnderlyingType(property.PropertyType) != null ? value != null : !IsDefaultOfType(value);
+            if (attribute.IsRequired || isSet)
             {
                 var queryParameterName = attribute.Name;
 
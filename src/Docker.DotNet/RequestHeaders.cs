@@ -0,0 +1,75 @@
+namespace Docker.DotNet;
+
+internal class RequestHeaders<
+#if NET
+    [DynamicallyAccessedMembers(DynamicallyAccessedMemberTypes.PublicProperties)]
+#endif
+T> where T : class
+{
+    private T Object { get; }
+
+    private Dictionary<PropertyInfo, HeaderParameterAttribute> AttributedPublicProperties { get; }
+
+    public RequestHeaders(T value)
+    {
+        if (value is null)
+        {
+            throw new ArgumentNullException(nameof(value));
+        }
+
+        Object = value;
+        AttributedPublicProperties = FindAttributedPublicProperties();
+    }
+
+    /// <summary>
+    /// Returns the request headers for all set header parameters.
+    /// </summary>
+    /// <returns></returns>
+    public Dictionary<string, string> GetHeaders()
+    {
+        var headers = new Dictionary<string, string>();
+
+        foreach (var attributedProperty in AttributedPublicProperties)
+        {
+            var property = attributedProperty.Key;
+            var attribute = attributedProperty.Value;
+
+            var value = property.GetValue(Object, null);
+
+            // 'Required' check
+            if (value == null)
+            {
+                if (attribute.IsRequired)
+                {
+                    var propertyFullName = $"{property.DeclaringType?.FullName}.{property.Name}";
+                    throw new ArgumentException("Got null/unset value for a required header parameter.", propertyFullName);
+                }
+
+                continue;
+            }
+
+            headers[attribute.Name] = attribute.Convert(value);
+        }
+
+        return headers;
+    }
+
+    private static Dictionary<PropertyInfo, HeaderParameterAttribute> FindAttributedPublicProperties()
+    {
+        var attributedPublicProperties = new Dictionary<PropertyInfo, HeaderParameterAttribute>();
+
+        foreach (var prop in typeof(T).GetProperties())
+        {
+            if (prop.GetGetMethod(false)?.IsPublic == true)
+            {
+                var attribute = prop.GetCustomAttribute<HeaderParameterAttribute>();
+                if (attribute != null)
+                {
+                    attributedPublicProperties.Add(prop, attribute);
+                }
+            }
+        }
+
+        return attributedPublicProperties;
+    }
+}
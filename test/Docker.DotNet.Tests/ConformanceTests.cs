@@ -0,0 +1,186 @@
+namespace Docker.DotNet.Tests;
+
+/// <summary>
+/// Asserts that the parameters send the requests moby's Go client sends for the same options, see <see cref="ConformanceVector" />.
+/// </summary>
+public sealed class ConformanceTests
+{
+    [Fact]
+    public void ContainersListParameters_MatchesGoClient()
+    {
+        var p = new ContainersListParameters
+        {
+            All = true,
+            Size = true,
+            Limit = 5,
+            Filters = new ContainersListFilters()
+                .Label("com.example.vendor", "ACME")
+                .Status(ContainerState.Running)
+        };
+
+        ConformanceVector.Load(nameof(ContainersListParameters)).AssertQuery(new QueryString<ContainersListParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public void ContainerRemoveParameters_MatchesGoClient()
+    {
+        var p = new ContainerRemoveParameters { RemoveVolumes = true, RemoveLinks = true, Force = true };
+
+        ConformanceVector.Load(nameof(ContainerRemoveParameters)).AssertQuery(new QueryString<ContainerRemoveParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public void ContainerStopParameters_MatchesGoClient()
+    {
+        var p = new ContainerStopParameters { Signal = "SIGINT", WaitBeforeKillSeconds = 5 };
+
+        ConformanceVector.Load(nameof(ContainerStopParameters)).AssertQuery(new QueryString<ContainerStopParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public void ContainerKillParameters_MatchesGoClient()
+    {
+        var p = new ContainerKillParameters { Signal = "SIGHUP" };
+
+        ConformanceVector.Load(nameof(ContainerKillParameters)).AssertQuery(new QueryString<ContainerKillParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public void ContainerLogsParameters_MatchesGoClient()
+    {
+        var p = new ContainerLogsParameters
+        {
+            ShowStdout = true,
+            ShowStderr = true,
+            Since = "1700000000",
+            Until = "1700003600",
+            Timestamps = true,
+            Follow = true,
+            Tail = "10"
+        };
+
+        ConformanceVector.Load(nameof(ContainerLogsParameters)).AssertQuery(new QueryString<ContainerLogsParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public void CopyToContainerParameters_MatchesGoClient()
+    {
+        // The Go client sends noOverwriteDirNonDir unless AllowOverwriteDirWithFile is set,
+        // Docker.DotNet sends the property as it is.
+        var p = new CopyToContainerParameters { Path = "/var/lib/app", AllowOverwriteDirWithFile = true, CopyUIDGID = true };
+
+        ConformanceVector.Load(nameof(CopyToContainerParameters)).AssertQuery(new QueryString<CopyToContainerParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public async Task CommitContainerChangesParameters_MatchesGoClient()
+    {
+        var p = new CommitContainerChangesParameters
+        {
+            ContainerID = "app",
+            RepositoryName = "docker.io/example/app",
+            Tag = "v1",
+            Comment = "release",
+            Author = "Docker.DotNet",
+            Changes = ["ENV DEBUG=1"],
+            Pause = false,
+            Image = "alpine:3.20",
+            Labels = new Dictionary<string, string> { ["com.example.vendor"] = "ACME" }
+        };
+
+        var vector = ConformanceVector.Load(nameof(CommitContainerChangesParameters));
+        vector.AssertQuery(new QueryString<CommitContainerChangesParameters>(p).GetQueryString());
+
+        // The query string parameters are serialized into the body too, the daemon ignores them.
+        using var content = new JsonRequestContent<CommitContainerChangesParameters>(p, JsonSerializer.Instance).GetContent();
+        vector.AssertBody(await content.ReadAsStringAsync(TestContext.Current.CancellationToken),
+            nameof(p.ContainerID), nameof(p.RepositoryName), nameof(p.Tag), nameof(p.Comment), nameof(p.Author), nameof(p.Changes), nameof(p.Pause));
+    }
+
+    [Fact]
+    public async Task CreateContainerParameters_MatchesGoClient()
+    {
+        var p = new CreateContainerParameters
+        {
+            Name = "app",
+            Image = "alpine:3.20",
+            Cmd = ["sleep", "infinity"],
+            Env = ["DEBUG=1"],
+            Labels = new Dictionary<string, string> { ["com.example.vendor"] = "ACME" },
+            HostConfig = new HostConfig
+            {
+                AutoRemove = true,
+                RestartPolicy = new RestartPolicy { Name = RestartPolicyKind.OnFailure, MaximumRetryCount = 3 }
+            }
+        };
+
+        var vector = ConformanceVector.Load(nameof(CreateContainerParameters));
+        vector.AssertQuery(new QueryString<CreateContainerParameters>(p).GetQueryString());
+
+        using var content = new JsonRequestContent<CreateContainerParameters>(p, JsonSerializer.Instance).GetContent();
+        vector.AssertBody(await content.ReadAsStringAsync(TestContext.Current.CancellationToken), nameof(p.Name));
+    }
+
+    [Fact]
+    public void ImageBuildParameters_MatchesGoClient()
+    {
+        // The Go client sends rm=0 unless Remove is set, the daemon removes the intermediate containers by default.
+        var p = new ImageBuildParameters
+        {
+            Tags = ["example/app:v1", "example/app:latest"],
+            NoCache = true,
+            ForceRemove = true,
+            Memory = 268435456,
+            MemorySwap = 536870912,
+            ShmSize = 67108864,
+            Dockerfile = "build/Dockerfile",
+            BuildArgs = new Dictionary<string, string> { ["DEBUG"] = "1" },
+            Labels = new Dictionary<string, string> { ["com.example.vendor"] = "ACME" },
+            Target = "release",
+            AuthConfigs = new Dictionary<string, AuthConfig> { ["registry.example.com"] = new AuthConfig { Username = "user", Password = "secret" } }
+        };
+
+        var vector = ConformanceVector.Load(nameof(ImageBuildParameters));
+        vector.AssertQuery(new QueryString<ImageBuildParameters>(p).GetQueryString());
+        vector.AssertHeader("X-Registry-Config", new RequestHeaders<ImageBuildParameters>(p).GetHeaders());
+    }
+
+    [Fact]
+    public void ImagesCreateParameters_MatchesGoClient()
+    {
+        var p = new ImagesCreateParameters
+        {
+            FromImage = "registry.example.com/example/app",
+            Tag = "v1",
+            RegistryAuth = new AuthConfig { Username = "user", Password = "secret", ServerAddress = "registry.example.com" }
+        };
+
+        var vector = ConformanceVector.Load(nameof(ImagesCreateParameters));
+        vector.AssertQuery(new QueryString<ImagesCreateParameters>(p).GetQueryString());
+        vector.AssertHeader("X-Registry-Auth", new RequestHeaders<ImagesCreateParameters>(p).GetHeaders());
+    }
+
+    [Fact]
+    public void ImagesListParameters_MatchesGoClient()
+    {
+        var p = new ImagesListParameters { All = true, SharedSize = true, Manifests = true };
+
+        ConformanceVector.Load(nameof(ImagesListParameters)).AssertQuery(new QueryString<ImagesListParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public void ImageDeleteParameters_MatchesGoClient()
+    {
+        var p = new ImageDeleteParameters { Force = true, NoPrune = true };
+
+        ConformanceVector.Load(nameof(ImageDeleteParameters)).AssertQuery(new QueryString<ImageDeleteParameters>(p).GetQueryString());
+    }
+
+    [Fact]
+    public void ImageTagParameters_MatchesGoClient()
+    {
+        var p = new ImageTagParameters { RepositoryName = "registry.example.com/example/app", Tag = "v1" };
+
+        ConformanceVector.Load(nameof(ImageTagParameters)).AssertQuery(new QueryString<ImageTagParameters>(p).GetQueryString());
+    }
+}
@@ -0,0 +1,219 @@
+namespace Docker.DotNet.Tests;
+
+/// <summary>
+/// A request moby's Go client sends for an operation, recorded by specgen conformance into Conformance/&lt;name&gt;.Generated.json.
+/// The tests assert that Docker.DotNet sends the same query string, headers and body for equivalent parameters.
+/// </summary>
+internal sealed class ConformanceVector
+{
+    private readonly JsonElement _root;
+
+    private ConformanceVector(JsonElement root)
+    {
+        _root = root;
+    }
+
+    public static ConformanceVector Load(string name)
+    {
+        var json = File.ReadAllBytes(Path.Combine(AppContext.BaseDirectory, "Conformance", name + ".Generated.json"));
+
+        using var document = JsonDocument.Parse(json);
+        return new ConformanceVector(document.RootElement.Clone());
+    }
+
+    /// <summary>
+    /// Asserts that a query string has the parameters of the vector. Booleans match as 1/true and 0/false,
+    /// JSON values such as filters match regardless of their formatting.
+    /// </summary>
+    public void AssertQuery(string queryString)
+    {
+        var expected = new SortedDictionary<string, List<string>>(StringComparer.Ordinal);
+
+        if (_root.TryGetProperty("query", out var query))
+        {
+            foreach (var parameter in query.EnumerateObject())
+            {
+                expected[parameter.Name] = parameter.Value.EnumerateArray().Select(value => value.GetString()!).ToList();
+            }
+        }
+
+        var actual = new SortedDictionary<string, List<string>>(StringComparer.Ordinal);
+
+        foreach (var pair in queryString.Split('&', StringSplitOptions.RemoveEmptyEntries))
+        {
+            var separator = pair.IndexOf('=');
+            var key = Uri.UnescapeDataString(separator < 0 ? pair : pair[..separator]);
+            var value = separator < 0 ? string.Empty : Uri.UnescapeDataString(pair[(separator + 1)..]);
+
+            if (!actual.TryGetValue(key, out var values))
+            {
+                actual[key] = values = [];
+            }
+
+            values.Add(value);
+        }
+
+        Assert.Equal(expected.Keys, actual.Keys);
+
+        foreach (var (key, expectedValues) in expected)
+        {
+            var actualValues = actual[key];
+
+            Assert.True(expectedValues.Count == actualValues.Count && expectedValues.Zip(actualValues).All(values => QueryValueEquals(values.First, values.Second)),
+                $"query parameter {key}: expected [{string.Join(", ", expectedValues)}], got [{string.Join(", ", actualValues)}]");
+        }
+    }
+
+    /// <summary>
+    /// Asserts that the headers have the base64url encoded JSON header of the vector, compared like <see cref="AssertBody" />.
+    /// </summary>
+    public void AssertHeader(string name, IDictionary<string, string> headers)
+    {
+        var expected = _root.GetProperty("headers").GetProperty(name).GetString()!;
+
+        Assert.True(headers.TryGetValue(name, out var actual), $"header {name} is missing");
+
+        using var expectedJson = DecodeHeader(expected);
+        using var actualJson = DecodeHeader(actual);
+
+        var mismatches = new List<string>();
+        Compare("$", expectedJson.RootElement, actualJson.RootElement, mismatches);
+
+        Assert.True(mismatches.Count == 0, $"header {name} does not match the Go client:{Environment.NewLine}{string.Join(Environment.NewLine, mismatches)}");
+    }
+
+    /// <summary>
+    /// Asserts that a JSON body matches the one of the vector. Go writes the zero values of the fields without
+    /// omitempty, the serializer omits null, so a zero value matches a missing property. The top-level properties
+    /// in <paramref name="ignoredProperties" /> are not compared, e.g. the query string parameters the body also has.
+    /// </summary>
+    public void AssertBody(string json, params string[] ignoredProperties)
+    {
+        using var actual = JsonDocument.Parse(json);
+
+        var mismatches = new List<string>();
+        Compare("$", _root.GetProperty("body"), actual.RootElement, mismatches);
+        mismatches.RemoveAll(mismatch => ignoredProperties.Any(property => mismatch.StartsWith($"$.{property}:", StringComparison.Ordinal)));
+
+        Assert.True(mismatches.Count == 0, $"body does not match the Go client:{Environment.NewLine}{string.Join(Environment.NewLine, mismatches)}");
+    }
+
+    private static bool QueryValueEquals(string expected, string actual)
+    {
+        if (expected == actual)
+        {
+            return true;
+        }
+
+        if (ToBool(expected) is { } expectedBool && ToBool(actual) is { } actualBool)
+        {
+            return expectedBool == actualBool;
+        }
+
+        try
+        {
+            using var expectedJson = JsonDocument.Parse(expected);
+            using var actualJson = JsonDocument.Parse(actual);
+
+            var mismatches = new List<string>();
+            Compare("$", expectedJson.RootElement, actualJson.RootElement, mismatches);
+
+            return mismatches.Count == 0;
+        }
+        catch (JsonException)
+        {
+            return false;
+        }
+    }
+
+    private static bool? ToBool(string value)
+    {
+        return value switch
+        {
+            "1" or "true" => true,
+            "0" or "false" => false,
+            _ => null
+        };
+    }
+
+    private static JsonDocument DecodeHeader(string value)
+    {
+        var base64 = value.Replace('-', '+').Replace('_', '/');
+        base64 = base64.PadRight(base64.Length + (4 - base64.Length % 4) % 4, '=');
+
+        return JsonDocument.Parse(Convert.FromBase64String(base64));
+    }
+
+    private static void Compare(string path, JsonElement expected, JsonElement actual, List<string> mismatches)
+    {
+        switch (expected.ValueKind)
+        {
+            case JsonValueKind.Object when actual.ValueKind == JsonValueKind.Object:
+                var actualProperties = actual.EnumerateObject().ToDictionary(property => property.Name, property => property.Value);
+
+                foreach (var property in expected.EnumerateObject())
+                {
+                    if (actualProperties.Remove(property.Name, out var value))
+                    {
+                        Compare($"{path}.{property.Name}", property.Value, value, mismatches);
+                    }
+                    else if (!IsZero(property.Value))
+                    {
+                        mismatches.Add($"{path}.{property.Name}: is missing");
+                    }
+                }
+
+                foreach (var property in actualProperties.Where(property => !IsZero(property.Value)))
+                {
+                    mismatches.Add($"{path}.{property.Key}: is not sent by the Go client");
+                }
+
+                return;
+            case JsonValueKind.Array when actual.ValueKind == JsonValueKind.Array:
+                if (expected.GetArrayLength() != actual.GetArrayLength())
+                {
+                    mismatches.Add($"{path}: expected {expected.GetArrayLength()} elements, got {actual.GetArrayLength()}");
+                    return;
+                }
+
+                var index = 0;
+
+                foreach (var (expectedElement, actualElement) in expected.EnumerateArray().Zip(actual.EnumerateArray()))
+                {
+                    Compare($"{path}[{index++}]", expectedElement, actualElement, mismatches);
+                }
+
+                return;
+            case JsonValueKind.Number when actual.ValueKind == JsonValueKind.Number:
+                if (expected.GetDecimal() != actual.GetDecimal())
+                {
+                    mismatches.Add($"{path}: expected {expected.GetRawText()}, got {actual.GetRawText()}");
+                }
+
+                return;
+        }
+
+        if (IsZero(expected) && IsZero(actual))
+        {
+            return;
+        }
+
+        if (expected.ValueKind != actual.ValueKind || expected.GetRawText() != actual.GetRawText())
+        {
+            mismatches.Add($"{path}: expected {expected.GetRawText()}, got {actual.GetRawText()}");
+        }
+    }
+
+    private static bool IsZero(JsonElement element)
+    {
+        return element.ValueKind switch
+        {
+            JsonValueKind.Null or JsonValueKind.False => true,
+            JsonValueKind.Number => element.GetDecimal() == 0,
+            JsonValueKind.String => element.GetString()!.Length == 0,
+            JsonValueKind.Array => element.EnumerateArray().All(IsZero),
+            JsonValueKind.Object => element.EnumerateObject().All(property => IsZero(property.Value)),
+            _ => false
+        };
+    }
+}
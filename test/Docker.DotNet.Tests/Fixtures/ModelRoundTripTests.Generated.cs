@@ -0,0 +1,1558 @@
+namespace Docker.DotNet.Tests;
+
+public sealed partial class ModelRoundTripTests
+{
+    [Fact]
+    public void RoundTrip_Actor_MatchesGoJson()
+    {
+        AssertRoundTrip<Actor>("Actor.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Annotations_MatchesGoJson()
+    {
+        AssertRoundTrip<Annotations>("Annotations.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_AppArmorOpts_MatchesGoJson()
+    {
+        AssertRoundTrip<AppArmorOpts>("AppArmorOpts.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_AttestationProperties_MatchesGoJson()
+    {
+        AssertRoundTrip<AttestationProperties>("AttestationProperties.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_AuthConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<AuthConfig>("AuthConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_AuthResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<AuthResponse>("AuthResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_BindOptions_MatchesGoJson()
+    {
+        AssertRoundTrip<BindOptions>("BindOptions.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_BlkioStatEntry_MatchesGoJson()
+    {
+        AssertRoundTrip<BlkioStatEntry>("BlkioStatEntry.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_BlkioStats_MatchesGoJson()
+    {
+        AssertRoundTrip<BlkioStats>("BlkioStats.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_BuildDiskUsage_MatchesGoJson()
+    {
+        AssertRoundTrip<BuildDiskUsage>("BuildDiskUsage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_BuildIdentity_MatchesGoJson()
+    {
+        AssertRoundTrip<BuildIdentity>("BuildIdentity.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_BuildResult_MatchesGoJson()
+    {
+        AssertRoundTrip<BuildResult>("BuildResult.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CAConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<CAConfig>("CAConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CPUStats_MatchesGoJson()
+    {
+        AssertRoundTrip<CPUStats>("CPUStats.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CPUUsage_MatchesGoJson()
+    {
+        AssertRoundTrip<CPUUsage>("CPUUsage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CacheRecord_MatchesGoJson()
+    {
+        AssertRoundTrip<CacheRecord>("CacheRecord.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CapacityRange_MatchesGoJson()
+    {
+        AssertRoundTrip<CapacityRange>("CapacityRange.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ClusterInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<ClusterInfo>("ClusterInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ClusterVolume_MatchesGoJson()
+    {
+        AssertRoundTrip<ClusterVolume>("ClusterVolume.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ClusterVolumeSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<ClusterVolumeSpec>("ClusterVolumeSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Commit_MatchesGoJson()
+    {
+        AssertRoundTrip<Commit>("Commit.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CommitContainerChangesResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<CommitContainerChangesResponse>("CommitContainerChangesResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ComponentVersion_MatchesGoJson()
+    {
+        AssertRoundTrip<ComponentVersion>("ComponentVersion.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ConfigReference_MatchesGoJson()
+    {
+        AssertRoundTrip<ConfigReference>("ConfigReference.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ConfigReferenceFileTarget_MatchesGoJson()
+    {
+        AssertRoundTrip<ConfigReferenceFileTarget>("ConfigReferenceFileTarget.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ConsoleSize_MatchesGoJson()
+    {
+        AssertRoundTrip<ConsoleSize>("ConsoleSize.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerConfig>("ContainerConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerDiskUsage_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerDiskUsage>("ContainerDiskUsage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerExecCreateParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerExecCreateParameters>("ContainerExecCreateParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerExecCreateResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerExecCreateResponse>("ContainerExecCreateResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerExecInspectResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerExecInspectResponse>("ContainerExecInspectResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerExecStartParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerExecStartParameters>("ContainerExecStartParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerFileSystemChangeResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerFileSystemChangeResponse>("ContainerFileSystemChangeResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerInspectResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerInspectResponse>("ContainerInspectResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerListResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerListResponse>("ContainerListResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerPathStatResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerPathStatResponse>("ContainerPathStatResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerProcessesResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerProcessesResponse>("ContainerProcessesResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerSpec>("ContainerSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerStatsResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerStatsResponse>("ContainerStatsResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerStatus>("ContainerStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerUpdateParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerUpdateParameters>("ContainerUpdateParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerUpdateResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerUpdateResponse>("ContainerUpdateResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerWaitResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerWaitResponse>("ContainerWaitResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerdInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerdInfo>("ContainerdInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainerdNamespaces_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainerdNamespaces>("ContainerdNamespaces.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContainersPruneResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ContainersPruneResponse>("ContainersPruneResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ContentMissingNote_MatchesGoJson()
+    {
+        AssertRoundTrip<ContentMissingNote>("ContentMissingNote.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CreateContainerResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<CreateContainerResponse>("CreateContainerResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_CredentialSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<CredentialSpec>("CredentialSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DNSConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<DNSConfig>("DNSConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Descriptor_MatchesGoJson()
+    {
+        AssertRoundTrip<Descriptor>("Descriptor.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DeviceInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<DeviceInfo>("DeviceInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DeviceMapping_MatchesGoJson()
+    {
+        AssertRoundTrip<DeviceMapping>("DeviceMapping.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DeviceRequest_MatchesGoJson()
+    {
+        AssertRoundTrip<DeviceRequest>("DeviceRequest.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DiscreteGenericResource_MatchesGoJson()
+    {
+        AssertRoundTrip<DiscreteGenericResource>("DiscreteGenericResource.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DispatcherConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<DispatcherConfig>("DispatcherConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DistributionInspectResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<DistributionInspectResponse>("DistributionInspectResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DockerOCIImageConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<DockerOCIImageConfig>("DockerOCIImageConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DockerOCIImageConfigExt_MatchesGoJson()
+    {
+        AssertRoundTrip<DockerOCIImageConfigExt>("DockerOCIImageConfigExt.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Driver_MatchesGoJson()
+    {
+        AssertRoundTrip<Driver>("Driver.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_DriverData_MatchesGoJson()
+    {
+        AssertRoundTrip<DriverData>("DriverData.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_EncryptionConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<EncryptionConfig>("EncryptionConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Endpoint_MatchesGoJson()
+    {
+        AssertRoundTrip<Endpoint>("Endpoint.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_EndpointIPAMConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<EndpointIPAMConfig>("EndpointIPAMConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_EndpointResource_MatchesGoJson()
+    {
+        AssertRoundTrip<EndpointResource>("EndpointResource.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_EndpointSettings_MatchesGoJson()
+    {
+        AssertRoundTrip<EndpointSettings>("EndpointSettings.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_EndpointSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<EndpointSpec>("EndpointSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_EndpointVirtualIP_MatchesGoJson()
+    {
+        AssertRoundTrip<EndpointVirtualIP>("EndpointVirtualIP.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_EngineDescription_MatchesGoJson()
+    {
+        AssertRoundTrip<EngineDescription>("EngineDescription.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ExecProcessConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<ExecProcessConfig>("ExecProcessConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ExternalCA_MatchesGoJson()
+    {
+        AssertRoundTrip<ExternalCA>("ExternalCA.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_FirewallInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<FirewallInfo>("FirewallInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_GenericResource_MatchesGoJson()
+    {
+        AssertRoundTrip<GenericResource>("GenericResource.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Health_MatchesGoJson()
+    {
+        AssertRoundTrip<Health>("Health.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_HealthSummary_MatchesGoJson()
+    {
+        AssertRoundTrip<HealthSummary>("HealthSummary.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_HealthcheckConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<HealthcheckConfig>("HealthcheckConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_HealthcheckResult_MatchesGoJson()
+    {
+        AssertRoundTrip<HealthcheckResult>("HealthcheckResult.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_HostConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<HostConfig>("HostConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_IPAM_MatchesGoJson()
+    {
+        AssertRoundTrip<IPAM>("IPAM.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_IPAMConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<IPAMConfig>("IPAMConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_IPAMOptions_MatchesGoJson()
+    {
+        AssertRoundTrip<IPAMOptions>("IPAMOptions.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_IPAMStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<IPAMStatus>("IPAMStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Identity_MatchesGoJson()
+    {
+        AssertRoundTrip<Identity>("Identity.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageConfig>("ImageConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageDeleteResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageDeleteResponse>("ImageDeleteResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageDiskUsage_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageDiskUsage>("ImageDiskUsage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageHistoryResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageHistoryResponse>("ImageHistoryResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageInspectResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageInspectResponse>("ImageInspectResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageOptions_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageOptions>("ImageOptions.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageProperties_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageProperties>("ImageProperties.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImagePropertiesSize_MatchesGoJson()
+    {
+        AssertRoundTrip<ImagePropertiesSize>("ImagePropertiesSize.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImageSearchResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ImageSearchResponse>("ImageSearchResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImagesListResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ImagesListResponse>("ImagesListResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ImagesPruneResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ImagesPruneResponse>("ImagesPruneResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_IndexInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<IndexInfo>("IndexInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Info_MatchesGoJson()
+    {
+        AssertRoundTrip<Info>("Info.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_JSONError_MatchesGoJson()
+    {
+        AssertRoundTrip<JSONError>("JSONError.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_JSONMessage_MatchesGoJson()
+    {
+        AssertRoundTrip<JSONMessage>("JSONMessage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_JSONProgress_MatchesGoJson()
+    {
+        AssertRoundTrip<JSONProgress>("JSONProgress.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_JobStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<JobStatus>("JobStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_JoinTokens_MatchesGoJson()
+    {
+        AssertRoundTrip<JoinTokens>("JoinTokens.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_LogConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<LogConfig>("LogConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ManagerStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<ManagerStatus>("ManagerStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ManifestPushedInsteadOfIndexNote_MatchesGoJson()
+    {
+        AssertRoundTrip<ManifestPushedInsteadOfIndexNote>("ManifestPushedInsteadOfIndexNote.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ManifestSummary_MatchesGoJson()
+    {
+        AssertRoundTrip<ManifestSummary>("ManifestSummary.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ManifestSummarySize_MatchesGoJson()
+    {
+        AssertRoundTrip<ManifestSummarySize>("ManifestSummarySize.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_MemoryStats_MatchesGoJson()
+    {
+        AssertRoundTrip<MemoryStats>("MemoryStats.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Message_MatchesGoJson()
+    {
+        AssertRoundTrip<Message>("Message.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Meta_MatchesGoJson()
+    {
+        AssertRoundTrip<Meta>("Meta.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Metadata_MatchesGoJson()
+    {
+        AssertRoundTrip<Metadata>("Metadata.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Mount_MatchesGoJson()
+    {
+        AssertRoundTrip<Mount>("Mount.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_MountPoint_MatchesGoJson()
+    {
+        AssertRoundTrip<MountPoint>("MountPoint.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NRIInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<NRIInfo>("NRIInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NamedGenericResource_MatchesGoJson()
+    {
+        AssertRoundTrip<NamedGenericResource>("NamedGenericResource.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Network_MatchesGoJson()
+    {
+        AssertRoundTrip<Network>("Network.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkAddressPool_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkAddressPool>("NetworkAddressPool.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkAttachment_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkAttachment>("NetworkAttachment.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkAttachmentConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkAttachmentConfig>("NetworkAttachmentConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkAttachmentSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkAttachmentSpec>("NetworkAttachmentSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkConnectParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkConnectParameters>("NetworkConnectParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkDisconnectParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkDisconnectParameters>("NetworkDisconnectParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkResponse>("NetworkResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkSettings_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkSettings>("NetworkSettings.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkSettingsSummary_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkSettingsSummary>("NetworkSettingsSummary.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkSpec>("NetworkSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkStats_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkStats>("NetworkStats.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkTask_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkTask>("NetworkTask.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworkingConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworkingConfig>("NetworkingConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworksCreateParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworksCreateParameters>("NetworksCreateParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworksCreateResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworksCreateResponse>("NetworksCreateResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NetworksPruneResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<NetworksPruneResponse>("NetworksPruneResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NodeCSIInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<NodeCSIInfo>("NodeCSIInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NodeDescription_MatchesGoJson()
+    {
+        AssertRoundTrip<NodeDescription>("NodeDescription.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NodeListResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<NodeListResponse>("NodeListResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NodeStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<NodeStatus>("NodeStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_NodeUpdateParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<NodeUpdateParameters>("NodeUpdateParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_OrchestrationConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<OrchestrationConfig>("OrchestrationConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Peer_MatchesGoJson()
+    {
+        AssertRoundTrip<Peer>("Peer.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PeerInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<PeerInfo>("PeerInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PidsStats_MatchesGoJson()
+    {
+        AssertRoundTrip<PidsStats>("PidsStats.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Placement_MatchesGoJson()
+    {
+        AssertRoundTrip<Placement>("Placement.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PlacementPreference_MatchesGoJson()
+    {
+        AssertRoundTrip<PlacementPreference>("PlacementPreference.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Platform_MatchesGoJson()
+    {
+        AssertRoundTrip<Platform>("Platform.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PlatformInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<PlatformInfo>("PlatformInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Plugin_MatchesGoJson()
+    {
+        AssertRoundTrip<Plugin>("Plugin.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginArgs_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginArgs>("PluginArgs.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginCapabilityID_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginCapabilityID>("PluginCapabilityID.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginConfig>("PluginConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginConfigureParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginConfigureParameters>("PluginConfigureParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginDescription_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginDescription>("PluginDescription.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginDevice_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginDevice>("PluginDevice.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginEnv_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginEnv>("PluginEnv.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginInterface_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginInterface>("PluginInterface.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginLinuxConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginLinuxConfig>("PluginLinuxConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginMount_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginMount>("PluginMount.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginNetworkConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginNetworkConfig>("PluginNetworkConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginPrivilege_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginPrivilege>("PluginPrivilege.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginRootFS_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginRootFS>("PluginRootFS.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginSettings_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginSettings>("PluginSettings.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginUser_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginUser>("PluginUser.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PluginsInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<PluginsInfo>("PluginsInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PortBinding_MatchesGoJson()
+    {
+        AssertRoundTrip<PortBinding>("PortBinding.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PortConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<PortConfig>("PortConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PortStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<PortStatus>("PortStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PortSummary_MatchesGoJson()
+    {
+        AssertRoundTrip<PortSummary>("PortSummary.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Privileges_MatchesGoJson()
+    {
+        AssertRoundTrip<Privileges>("Privileges.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PublishStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<PublishStatus>("PublishStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PullIdentity_MatchesGoJson()
+    {
+        AssertRoundTrip<PullIdentity>("PullIdentity.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_PushResult_MatchesGoJson()
+    {
+        AssertRoundTrip<PushResult>("PushResult.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_RaftConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<RaftConfig>("RaftConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ReplicatedJob_MatchesGoJson()
+    {
+        AssertRoundTrip<ReplicatedJob>("ReplicatedJob.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ReplicatedService_MatchesGoJson()
+    {
+        AssertRoundTrip<ReplicatedService>("ReplicatedService.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ResourceRequirements_MatchesGoJson()
+    {
+        AssertRoundTrip<ResourceRequirements>("ResourceRequirements.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Resources_MatchesGoJson()
+    {
+        AssertRoundTrip<Resources>("Resources.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_RestartPolicy_MatchesGoJson()
+    {
+        AssertRoundTrip<RestartPolicy>("RestartPolicy.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_RootFS_MatchesGoJson()
+    {
+        AssertRoundTrip<RootFS>("RootFS.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_RootFSStorage_MatchesGoJson()
+    {
+        AssertRoundTrip<RootFSStorage>("RootFSStorage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_RootFSStorageSnapshot_MatchesGoJson()
+    {
+        AssertRoundTrip<RootFSStorageSnapshot>("RootFSStorageSnapshot.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Runtime_MatchesGoJson()
+    {
+        AssertRoundTrip<Runtime>("Runtime.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_RuntimePrivilege_MatchesGoJson()
+    {
+        AssertRoundTrip<RuntimePrivilege>("RuntimePrivilege.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_RuntimeWithStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<RuntimeWithStatus>("RuntimeWithStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SELinuxContext_MatchesGoJson()
+    {
+        AssertRoundTrip<SELinuxContext>("SELinuxContext.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SeccompOpts_MatchesGoJson()
+    {
+        AssertRoundTrip<SeccompOpts>("SeccompOpts.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Secret_MatchesGoJson()
+    {
+        AssertRoundTrip<Secret>("Secret.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SecretCreateResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<SecretCreateResponse>("SecretCreateResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SecretReference_MatchesGoJson()
+    {
+        AssertRoundTrip<SecretReference>("SecretReference.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SecretReferenceFileTarget_MatchesGoJson()
+    {
+        AssertRoundTrip<SecretReferenceFileTarget>("SecretReferenceFileTarget.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ServiceConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<ServiceConfig>("ServiceConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ServiceCreateResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ServiceCreateResponse>("ServiceCreateResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ServiceInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<ServiceInfo>("ServiceInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ServiceMode_MatchesGoJson()
+    {
+        AssertRoundTrip<ServiceMode>("ServiceMode.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ServiceSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<ServiceSpec>("ServiceSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ServiceStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<ServiceStatus>("ServiceStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ServiceUpdateResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<ServiceUpdateResponse>("ServiceUpdateResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SignatureIdentity_MatchesGoJson()
+    {
+        AssertRoundTrip<SignatureIdentity>("SignatureIdentity.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SignatureTimestamp_MatchesGoJson()
+    {
+        AssertRoundTrip<SignatureTimestamp>("SignatureTimestamp.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SignerIdentity_MatchesGoJson()
+    {
+        AssertRoundTrip<SignerIdentity>("SignerIdentity.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Spec_MatchesGoJson()
+    {
+        AssertRoundTrip<Spec>("Spec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SpreadOver_MatchesGoJson()
+    {
+        AssertRoundTrip<SpreadOver>("SpreadOver.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_State_MatchesGoJson()
+    {
+        AssertRoundTrip<State>("State.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Status_MatchesGoJson()
+    {
+        AssertRoundTrip<Status>("Status.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Storage_MatchesGoJson()
+    {
+        AssertRoundTrip<Storage>("Storage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_StorageStats_MatchesGoJson()
+    {
+        AssertRoundTrip<StorageStats>("StorageStats.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SubnetStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<SubnetStatus>("SubnetStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SummaryHostConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<SummaryHostConfig>("SummaryHostConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmConfig>("SwarmConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmConfigReference_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmConfigReference>("SwarmConfigReference.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmConfigSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmConfigSpec>("SwarmConfigSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmCreateConfigParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmCreateConfigParameters>("SwarmCreateConfigParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmCreateConfigResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmCreateConfigResponse>("SwarmCreateConfigResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmDriver_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmDriver>("SwarmDriver.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmIPAMConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmIPAMConfig>("SwarmIPAMConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmInitParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmInitParameters>("SwarmInitParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmInspectResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmInspectResponse>("SwarmInspectResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmJoinParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmJoinParameters>("SwarmJoinParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmLimit_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmLimit>("SwarmLimit.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmNetwork_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmNetwork>("SwarmNetwork.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmPlatform_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmPlatform>("SwarmPlatform.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmResources_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmResources>("SwarmResources.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmRestartPolicy_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmRestartPolicy>("SwarmRestartPolicy.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmRuntimeSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmRuntimeSpec>("SwarmRuntimeSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmSecretSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmSecretSpec>("SwarmSecretSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmService_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmService>("SwarmService.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmUnlockParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmUnlockParameters>("SwarmUnlockParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmUnlockResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmUnlockResponse>("SwarmUnlockResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SwarmUpdateConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<SwarmUpdateConfig>("SwarmUpdateConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SystemDataUsageInfoResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<SystemDataUsageInfoResponse>("SystemDataUsageInfoResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_SystemInfoResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<SystemInfoResponse>("SystemInfoResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TLSInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<TLSInfo>("TLSInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TaskDefaults_MatchesGoJson()
+    {
+        AssertRoundTrip<TaskDefaults>("TaskDefaults.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TaskResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<TaskResponse>("TaskResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TaskSpec_MatchesGoJson()
+    {
+        AssertRoundTrip<TaskSpec>("TaskSpec.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TaskStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<TaskStatus>("TaskStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ThrottleDevice_MatchesGoJson()
+    {
+        AssertRoundTrip<ThrottleDevice>("ThrottleDevice.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_ThrottlingData_MatchesGoJson()
+    {
+        AssertRoundTrip<ThrottlingData>("ThrottlingData.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TmpfsOptions_MatchesGoJson()
+    {
+        AssertRoundTrip<TmpfsOptions>("TmpfsOptions.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Topology_MatchesGoJson()
+    {
+        AssertRoundTrip<Topology>("Topology.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TopologyRequirement_MatchesGoJson()
+    {
+        AssertRoundTrip<TopologyRequirement>("TopologyRequirement.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_TypeMount_MatchesGoJson()
+    {
+        AssertRoundTrip<TypeMount>("TypeMount.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Ulimit_MatchesGoJson()
+    {
+        AssertRoundTrip<Ulimit>("Ulimit.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_UpdateConfig_MatchesGoJson()
+    {
+        AssertRoundTrip<UpdateConfig>("UpdateConfig.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_UpdateStatus_MatchesGoJson()
+    {
+        AssertRoundTrip<UpdateStatus>("UpdateStatus.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_UsageData_MatchesGoJson()
+    {
+        AssertRoundTrip<UsageData>("UsageData.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Version_MatchesGoJson()
+    {
+        AssertRoundTrip<Version>("Version.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VersionResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<VersionResponse>("VersionResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_Volume_MatchesGoJson()
+    {
+        AssertRoundTrip<Volume>("Volume.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeAccessMode_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeAccessMode>("VolumeAccessMode.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeAttachment_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeAttachment>("VolumeAttachment.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeDiskUsage_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeDiskUsage>("VolumeDiskUsage.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeInfo_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeInfo>("VolumeInfo.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeOptions_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeOptions>("VolumeOptions.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeResponse>("VolumeResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeSecret_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeSecret>("VolumeSecret.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumeTopology_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumeTopology>("VolumeTopology.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumesCreateParameters_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumesCreateParameters>("VolumesCreateParameters.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumesListResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumesListResponse>("VolumesListResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_VolumesPruneResponse_MatchesGoJson()
+    {
+        AssertRoundTrip<VolumesPruneResponse>("VolumesPruneResponse.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_WaitExitError_MatchesGoJson()
+    {
+        AssertRoundTrip<WaitExitError>("WaitExitError.Generated.json");
+    }
+
+    [Fact]
+    public void RoundTrip_WeightDevice_MatchesGoJson()
+    {
+        AssertRoundTrip<WeightDevice>("WeightDevice.Generated.json");
+    }
+}
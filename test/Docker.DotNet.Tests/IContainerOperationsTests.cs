@@ -511,7 +511,7 @@ public class IContainerOperationsTests
 
         Assert.True(inspectRunningContainerResponse.State.Running);
         Assert.False(inspectKilledContainerResponse.State.Running);
-        Assert.Equal("exited", inspectKilledContainerResponse.State.Status);
+        Assert.Equal(ContainerState.Exited, inspectKilledContainerResponse.State.Status);
 
         _testOutputHelper.WriteLine("Killed");
         _testOutputHelper.WriteLine(JsonSerializer.Instance.Serialize(inspectKilledContainerResponse));
@@ -0,0 +1,64 @@
+namespace Docker.DotNet.Tests;
+
+public sealed class JSONMessageAuxTests
+{
+    [Fact]
+    public void Deserialize_JSONMessage_WithBuildResultAux_ReturnsBuildResult()
+    {
+        var message = JsonSerializer.Instance.Deserialize<JSONMessage>(Encoding.UTF8.GetBytes("{\"aux\":{\"ID\":\"sha256:abc\"}}"));
+
+        var result = Assert.IsType<BuildResult>(message.Aux);
+        Assert.Equal("sha256:abc", result.ID);
+    }
+
+    [Fact]
+    public void Deserialize_JSONMessage_WithPushResultAux_ReturnsPushResult()
+    {
+        var message = JsonSerializer.Instance.Deserialize<JSONMessage>(Encoding.UTF8.GetBytes("{\"aux\":{\"Tag\":\"latest\",\"Digest\":\"sha256:abc\",\"Size\":528}}"));
+
+        var result = Assert.IsType<PushResult>(message.Aux);
+        Assert.Equal("latest", result.Tag);
+        Assert.Equal("sha256:abc", result.Digest);
+        Assert.Equal(528, result.Size);
+    }
+
+    [Fact]
+    public void Deserialize_JSONMessage_WithContentMissingAux_ReturnsContentMissingNote()
+    {
+        var message = JsonSerializer.Instance.Deserialize<JSONMessage>(Encoding.UTF8.GetBytes("{\"aux\":{\"contentMissing\":true,\"desc\":{\"mediaType\":\"application/vnd.oci.image.index.v1+json\",\"digest\":\"sha256:abc\",\"size\":1}}}"));
+
+        var result = Assert.IsType<ContentMissingNote>(message.Aux);
+        Assert.True(result.ContentMissing);
+    }
+
+    [Theory]
+    [InlineData("{\"aux\":{\"Unknown\":1}}", "{\"Unknown\":1}")]
+    [InlineData("{\"aux\":\"text\"}", "\"text\"")]
+    public void Deserialize_JSONMessage_WithUnknownAux_ReturnsOther(string jsonString, string auxJson)
+    {
+        var message = JsonSerializer.Instance.Deserialize<JSONMessage>(Encoding.UTF8.GetBytes(jsonString));
+
+        var other = Assert.IsType<JSONMessageAuxOther>(message.Aux);
+        Assert.Equal(auxJson, other.Value.GetRawText());
+    }
+
+    [Fact]
+    public void Serialize_JSONMessage_WithAux_WritesMemberProperties()
+    {
+        var message = new JSONMessage { Aux = new BuildResult { ID = "sha256:abc" } };
+
+        var jsonString = JsonSerializer.Instance.Serialize(message);
+
+        Assert.Contains("\"aux\":{\"ID\":\"sha256:abc\"}", jsonString);
+    }
+
+    [Fact]
+    public void Serialize_JSONMessage_WithOtherAux_WritesRawValue()
+    {
+        var message = JsonSerializer.Instance.Deserialize<JSONMessage>(Encoding.UTF8.GetBytes("{\"aux\":{\"Unknown\":[1,2]}}"));
+
+        var jsonString = JsonSerializer.Instance.Serialize(message);
+
+        Assert.Contains("\"aux\":{\"Unknown\":[1,2]}", jsonString);
+    }
+}
@@ -26,6 +26,16 @@ public sealed class JsonEnumMemberConverterTests
         Assert.Equal(restartPolicyKind, deserializedParameters.HostConfig.RestartPolicy.Name);
     }
 
+    [Fact]
+    public void JsonDeserialization_UnknownValue_ShouldThrow()
+    {
+        // Given
+        var jsonString = "{\"HostConfig\":{\"RestartPolicy\":{\"Name\":\"on-reboot\"}}}";
+
+        // When, Then
+        Assert.Throws<JsonException>(() => JsonSerializer.Instance.Deserialize<CreateContainerParameters>(Encoding.UTF8.GetBytes(jsonString)));
+    }
+
     public sealed class RestartPolicyKindTestData : TheoryData<RestartPolicyKind>
     {
         public RestartPolicyKindTestData()
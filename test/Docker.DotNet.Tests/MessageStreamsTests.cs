@@ -0,0 +1,114 @@
+using System.Net;
+using System.Net.Http;
+
+namespace Docker.DotNet.Tests;
+
+public sealed class MessageStreamsTests
+{
+    [Fact]
+    public async Task ReadCreateImageMessagesAsync_NewlineDelimitedMessages_ReturnsEachMessage()
+    {
+        using var stream = new MemoryStream(Encoding.UTF8.GetBytes("{\"status\":\"Pulling fs layer\",\"id\":\"a\"}\r\n{\"status\":\"Download complete\",\"id\":\"a\"}\n"));
+
+        var messages = new List<JSONMessage>();
+
+        await foreach (var message in MessageStreams.ReadCreateImageMessagesAsync(stream))
+        {
+            messages.Add(message);
+        }
+
+        Assert.Collection(
+            messages,
+            message => Assert.Equal("Pulling fs layer", message.Status),
+            message => Assert.Equal("Download complete", message.Status));
+    }
+
+    [Fact]
+    public async Task ReadEventsAsync_EmptyStream_ReturnsNoMessages()
+    {
+        using var stream = new MemoryStream();
+
+        await foreach (var _ in MessageStreams.ReadEventsAsync(stream))
+        {
+            Assert.Fail("The stream has no messages.");
+        }
+    }
+
+    [Fact]
+    public void ReadEventsAsync_NullStream_ThrowsArgumentNullException()
+    {
+        Assert.Throws<ArgumentNullException>(() => MessageStreams.ReadEventsAsync(null!));
+    }
+
+    [Fact]
+    public async Task MonitorEventsAsync_NewlineDelimitedMessages_ReportsEachMessage()
+    {
+        var handler = new FakeDaemonHandler("{\"Type\":\"container\",\"Action\":\"start\"}\n{\"Type\":\"container\",\"Action\":\"die\"}\n");
+
+        using var client = FakeDaemonHandlerFactory.CreateClient(handler);
+
+        var actions = new List<string>();
+
+        await client.System.MonitorEventsAsync(new ContainerEventsParameters(), new SynchronousProgress<Message>(message => actions.Add(message.Action)), TestContext.Current.CancellationToken);
+
+        Assert.EndsWith("/events", handler.LastRequestUri!.AbsolutePath);
+        Assert.Equal(["start", "die"], actions);
+    }
+
+    [Fact]
+    public async Task CreateImageAsync_NewlineDelimitedMessages_ReportsEachMessage()
+    {
+        var handler = new FakeDaemonHandler("{\"status\":\"Pulling fs layer\",\"id\":\"a\"}\r\n{\"status\":\"Download complete\",\"id\":\"a\"}\n");
+
+        using var client = FakeDaemonHandlerFactory.CreateClient(handler);
+
+        var statuses = new List<string>();
+
+        await client.Images.CreateImageAsync(new ImagesCreateParameters { FromImage = "alpine", Tag = "3.20" }, null, new SynchronousProgress<JSONMessage>(message => statuses.Add(message.Status)), TestContext.Current.CancellationToken);
+
+        Assert.EndsWith("/images/create", handler.LastRequestUri!.AbsolutePath);
+        Assert.Equal(["Pulling fs layer", "Download complete"], statuses);
+    }
+
+    /// <summary>
+    /// Reports each value on the calling thread, unlike <see cref="Progress{T}"/>, so the values are complete when the operation is.
+    /// </summary>
+    private sealed class SynchronousProgress<T>(Action<T> report) : IProgress<T>
+    {
+        public void Report(T value)
+            => report(value);
+    }
+
+    /// <summary>
+    /// Answers every request with the same newline-delimited JSON body.
+    /// </summary>
+    private sealed class FakeDaemonHandler(string body) : HttpMessageHandler
+    {
+        public Uri? LastRequestUri { get; private set; }
+
+        protected override Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+        {
+            LastRequestUri = request.RequestUri;
+
+            return Task.FromResult(new HttpResponseMessage(HttpStatusCode.OK) { Content = new StringContent(body, Encoding.UTF8, "application/json") });
+        }
+    }
+
+    private sealed class FakeDaemonHandlerFactory : IDockerHandlerFactory<FakeDaemonHandler>
+    {
+        public static DockerClient CreateClient(FakeDaemonHandler handler)
+            => new DockerClientBuilder()
+                .WithEndpoint(new Uri("http://localhost:2375"))
+                .WithTransportOptions(new FakeDaemonHandlerFactory(), handler)
+                .Build();
+
+        public ResolvedTransport CreateHandler(FakeDaemonHandler transportOptions, ResolvedClientOptions clientOptions, ILogger logger)
+            => new ResolvedTransport(transportOptions, clientOptions.Endpoint);
+
+        public ResolvedTransport CreateHandler(ResolvedClientOptions clientOptions, ILogger logger)
+            => throw new NotSupportedException();
+
+        public Task<WriteClosableStream> HijackStreamAsync(HttpContent content)
+            => throw new NotSupportedException();
+    }
+}
\ No newline at end of file
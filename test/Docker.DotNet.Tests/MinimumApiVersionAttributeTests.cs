@@ -0,0 +1,32 @@
+using System.Reflection;
+
+namespace Docker.DotNet.Tests;
+
+public class MinimumApiVersionAttributeTests
+{
+    [Fact]
+    public void SwarmConfig_RequiresApiVersionOfConfigsEndpoint()
+    {
+        var attribute = typeof(SwarmConfig).GetCustomAttribute<MinimumApiVersionAttribute>();
+
+        Assert.NotNull(attribute);
+        Assert.Equal(new Version(1, 30), attribute.Version);
+    }
+
+    [Fact]
+    public void ContainerInspectResponse_Storage_RequiresApiVersion()
+    {
+        var attribute = typeof(ContainerInspectResponse).GetProperty(nameof(ContainerInspectResponse.Storage))!.GetCustomAttribute<MinimumApiVersionAttribute>();
+
+        Assert.NotNull(attribute);
+        Assert.Equal(new Version(1, 52), attribute.Version);
+    }
+
+    [Fact]
+    public void ContainerInspectResponse_Name_IsSupportedByAllApiVersions()
+    {
+        var attribute = typeof(ContainerInspectResponse).GetProperty(nameof(ContainerInspectResponse.Name))!.GetCustomAttribute<MinimumApiVersionAttribute>();
+
+        Assert.Null(attribute);
+    }
+}
@@ -0,0 +1,115 @@
+namespace Docker.DotNet.Tests;
+
+/// <summary>
+/// Round-trips the JSON fixtures that specgen marshals with Go's encoding/json through the generated models.
+/// The tests are generated into Fixtures/ModelRoundTripTests.Generated.cs.
+/// </summary>
+public sealed partial class ModelRoundTripTests
+{
+    private static readonly DateTime UnixEpoch = new(1970, 1, 1, 0, 0, 0, DateTimeKind.Utc);
+
+    private static void AssertRoundTrip<T>(string fixtureName)
+    {
+        var fixture = File.ReadAllBytes(Path.Combine(AppContext.BaseDirectory, "Fixtures", fixtureName));
+
+        var model = JsonSerializer.Instance.Deserialize<T>(fixture);
+
+        var roundTripped = JsonSerializer.Instance.SerializeToUtf8Bytes(model);
+
+        using var expected = JsonDocument.Parse(fixture);
+        using var actual = JsonDocument.Parse(roundTripped);
+
+        var mismatches = new List<string>();
+        Compare("$", expected.RootElement, actual.RootElement, mismatches);
+
+        Assert.True(mismatches.Count == 0, $"{fixtureName} does not round-trip:{Environment.NewLine}{string.Join(Environment.NewLine, mismatches)}");
+    }
+
+    private static void Compare(string path, JsonElement expected, JsonElement actual, List<string> mismatches)
+    {
+        switch (expected.ValueKind)
+        {
+            case JsonValueKind.Object when actual.ValueKind == JsonValueKind.Object:
+                CompareObjects(path, expected, actual, mismatches);
+                return;
+            case JsonValueKind.Array when actual.ValueKind == JsonValueKind.Array:
+                CompareArrays(path, expected, actual, mismatches);
+                return;
+            case JsonValueKind.Number when actual.ValueKind == JsonValueKind.Number:
+                if (expected.GetDecimal() != actual.GetDecimal())
+                {
+                    mismatches.Add($"{path}: expected {expected.GetRawText()}, got {actual.GetRawText()}");
+                }
+
+                return;
+        }
+
+        if (expected.ValueKind == actual.ValueKind && expected.GetRawText() == actual.GetRawText())
+        {
+            return;
+        }
+
+        // DateTime is written in its round-trip format, which is not the one of Go's time.Time or of unix timestamps.
+        if (TryGetDateTime(expected, out var expectedDateTime) && TryGetDateTime(actual, out var actualDateTime) && expectedDateTime == actualDateTime)
+        {
+            return;
+        }
+
+        mismatches.Add($"{path}: expected {expected.GetRawText()}, got {actual.GetRawText()}");
+    }
+
+    private static void CompareObjects(string path, JsonElement expected, JsonElement actual, List<string> mismatches)
+    {
+        var actualProperties = actual.EnumerateObject().ToDictionary(property => property.Name, property => property.Value);
+
+        foreach (var property in expected.EnumerateObject())
+        {
+            if (actualProperties.Remove(property.Name, out var value))
+            {
+                Compare($"{path}.{property.Name}", property.Value, value, mismatches);
+            }
+            else if (property.Value.ValueKind != JsonValueKind.Null)
+            {
+                mismatches.Add($"{path}.{property.Name}: is missing");
+            }
+        }
+
+        // The serializer omits null values, Go writes null for nil fields without omitempty.
+        foreach (var property in actualProperties.Where(property => property.Value.ValueKind != JsonValueKind.Null))
+        {
+            mismatches.Add($"{path}.{property.Key}: is not in the Go JSON");
+        }
+    }
+
+    private static void CompareArrays(string path, JsonElement expected, JsonElement actual, List<string> mismatches)
+    {
+        if (expected.GetArrayLength() != actual.GetArrayLength())
+        {
+            mismatches.Add($"{path}: expected {expected.GetArrayLength()} elements, got {actual.GetArrayLength()}");
+            return;
+        }
+
+        var index = 0;
+
+        foreach (var (expectedElement, actualElement) in expected.EnumerateArray().Zip(actual.EnumerateArray()))
+        {
+            Compare($"{path}[{index++}]", expectedElement, actualElement, mismatches);
+        }
+    }
+
+    private static bool TryGetDateTime(JsonElement element, out DateTime value)
+    {
+        switch (element.ValueKind)
+        {
+            case JsonValueKind.String when element.TryGetDateTime(out value):
+                value = value.ToUniversalTime();
+                return true;
+            case JsonValueKind.Number when element.TryGetInt64(out var seconds):
+                value = UnixEpoch.AddSeconds(seconds);
+                return true;
+            default:
+                value = default;
+                return false;
+        }
+    }
+}
@@ -61,4 +61,71 @@ public class QueryStringTests
 
         Assert.Equal("filters={\"mode\":{}}", Uri.UnescapeDataString(qs.GetQueryString()));
     }
+
+    [Fact]
+    public void ContainersListParameters_GenerateTypedFilters()
+    {
+        var p = new ContainersListParameters
+        {
+            Filters = new ContainersListFilters()
+                .Label("com.example.vendor", "ACME")
+                .Status(ContainerState.Running)
+                .Status(ContainerState.Paused)
+                .IsTask(false)
+                .Exited(137)
+        };
+
+        var qs = new QueryString<ContainersListParameters>(p);
+
+        Assert.Equal("filters={\"label\":{\"com.example.vendor=ACME\":true},\"status\":{\"running\":true,\"paused\":true},\"is-task\":{\"false\":true},\"exited\":{\"137\":true}}", Uri.UnescapeDataString(qs.GetQueryString()));
+    }
+
+    [Fact]
+    public void ContainersListFilters_UndefinedStatus_ThrowsArgumentOutOfRangeException()
+    {
+        Assert.Throws<ArgumentOutOfRangeException>(() => new ContainersListFilters().Status(ContainerState.Undefined));
+    }
+
+    [Fact]
+    public void CommitContainerChangesParameters_NullablePauseFalse_IsSent()
+    {
+        var p = new CommitContainerChangesParameters { ContainerID = "app", Pause = false };
+        var qs = new QueryString<CommitContainerChangesParameters>(p);
+
+        Assert.Equal("container=app&pause=0", qs.GetQueryString());
+    }
+
+    [Fact]
+    public void CommitContainerChangesParameters_NullablePauseNull_IsNotSent()
+    {
+        var p = new CommitContainerChangesParameters { ContainerID = "app" };
+        var qs = new QueryString<CommitContainerChangesParameters>(p);
+
+        Assert.Equal("container=app", qs.GetQueryString());
+    }
+
+    [Fact]
+    public void NonNullableParameters_Default_IsNotSent()
+    {
+        var qs = new QueryString<NonNullableParameters>(new NonNullableParameters());
+
+        Assert.Equal(string.Empty, qs.GetQueryString());
+    }
+
+    [Fact]
+    public void NonNullableParameters_NotDefault_IsSent()
+    {
+        var qs = new QueryString<NonNullableParameters>(new NonNullableParameters { Force = true, Limit = 5 });
+
+        Assert.Equal("force=1&limit=5", qs.GetQueryString());
+    }
+
+    private sealed class NonNullableParameters
+    {
+        [QueryStringBoolParameter("force", false)]
+        public bool Force { get; set; }
+
+        [QueryStringParameter("limit", false)]
+        public long Limit { get; set; }
+    }
 }
\ No newline at end of file
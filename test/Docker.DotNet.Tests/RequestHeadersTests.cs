@@ -0,0 +1,44 @@
+namespace Docker.DotNet.Tests;
+
+public class RequestHeadersTests
+{
+    [Fact]
+    public void ServiceCreateParameters_WithoutRegistryAuth_GenerateNoHeaders()
+    {
+        var p = new ServiceCreateParameters { Service = new ServiceSpec() };
+
+        var headers = new RequestHeaders<ServiceCreateParameters>(p).GetHeaders();
+
+        Assert.Empty(headers);
+    }
+
+    [Fact]
+    public void ServiceCreateParameters_GenerateBase64UrlRegistryAuth()
+    {
+        var p = new ServiceCreateParameters
+        {
+            Service = new ServiceSpec(),
+            RegistryAuth = new AuthConfig { Username = "user", Password = "?>?>" }
+        };
+
+        var headers = new RequestHeaders<ServiceCreateParameters>(p).GetHeaders();
+
+        Assert.True(headers.TryGetValue("X-Registry-Auth", out var value));
+        Assert.DoesNotContain('+', value);
+        Assert.DoesNotContain('/', value);
+
+        var authConfig = JsonSerializer.Instance.Deserialize<AuthConfig>(Convert.FromBase64String(value.Replace("_", "/").Replace("-", "+")));
+        Assert.Equal("user", authConfig.Username);
+        Assert.Equal("?>?>", authConfig.Password);
+    }
+
+    [Fact]
+    public void ServiceCreateParameters_RegistryAuth_IsNotSerializedToJson()
+    {
+        var p = new ServiceCreateParameters { RegistryAuth = new AuthConfig { Username = "user" } };
+
+        var json = JsonSerializer.Instance.Serialize(p);
+
+        Assert.DoesNotContain("RegistryAuth", json);
+    }
+}
@@ -27,7 +27,7 @@ public sealed class JsonSerializerAnalyzer : DiagnosticAnalyzer
         // Handle new JsonRequestContent<T>.
         context.RegisterSyntaxNodeAction(AnalyzeObjectCreation, SyntaxKind.ObjectCreationExpression);
 
-        // Handle [QueryStringMapParameter(typeof(T), ...)].
+        // Handle [QueryStringMapParameter(typeof(T), ...)] and [HeaderBase64JsonParameter(typeof(T), ...)].
         context.RegisterSyntaxNodeAction(AnalyzeAttribute, SyntaxKind.Attribute);
     }
 
@@ -86,7 +86,7 @@ public sealed class JsonSerializerAnalyzer : DiagnosticAnalyzer
         var typeSymbol = constructorSymbol.ContainingType;
 
         var isStjEntryPoint =
-            typeSymbol.Name == "QueryStringMapParameterAttribute" &&
+            (typeSymbol.Name == "QueryStringMapParameterAttribute" || typeSymbol.Name == "HeaderBase64JsonParameterAttribute") &&
             typeSymbol.ContainingNamespace.ToDisplayString() == "Docker.DotNet";
 
         if (!isStjEntryPoint)
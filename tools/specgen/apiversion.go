@@ -0,0 +1,268 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// apiVersion is a Docker Engine API version such as 1.44.
+type apiVersion struct {
+	Major, Minor int
+}
+
+func (v apiVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+func (v apiVersion) less(o apiVersion) bool {
+	return v.Major < o.Major || v.Major == o.Major && v.Minor < o.Minor
+}
+
+// versionedSwagger is the swagger document of a single API version.
+type versionedSwagger struct {
+	Version apiVersion
+	Spec    *swaggerSpec
+}
+
+var versionedSwaggerFileRegexp = regexp.MustCompile(`^v(\d+)\.(\d+)\.yaml$`)
+
+// loadVersionedSwaggers reads the swagger documents of the released API
+// versions, docs/v1.25.yaml to docs/v1.<latest>.yaml in the moby api module,
+// ordered from the oldest to the latest version.
+func loadVersionedSwaggers(dir string) ([]versionedSwagger, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []versionedSwagger
+	for _, e := range entries {
+		m := versionedSwaggerFileRegexp.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+
+		major, _ := strconv.Atoi(m[1])
+		minor, _ := strconv.Atoi(m[2])
+
+		spec, err := loadSwagger(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		specs = append(specs, versionedSwagger{apiVersion{major, minor}, spec})
+	}
+
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no versioned swagger documents in %s", dir)
+	}
+
+	slices.SortFunc(specs, func(a, b versionedSwagger) int {
+		if a.Version.less(b.Version) {
+			return -1
+		}
+		if b.Version.less(a.Version) {
+			return 1
+		}
+		return 0
+	})
+
+	return specs, nil
+}
+
+// defaultVersionedSwaggerDir returns the docs directory of the resolved moby api module.
+func defaultVersionedSwaggerDir() (string, error) {
+	modulePath, err := findGoModulePath("github.com/moby/moby/api")
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(modulePath, "docs"), nil
+}
+
+var pathParameterRegexp = regexp.MustCompile(`\{[^}]*\}`)
+
+// operation returns the swagger operation of a route. Path parameters are
+// matched by position, as their names differ between the route table and moby.
+func (v versionedSwagger) operation(r Route) *swaggerOperation {
+	path := pathParameterRegexp.ReplaceAllString(r.Path, "{}")
+	for p, item := range v.Spec.Paths {
+		if pathParameterRegexp.ReplaceAllString(p, "{}") == path {
+			return item.operation(r.Method)
+		}
+	}
+
+	return nil
+}
+
+// properties returns the JSON property names of a definition including those
+// of its allOf parts, or nil if the version does not declare the definition.
+func (v versionedSwagger) properties(definition string) map[string]bool {
+	s, ok := v.Spec.Definitions.Schemas[definition]
+	if !ok {
+		return nil
+	}
+
+	props := map[string]bool{}
+	var collect func(s *swaggerSchema)
+	collect = func(s *swaggerSchema) {
+		for _, p := range s.AllOf {
+			if p.Ref != "" {
+				if d, ok := v.Spec.Definitions.Schemas[swaggerDefinitionRef(p.Ref)]; ok {
+					collect(d)
+				}
+			} else {
+				collect(p)
+			}
+		}
+
+		for _, name := range s.Properties.Names {
+			props[name] = true
+		}
+	}
+
+	collect(s)
+	return props
+}
+
+// firstVersion returns the oldest version, not older than since, for which has returns true.
+func firstVersion(specs []versionedSwagger, since apiVersion, has func(v versionedSwagger) bool) (apiVersion, bool) {
+	for _, v := range specs {
+		if !v.Version.less(since) && has(v) {
+			return v.Version, true
+		}
+	}
+
+	return apiVersion{}, false
+}
+
+// annotateApiVersions sets the minimum API version of the models reachable
+// from routes and of their properties, if they are newer than the oldest
+// version in specs:
+//
+//   - A model needs the oldest version any of its routes exists in.
+//   - A JSON property needs the version its swagger definition, as returned
+//     by definitionOf, first declares it in.
+//   - A query or header parameter needs the version its route first accepts it in.
+//
+// Properties are only compared with versions the model and its definition
+// exist in, so renamed or newly extracted definitions are not reported as new.
+func annotateApiVersions(specs []versionedSwagger, routes []Route, models map[string]*CSModelType, definitionOf func(m *CSModelType) (string, bool)) {
+	oldest := specs[0].Version
+
+	routeVersions := map[string]apiVersion{}
+	for _, r := range routes {
+		if v, ok := firstVersion(specs, oldest, func(v versionedSwagger) bool { return v.operation(r) != nil }); ok {
+			routeVersions[r.String()] = v
+		}
+	}
+
+	typeRoutes := routeTypeKeys(routes)
+
+	for _, k := range sortedKeys(models) {
+		m := models[k]
+
+		// The model is available as soon as one of its routes is. Routes
+		// missing from the documents are assumed to be available in all versions.
+		since := oldest
+		if rs := typeRoutes[k]; len(rs) > 0 {
+			var modelSince apiVersion
+			known := true
+			for i, r := range rs {
+				v, ok := routeVersions[r.String()]
+				if !ok {
+					known = false
+					break
+				}
+
+				if i == 0 || v.less(modelSince) {
+					modelSince = v
+				}
+			}
+
+			if known && oldest.less(modelSince) {
+				m.MinApiVersion = modelSince.String()
+				since = modelSince
+			}
+		}
+
+		if def, ok := definitionOf(m); ok {
+			annotateDefinitionProperties(specs, since, def, m)
+		}
+
+		for _, r := range typeRoutes[k] {
+			routeSince, ok := routeVersions[r.String()]
+			if !ok || r.Parameters == nil || typeToKey(r.Parameters) != k {
+				continue
+			}
+
+			for i, p := range m.Properties {
+				in, name, ok := parameterName(p)
+				if !ok {
+					continue
+				}
+
+				added, found := firstVersion(specs, routeSince, func(v versionedSwagger) bool {
+					op := v.operation(r)
+					return op != nil && slices.ContainsFunc(op.Parameters, func(sp swaggerParameter) bool {
+						return sp.In == in && strings.EqualFold(sp.Name, name)
+					})
+				})
+
+				if found && routeSince.less(added) {
+					m.Properties[i].MinApiVersion = added.String()
+				}
+			}
+		}
+	}
+}
+
+// annotateDefinitionProperties sets the minimum API version of the JSON
+// properties of m that were added to the swagger definition def after since.
+func annotateDefinitionProperties(specs []versionedSwagger, since apiVersion, def string, m *CSModelType) {
+	defSince, ok := firstVersion(specs, since, func(v versionedSwagger) bool { return len(v.properties(def)) > 0 })
+	if !ok {
+		return
+	}
+
+	for i, p := range m.Properties {
+		name, ok := jsonPropertyName(p)
+		if !ok {
+			continue
+		}
+
+		added, found := firstVersion(specs, defSince, func(v versionedSwagger) bool { return v.properties(def)[name] })
+		if found && defSince.less(added) {
+			m.Properties[i].MinApiVersion = added.String()
+		}
+	}
+}
+
+// parameterName returns where (query or header) and under which name a property is sent.
+func parameterName(p CSProperty) (string, string, bool) {
+	for _, a := range p.Attributes {
+		in := ""
+		switch {
+		case strings.HasPrefix(a.Type.Name, "QueryString"):
+			in = "query"
+		case strings.HasPrefix(a.Type.Name, "Header"):
+			in = "header"
+		default:
+			continue
+		}
+
+		for _, arg := range a.Arguments {
+			if arg.Type == CSInboxTypesMap[reflect.String] {
+				return in, arg.Value, true
+			}
+		}
+	}
+
+	return "", "", false
+}
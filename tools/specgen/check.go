@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+)
+
+// check writes the diff of the files that -check finds out of date to w and
+// returns the exit code of -check.
+func (f generatedFiles) check(w io.Writer, dirs []string) int {
+	stale, err := checkGeneratedFiles(w, dirs, f)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		return exitIO
+	}
+
+	if stale > 0 {
+		fmt.Fprintf(w, "%d generated files are out of date, run specgen to update them.\n", stale)
+		return exitStale
+	}
+
+	return exitOK
+}
+
+// checkGeneratedFiles compares the generated files with the *.Generated.cs
+// files in dirs and writes a unified diff for every file that differs, is
+// missing or is no longer generated. It returns the number of such files.
+func checkGeneratedFiles(w io.Writer, dirs []string, files generatedFiles) (int, error) {
+	names := files.names()
+
+	for _, dir := range dirs {
+		existing, err := generatedFileNames(dir)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, name := range existing {
+			if _, ok := files[name]; !ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	slices.Sort(names)
+
+	stale := 0
+	for _, name := range names {
+		current, err := os.ReadFile(name)
+		exists := err == nil
+		if err != nil && !os.IsNotExist(err) {
+			return stale, err
+		}
+
+		var generated []byte
+		file, ok := files[name]
+		if ok {
+			generated = file.Content
+		}
+
+		if exists && ok && string(current) == string(generated) {
+			continue
+		}
+
+		aName, bName := name, name
+		if !exists {
+			aName = "/dev/null"
+		}
+		if !ok {
+			bName = "/dev/null"
+		}
+
+		writeUnifiedDiff(w, aName, bName, current, generated)
+		stale++
+	}
+
+	return stale, nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing map[string]string
+		files    map[string]string
+		want     int
+		// wantDiff are the headers of the diffs -check prints, in order.
+		wantDiff []string
+	}{
+		{
+			name:     "current",
+			existing: map[string]string{"A.Generated.cs": "a\n"},
+			files:    map[string]string{"A.Generated.cs": "a\n"},
+			want:     exitOK,
+		},
+		{
+			name:     "stale",
+			existing: map[string]string{"A.Generated.cs": "a\n", "B.Generated.cs": "b\n"},
+			files:    map[string]string{"A.Generated.cs": "a\n", "B.Generated.cs": "c\n"},
+			want:     exitStale,
+			wantDiff: []string{"--- B.Generated.cs\n+++ B.Generated.cs\n@@ -1,1 +1,1 @@\n-b\n+c\n"},
+		},
+		{
+			name:     "extra",
+			existing: map[string]string{"A.Generated.cs": "a\n", "Old.Generated.cs": "old\n", "Manual.cs": "manual\n"},
+			files:    map[string]string{"A.Generated.cs": "a\n"},
+			want:     exitStale,
+			wantDiff: []string{"--- Old.Generated.cs\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-old\n"},
+		},
+		{
+			name:     "missing",
+			existing: map[string]string{},
+			files:    map[string]string{"A.Generated.cs": "a\n", "A.Generated.json": "{}\n"},
+			want:     exitStale,
+			wantDiff: []string{
+				"--- /dev/null\n+++ A.Generated.cs\n@@ -0,0 +1,1 @@\n+a\n",
+				"--- /dev/null\n+++ A.Generated.json\n@@ -0,0 +1,1 @@\n+{}\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.existing {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			files := generatedFiles{}
+			for name, content := range tt.files {
+				files[filepath.Join(dir, name)] = &generatedFile{Content: []byte(content)}
+			}
+
+			var buf bytes.Buffer
+			if got := files.check(&buf, []string{dir}); got != tt.want {
+				t.Errorf("check() = %d, want %d", got, tt.want)
+			}
+
+			want := strings.Join(tt.wantDiff, "")
+			if len(tt.wantDiff) > 0 {
+				want += fmt.Sprintf("%d generated files are out of date, run specgen to update them.\n", len(tt.wantDiff))
+			}
+
+			if got := strings.ReplaceAll(buf.String(), dir+string(filepath.Separator), ""); got != want {
+				t.Errorf("check() wrote\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckMissingDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Models")
+	files := generatedFiles{filepath.Join(dir, "A.Generated.cs"): {Content: []byte("a\n")}}
+
+	var buf bytes.Buffer
+	if got := files.check(&buf, []string{dir}); got != exitIO {
+		t.Errorf("check() = %d, want %d", got, exitIO)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("check() wrote %q, want nothing", buf.String())
+	}
+}
+
+func TestGeneratedFilesAddCollision(t *testing.T) {
+	resetGeneratorState(t)
+
+	write := func(w io.Writer) { fmt.Fprintln(w, "content") }
+
+	files := generatedFiles{}
+	files.add(filepath.Join("Models", "Mount.Generated.cs"), generatedFile{TypeName: "Mount", SourceName: "mount.Mount"}, write)
+	files.add(filepath.Join("Models", "Mount.Generated.cs"), generatedFile{TypeName: "Mount", SourceName: "container.Mount"}, write)
+	files.add(filepath.Join("Models", "MountFilters.Generated.cs"), generatedFile{TypeName: "MountFilters"}, write)
+	files.add(filepath.Join("Models", "MountFilters.Generated.cs"), generatedFile{TypeName: "MountFilters"}, write)
+
+	want := []string{
+		"file (Mount.Generated.cs) is generated for both (mount.Mount) and (container.Mount)",
+		"file (MountFilters.Generated.cs) is generated for both (MountFilters) and (MountFilters)",
+	}
+	if len(generationErrors) != len(want) {
+		t.Fatalf("errors = %v, want %v", generationErrors, want)
+	}
+
+	for i, err := range generationErrors {
+		if err.Error() != want[i] {
+			t.Errorf("errors[%d] = %s, want %s", i, err, want[i])
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configVersion is the version of specgen.yaml this specgen reads.
+const configVersion = 1
+
+// Config is the content of specgen.yaml, the customizations of the C# models
+// and enums generated for the Go types.
+type Config struct {
+	Version int `yaml:"version"`
+	// Types maps the import path and name of a Go type, e.g.
+	// github.com/moby/moby/api/types/container.Config, to its customizations.
+	Types map[string]*TypeConfig `yaml:"types"`
+}
+
+// TypeConfig customizes the C# model or enum generated for a Go type.
+type TypeConfig struct {
+	// Name is the name of the C# type, empty for the name of the Go type.
+	Name string `yaml:"name"`
+	// OpenValues keeps a Go type with declared constants a string, because the
+	// constants only cover some of the values the daemon accepts or returns.
+	OpenValues bool `yaml:"openValues"`
+	// UndefinedFirst declares the Undefined member of a string enum without a ""
+	// constant first instead of last, where the hand-written enum did, so the
+	// members keep their values.
+	UndefinedFirst bool `yaml:"undefinedFirst"`
+	// Properties maps the name of a Go field to the customizations of its property.
+	Properties map[string]*PropertyConfig `yaml:"properties"`
+}
+
+// PropertyConfig customizes the C# property generated for a Go field.
+type PropertyConfig struct {
+	// Type is the C# type of the property, with its namespace, e.g. System.TimeSpan.
+	Type string `yaml:"type"`
+	// Converter is the JsonConverter the property is serialized with.
+	Converter string `yaml:"converter"`
+	// Encoding is the wire format of a time or duration field where
+	// inferTimeEncoding infers none or the wrong one, see timeEncoding.
+	Encoding timeEncoding `yaml:"encoding"`
+	// NullZeroTime set to false keeps Go's zero time of an rfc3339nano
+	// property as 0001-01-01 instead of null, see nullZeroTime.
+	NullZeroTime *bool `yaml:"nullZeroTime"`
+	// Attributes are added to the property.
+	Attributes []AttributeConfig `yaml:"attributes"`
+	// Nullable sets the nullability where the wire format differs from what
+	// isNullable derives from the Go type.
+	Nullable *bool `yaml:"nullable"`
+	// Exclude omits the field from the model.
+	Exclude bool `yaml:"exclude"`
+}
+
+// AttributeConfig is a C# attribute of a property.
+type AttributeConfig struct {
+	// Type is the attribute with its namespace, e.g. System.Text.Json.Serialization.JsonIgnore.
+	Type string `yaml:"type"`
+	// Arguments are C# expressions, strings have to be quoted.
+	Arguments []string `yaml:"arguments"`
+}
+
+// typeConfigs are the customizations of the Go types read from specgen.yaml.
+var typeConfigs = map[string]*TypeConfig{}
+
+// usedConfigs records the types and properties of the config that were looked
+// up while reflecting, by config key and by config key and field name.
+var usedConfigs = map[string]bool{}
+
+// specgenModule is the module of specgen, whose directory has the default specgen.yaml.
+const specgenModule = "github.com/dotnet/Docker.DotNet/tools/specgen"
+
+// readConfig reads the -config file, by default the specgen.yaml next to the
+// sources of specgen whatever the working directory.
+func readConfig() (*Config, error) {
+	name := *configFile
+	if name == "" {
+		modulePath, err := findGoModulePath(specgenModule)
+		if err != nil {
+			return nil, fmt.Errorf("find the default config, use -config: %w", err)
+		}
+
+		name = filepath.Join(modulePath, "specgen.yaml")
+	}
+
+	verbosef("Reading %s", name)
+	return loadConfig(name)
+}
+
+// loadConfig reads the customizations from a specgen.yaml file.
+func loadConfig(name string) (*Config, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("read config %s: %w", name, err)
+	}
+
+	var cfg Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse config %s: %w", name, err)
+	}
+
+	if cfg.Version != configVersion {
+		return nil, fmt.Errorf("config %s has version %d, specgen reads version %d", name, cfg.Version, configVersion)
+	}
+
+	return &cfg, nil
+}
+
+// validateConfig reports the types and properties of the config that are not
+// declared in the Go packages, e.g. after moby renamed them, and the ones that
+// were not applied because no route reflects them. declared maps the import
+// path and name of the Go types to the names of their fields.
+func validateConfig(cfg *Config, declared map[string][]string) {
+	for _, k := range sortedKeys(cfg.Types) {
+		tc := cfg.Types[k]
+
+		fields, ok := declared[k]
+		if !ok {
+			reportError("config type (%s) is not declared in the Go packages", k)
+			continue
+		}
+
+		if !usedConfigs[k] {
+			reportError("config type (%s) is unused, no route reflects it", k)
+			continue
+		}
+
+		for _, name := range sortedKeys(tc.Properties) {
+			if !slices.Contains(fields, name) {
+				reportError("config property (%s) of type (%s) is not a field of the Go type", name, k)
+				continue
+			}
+
+			if !usedConfigs[k+"."+name] {
+				reportError("config property (%s) of type (%s) is unused, the field is not generated", name, k)
+				continue
+			}
+
+			pc := tc.Properties[name]
+			if _, ok := timeEncodingTypes[pc.Encoding]; !ok && pc.Encoding != "" && pc.Encoding != timeEncodingNone {
+				reportError("config property (%s) of type (%s) has an unknown encoding (%s)", name, k, pc.Encoding)
+			}
+
+			for _, a := range pc.Attributes {
+				if a.Type == "" {
+					reportError("config property (%s) of type (%s) has an attribute without type", name, k)
+				}
+			}
+		}
+	}
+}
+
+// configKey returns the key of a Go type in the config.
+func configKey(t reflect.Type) string {
+	return t.PkgPath() + "." + t.Name()
+}
+
+// typeConfig returns the customizations of a Go type, nil if it has none.
+func typeConfig(t reflect.Type) *TypeConfig {
+	k := configKey(t)
+	tc, ok := typeConfigs[k]
+	if ok {
+		usedConfigs[k] = true
+	}
+
+	return tc
+}
+
+// propertyConfig returns the customizations of a field of a Go type, nil if it has none.
+func propertyConfig(t reflect.Type, field string) *PropertyConfig {
+	tc := typeConfig(t)
+	if tc == nil {
+		return nil
+	}
+
+	pc, ok := tc.Properties[field]
+	if ok {
+		usedConfigs[configKey(t)+"."+field] = true
+	}
+
+	return pc
+}
+
+// parseCSType splits a C# type name into its namespace and name, e.g.
+// System.Collections.Generic.IList<string> into System.Collections.Generic and
+// IList<string>.
+func parseCSType(s string) CSType {
+	name := s
+	if i := strings.IndexByte(s, '<'); i >= 0 {
+		name = s[:i]
+	}
+
+	i := strings.LastIndexByte(name, '.')
+	if i < 0 {
+		return CSType{"", s}
+	}
+
+	return CSType{s[:i], s[i+1:]}
+}
+
+// attributes returns the C# attributes the config adds to a property.
+func (pc *PropertyConfig) attributes() []CSAttribute {
+	var attributes []CSAttribute
+	if pc.Converter != "" {
+		attributes = append(attributes, jsonConverterAttribute(pc.Converter))
+	}
+
+	for _, a := range pc.Attributes {
+		attribute := CSAttribute{Type: parseCSType(a.Type)}
+		for _, arg := range a.Arguments {
+			attribute.Arguments = append(attribute.Arguments, CSArgument{Value: arg})
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes
+}
+
+// declaredStructFields returns the names of the fields of the struct types,
+// for the validation of the config against the types specgen declares itself.
+func declaredStructFields(types []reflect.Type) map[string][]string {
+	declared := map[string][]string{}
+	for _, t := range types {
+		t = ultimateType(t)
+		if t.Kind() != reflect.Struct || t.Name() == "" {
+			continue
+		}
+
+		var fields []string
+		for i := 0; i < t.NumField(); i++ {
+			fields = append(fields, t.Field(i).Name)
+		}
+
+		declared[configKey(t)] = fields
+	}
+
+	return declared
+}
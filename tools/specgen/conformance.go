@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/registry"
+	"github.com/moby/moby/client"
+)
+
+// conformanceHeaders are the request headers a vector records, the others are
+// set by the transport.
+var conformanceHeaders = []string{"Content-Type", "X-Registry-Auth", "X-Registry-Config"}
+
+// apiVersionPrefix matches the API version the Go client prefixes the paths with.
+var apiVersionPrefix = regexp.MustCompile(`^/v[0-9.]+`)
+
+// ConformanceVector is the HTTP request moby's Go client sends for an operation.
+type ConformanceVector struct {
+	Name   string `json:"name"`
+	Method string `json:"method"`
+	// Path is the path without the API version prefix, e.g. /containers/json.
+	Path    string              `json:"path"`
+	Query   map[string][]string `json:"query,omitempty"`
+	Headers map[string]string   `json:"headers,omitempty"`
+	// Body is the JSON body, other bodies such as tar archives are not recorded.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// conformanceCase calls an operation of the Go client with representative options.
+type conformanceCase struct {
+	Name string
+	Call func(ctx context.Context, c *client.Client) error
+	// Response is the JSON body the server answers with, {} if empty.
+	Response string
+}
+
+// conformanceCases are the operations whose requests are recorded. The names
+// are the ones of the Docker.DotNet parameters the C# tests send them with.
+var conformanceCases = []conformanceCase{
+	{Name: "ContainersListParameters", Response: "[]", Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ContainerList(ctx, client.ContainerListOptions{
+			All:     true,
+			Size:    true,
+			Limit:   5,
+			Filters: make(client.Filters).Add("label", "com.example.vendor=ACME").Add("status", "running"),
+		})
+		return err
+	}},
+	{Name: "ContainerRemoveParameters", Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ContainerRemove(ctx, "app", client.ContainerRemoveOptions{RemoveVolumes: true, RemoveLinks: true, Force: true})
+		return err
+	}},
+	{Name: "ContainerStopParameters", Call: func(ctx context.Context, c *client.Client) error {
+		timeout := 5
+		_, err := c.ContainerStop(ctx, "app", client.ContainerStopOptions{Signal: "SIGINT", Timeout: &timeout})
+		return err
+	}},
+	{Name: "ContainerKillParameters", Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ContainerKill(ctx, "app", client.ContainerKillOptions{Signal: "SIGHUP"})
+		return err
+	}},
+	{Name: "ContainerLogsParameters", Call: func(ctx context.Context, c *client.Client) error {
+		logs, err := c.ContainerLogs(ctx, "app", client.ContainerLogsOptions{
+			ShowStdout: true,
+			ShowStderr: true,
+			Since:      "1700000000",
+			Until:      "1700003600",
+			Timestamps: true,
+			Follow:     true,
+			Tail:       "10",
+		})
+		if err != nil {
+			return err
+		}
+		return logs.Close()
+	}},
+	{Name: "CopyToContainerParameters", Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.CopyToContainer(ctx, "app", client.CopyToContainerOptions{
+			DestinationPath: "/var/lib/app",
+			Content:         bytes.NewReader(nil),
+			CopyUIDGID:      true,
+		})
+		return err
+	}},
+	{Name: "CommitContainerChangesParameters", Response: `{"Id":"sha256:0"}`, Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ContainerCommit(ctx, "app", client.ContainerCommitOptions{
+			Reference: "example/app:v1",
+			Comment:   "release",
+			Author:    "Docker.DotNet",
+			Changes:   []string{"ENV DEBUG=1"},
+			NoPause:   true,
+			Config:    &container.Config{Image: "alpine:3.20", Labels: map[string]string{"com.example.vendor": "ACME"}},
+		})
+		return err
+	}},
+	{Name: "CreateContainerParameters", Response: `{"Id":"0","Warnings":[]}`, Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ContainerCreate(ctx, client.ContainerCreateOptions{
+			Name: "app",
+			Config: &container.Config{
+				Image:  "alpine:3.20",
+				Cmd:    []string{"sleep", "infinity"},
+				Env:    []string{"DEBUG=1"},
+				Labels: map[string]string{"com.example.vendor": "ACME"},
+			},
+			HostConfig: &container.HostConfig{
+				AutoRemove:    true,
+				RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyOnFailure, MaximumRetryCount: 3},
+			},
+		})
+		return err
+	}},
+	{Name: "ImageBuildParameters", Call: func(ctx context.Context, c *client.Client) error {
+		debug := "1"
+		result, err := c.ImageBuild(ctx, bytes.NewReader(nil), client.ImageBuildOptions{
+			Tags:        []string{"example/app:v1", "example/app:latest"},
+			NoCache:     true,
+			Remove:      true,
+			ForceRemove: true,
+			Memory:      268435456,
+			MemorySwap:  536870912,
+			ShmSize:     67108864,
+			Dockerfile:  "build/Dockerfile",
+			BuildArgs:   map[string]*string{"DEBUG": &debug},
+			Labels:      map[string]string{"com.example.vendor": "ACME"},
+			Target:      "release",
+			AuthConfigs: map[string]registry.AuthConfig{"registry.example.com": {Username: "user", Password: "secret"}},
+		})
+		if err != nil {
+			return err
+		}
+		return result.Body.Close()
+	}},
+	{Name: "ImagesCreateParameters", Call: func(ctx context.Context, c *client.Client) error {
+		auth, err := encodeAuthConfig(registry.AuthConfig{Username: "user", Password: "secret", ServerAddress: "registry.example.com"})
+		if err != nil {
+			return err
+		}
+
+		pull, err := c.ImagePull(ctx, "registry.example.com/example/app:v1", client.ImagePullOptions{RegistryAuth: auth})
+		if err != nil {
+			return err
+		}
+		return pull.Close()
+	}},
+	{Name: "ImagesListParameters", Response: "[]", Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ImageList(ctx, client.ImageListOptions{All: true, SharedSize: true, Manifests: true})
+		return err
+	}},
+	{Name: "ImageDeleteParameters", Response: "[]", Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ImageRemove(ctx, "example/app:v1", client.ImageRemoveOptions{Force: true})
+		return err
+	}},
+	{Name: "ImageTagParameters", Call: func(ctx context.Context, c *client.Client) error {
+		_, err := c.ImageTag(ctx, client.ImageTagOptions{Source: "example/app:v1", Target: "registry.example.com/example/app:v1"})
+		return err
+	}},
+}
+
+// encodeAuthConfig encodes the credentials of a registry as the X-Registry-Auth header value.
+func encodeAuthConfig(auth registry.AuthConfig) (string, error) {
+	b, err := json.Marshal(auth)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.URLEncoding.EncodeToString(b), nil
+}
+
+// recordConformanceVectors calls the cases with a Go client connected to a
+// local server and returns the request each case sent.
+func recordConformanceVectors(cases []conformanceCase) []ConformanceVector {
+	var mu sync.Mutex
+	var requests []*http.Request
+	var bodies [][]byte
+	var response string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		resp := response
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, resp)
+	}))
+	defer server.Close()
+
+	c, err := client.New(client.WithHost("tcp://"+server.Listener.Addr().String()), client.WithAPIVersion(client.MaxAPIVersion))
+	if err != nil {
+		reportError("create client: %w", err)
+		return nil
+	}
+	defer c.Close()
+
+	var vectors []ConformanceVector
+	for _, cc := range cases {
+		mu.Lock()
+		requests, bodies = nil, nil
+		response = cc.Response
+		if response == "" {
+			response = "{}"
+		}
+		mu.Unlock()
+
+		if err := cc.Call(context.Background(), c); err != nil {
+			reportError("conformance case (%s): %w", cc.Name, err)
+			continue
+		}
+
+		mu.Lock()
+		recorded, recordedBodies := requests, bodies
+		mu.Unlock()
+
+		if len(recorded) != 1 {
+			reportError("conformance case (%s) sent %d requests, want 1", cc.Name, len(recorded))
+			continue
+		}
+
+		vector, err := newConformanceVector(cc.Name, recorded[0], recordedBodies[0])
+		if err != nil {
+			reportError("conformance case (%s): %w", cc.Name, err)
+			continue
+		}
+
+		vectors = append(vectors, vector)
+	}
+
+	return vectors
+}
+
+// newConformanceVector records a request of the Go client.
+func newConformanceVector(name string, r *http.Request, body []byte) (ConformanceVector, error) {
+	v := ConformanceVector{
+		Name:   name,
+		Method: r.Method,
+		Path:   apiVersionPrefix.ReplaceAllString(r.URL.Path, ""),
+	}
+
+	if query := r.URL.Query(); len(query) > 0 {
+		v.Query = map[string][]string(query)
+	}
+
+	for _, h := range conformanceHeaders {
+		if value := r.Header.Get(h); value != "" {
+			if v.Headers == nil {
+				v.Headers = map[string]string{}
+			}
+			v.Headers[h] = value
+		}
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" && len(bytes.TrimSpace(body)) > 0 {
+		var b bytes.Buffer
+		if err := json.Compact(&b, body); err != nil {
+			return v, fmt.Errorf("body is not JSON: %w", err)
+		}
+		v.Body = b.Bytes()
+	}
+
+	return v, nil
+}
+
+// renderConformanceVectors adds a file per vector to the files.
+func renderConformanceVectors(files generatedFiles, dir string, vectors []ConformanceVector) {
+	for _, v := range vectors {
+		files.add(filepath.Join(dir, v.Name+".Generated.json"), generatedFile{TypeName: v.Name, Kind: "vector"}, func(w io.Writer) {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			enc.SetEscapeHTML(false)
+			if err := enc.Encode(v); err != nil {
+				reportError("encode conformance vector (%s): %w", v.Name, err)
+			}
+		})
+	}
+}
+
+// runConformance implements specgen conformance, which records the requests of
+// the Go client into the conformance vectors of the C# tests.
+func runConformance(args []string) int {
+	fs := flag.NewFlagSet("specgen conformance", flag.ContinueOnError)
+	out := fs.String("out", "", "Directory to write the conformance vectors to, e.g. ../../test/Docker.DotNet.Tests/Conformance.")
+	check := fs.Bool("check", false, "Compare the vectors with the files on disk, print a diff per file and exit with 1 if they differ, without writing any files.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: specgen conformance -out <vectors directory> [flags]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if *out == "" || fs.NArg() > 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	dir := filepath.Clean(*out)
+	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+		fmt.Fprintf(os.Stderr, "specgen: %s is not a directory\n", dir)
+		return exitUsage
+	}
+
+	files := generatedFiles{}
+	renderConformanceVectors(files, dir, recordConformanceVectors(conformanceCases))
+
+	if code := reportGenerationErrors(); code != exitOK {
+		return code
+	}
+
+	if *check {
+		stale, err := checkGeneratedFiles(os.Stdout, []string{dir}, files)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
+		}
+
+		if stale > 0 {
+			fmt.Printf("%d conformance vectors are out of date, run specgen conformance to update them.\n", stale)
+			return exitStale
+		}
+
+		return exitOK
+	}
+
+	if err := deleteGeneratedFiles(dir); err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		return exitIO
+	}
+
+	if err := files.write(); err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		return exitIO
+	}
+
+	verbosef("Wrote %d conformance vectors", len(files))
+	return exitOK
+}
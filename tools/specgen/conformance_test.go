@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+)
+
+func TestRecordConformanceVectors(t *testing.T) {
+	resetGeneratorState(t)
+
+	cases := []conformanceCase{
+		{Name: "GoldenQuery", Call: func(ctx context.Context, c *client.Client) error {
+			_, err := c.ContainerRemove(ctx, "golden", client.ContainerRemoveOptions{Force: true})
+			return err
+		}},
+		{Name: "GoldenBody", Response: `{"Id":"0","Warnings":[]}`, Call: func(ctx context.Context, c *client.Client) error {
+			_, err := c.ContainerCreate(ctx, client.ContainerCreateOptions{
+				Name:   "golden",
+				Config: &container.Config{Image: "golden", Labels: map[string]string{"golden": "true"}},
+			})
+			return err
+		}},
+		{Name: "GoldenError", Call: func(ctx context.Context, c *client.Client) error {
+			return errors.New("golden error")
+		}},
+		{Name: "GoldenNoRequest", Call: func(ctx context.Context, c *client.Client) error {
+			return nil
+		}},
+	}
+
+	files := generatedFiles{}
+	renderConformanceVectors(files, ".", recordConformanceVectors(cases))
+
+	compareGolden(t, "conformance", writeGoldenFiles(files))
+}
@@ -0,0 +1,459 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// swaggerDefinitionNames maps reflected Go types to their swagger definition
+// where the package prefixed type name does not match. The bare type name is
+// not matched, it pairs types of different packages such as network.Task and
+// swarm.Task with the same definition.
+var swaggerDefinitionNames = map[string]string{
+	"blkiodev.ThrottleDevice":         "ThrottleDevice",
+	"build.CacheRecord":               "BuildCache",
+	"build.DiskUsage":                 "BuildCacheDiskUsage",
+	"client.NetworkConnectOptions":    "NetworkConnectRequest",
+	"client.NetworkDisconnectOptions": "NetworkDisconnectRequest",
+	"container.Config":                "ContainerConfig",
+	"container.DeviceMapping":         "DeviceMapping",
+	"container.DeviceRequest":         "DeviceRequest",
+	"container.DiskUsage":             "ContainersDiskUsage",
+	"container.ExecProcessConfig":     "ProcessConfig",
+	"container.FilesystemChange":      "FilesystemChange",
+	"container.Health":                "Health",
+	"container.HealthcheckResult":     "HealthcheckResult",
+	"container.HostConfig":            "HostConfig",
+	"container.MountPoint":            "MountPoint",
+	"container.NetworkSettings":       "NetworkSettings",
+	"container.PortSummary":           "PortSummary",
+	"container.Resources":             "Resources",
+	"container.RestartPolicy":         "RestartPolicy",
+	"container.State":                 "ContainerState",
+	"events.Actor":                    "EventActor",
+	"events.Message":                  "EventMessage",
+	"image.BuildIdentity":             "BuildIdentity",
+	"image.DeleteResponse":            "ImageDeleteResponseItem",
+	"image.DiskUsage":                 "ImagesDiskUsage",
+	"image.Identity":                  "Identity",
+	"image.InspectResponse":           "ImageInspect",
+	"image.PullIdentity":              "PullIdentity",
+	"image.SignatureIdentity":         "SignatureIdentity",
+	"image.SignatureTimestamp":        "SignatureTimestamp",
+	"image.SignerIdentity":            "SignerIdentity",
+	"jsonstream.Error":                "ErrorDetail",
+	"jsonstream.Progress":             "ProgressDetail",
+	"mount.Mount":                     "Mount",
+	"network.ConfigReference":         "ConfigReference",
+	"network.EndpointIPAMConfig":      "EndpointIPAMConfig",
+	"network.EndpointResource":        "EndpointResource",
+	"network.EndpointSettings":        "EndpointSettings",
+	"network.IPAM":                    "IPAM",
+	"network.IPAMConfig":              "IPAMConfig",
+	"network.IPAMStatus":              "IPAMStatus",
+	"network.Inspect":                 "NetworkInspect",
+	"network.Network":                 "Network",
+	"network.NetworkingConfig":        "NetworkingConfig",
+	"network.PeerInfo":                "PeerInfo",
+	"network.PortBinding":             "PortBinding",
+	"network.ServiceInfo":             "ServiceInfo",
+	"network.SubnetStatus":            "SubnetStatus",
+	"network.Task":                    "NetworkTaskInfo",
+	"plugin.Plugin":                   "Plugin",
+	"registry.AuthConfig":             "AuthConfig",
+	"registry.AuthResponse":           "AuthResponse",
+	"registry.DistributionInspect":    "DistributionInspect",
+	"registry.IndexInfo":              "IndexInfo",
+	"storage.DriverData":              "DriverData",
+	"storage.RootFSStorage":           "RootFSStorage",
+	"storage.RootFSStorageSnapshot":   "RootFSStorageSnapshot",
+	"storage.Storage":                 "Storage",
+	"swarm.ClusterInfo":               "ClusterInfo",
+	"swarm.ConfigSpec":                "ConfigSpec",
+	"swarm.ContainerStatus":           "ContainerStatus",
+	"swarm.Driver":                    "Driver",
+	"swarm.EndpointSpec":              "EndpointSpec",
+	"swarm.EngineDescription":         "EngineDescription",
+	"swarm.JoinTokens":                "JoinTokens",
+	"swarm.Limit":                     "Limit",
+	"swarm.ManagerStatus":             "ManagerStatus",
+	"swarm.NetworkAttachmentConfig":   "NetworkAttachmentConfig",
+	"swarm.Node":                      "Node",
+	"swarm.NodeDescription":           "NodeDescription",
+	"swarm.NodeSpec":                  "NodeSpec",
+	"swarm.NodeStatus":                "NodeStatus",
+	"swarm.Peer":                      "PeerNode",
+	"swarm.Platform":                  "Platform",
+	"swarm.PortConfig":                "EndpointPortConfig",
+	"swarm.PortStatus":                "PortStatus",
+	"swarm.Secret":                    "Secret",
+	"swarm.SecretSpec":                "SecretSpec",
+	"swarm.Service":                   "Service",
+	"swarm.ServiceCreateResponse":     "ServiceCreateResponse",
+	"swarm.ServiceSpec":               "ServiceSpec",
+	"swarm.ServiceUpdateResponse":     "ServiceUpdateResponse",
+	"swarm.Spec":                      "SwarmSpec",
+	"swarm.Swarm":                     "Swarm",
+	"swarm.TLSInfo":                   "TLSInfo",
+	"swarm.Task":                      "Task",
+	"swarm.TaskSpec":                  "TaskSpec",
+	"swarm.TaskStatus":                "TaskStatus",
+	"swarm.Version":                   "ObjectVersion",
+	"system.Commit":                   "Commit",
+	"system.ContainerdInfo":           "ContainerdInfo",
+	"system.DeviceInfo":               "DeviceInfo",
+	"system.FirewallInfo":             "FirewallInfo",
+	"system.Info":                     "SystemInfo",
+	"system.NRIInfo":                  "NRIInfo",
+	"system.PluginsInfo":              "PluginsInfo",
+	"system.Runtime":                  "Runtime",
+	"system.VersionResponse":          "SystemVersion",
+	"v1.Descriptor":                   "OCIDescriptor",
+	"v1.HealthcheckConfig":            "HealthConfig",
+	"v1.ImageConfig":                  "ImageConfig",
+	"v1.Platform":                     "OCIPlatform",
+	"volume.ClusterVolume":            "ClusterVolume",
+	"volume.ClusterVolumeSpec":        "ClusterVolumeSpec",
+	"volume.Topology":                 "Topology",
+	"volume.Volume":                   "Volume",
+}
+
+// typeShape is a C# type reduced to what both front-ends agree on: enums are
+// compared by their underlying kind and models by their swagger definition.
+type typeShape struct {
+	Kind string
+	// Model is the swagger definition of a model type, empty for inline or unmatched models.
+	Model string
+	Elem  *typeShape
+}
+
+func (s *typeShape) String() string {
+	switch s.Kind {
+	case "list":
+		return "IList<" + s.Elem.String() + ">"
+	case "map":
+		return "IDictionary<string, " + s.Elem.String() + ">"
+	case "model":
+		if s.Model == "" {
+			return "object"
+		}
+
+		return s.Model
+	}
+
+	return s.Kind
+}
+
+// matches reports whether both shapes describe the same wire type. Models only
+// differ if both sides are known definitions and integer enums match any integer.
+func (s *typeShape) matches(o *typeShape) bool {
+	if s.Kind == "integer" && isIntegerKind(o.Kind) || o.Kind == "integer" && isIntegerKind(s.Kind) {
+		return true
+	}
+
+	if s.Kind == "model" && o.Kind == "object" || s.Kind == "object" && o.Kind == "model" {
+		return true
+	}
+
+	if s.Kind != o.Kind {
+		return false
+	}
+
+	switch s.Kind {
+	case "list", "map":
+		return s.Elem.matches(o.Elem)
+	case "model":
+		return s.Model == "" || o.Model == "" || s.Model == o.Model
+	}
+
+	return true
+}
+
+func isIntegerKind(kind string) bool {
+	switch kind {
+	case "integer", "sbyte", "byte", "short", "ushort", "int", "uint", "long", "ulong":
+		return true
+	}
+
+	return false
+}
+
+// parseShape converts a generated C# type name. resolve returns the shape of a
+// model or enum name of the front-end the type comes from, or nil for any other name.
+// DateTimeOffset and DateTime are the same RFC 3339 string on the wire.
+func parseShape(name string, resolve func(string) *typeShape) *typeShape {
+	name = strings.TrimSuffix(name, "?")
+
+	switch {
+	case strings.HasPrefix(name, "IList<"):
+		return &typeShape{Kind: "list", Elem: parseShape(name[len("IList<"):len(name)-1], resolve)}
+	case strings.HasPrefix(name, "IDictionary<"):
+		inner := name[len("IDictionary<") : len(name)-1]
+		_, value, _ := strings.Cut(inner, ", ")
+		return &typeShape{Kind: "map", Elem: parseShape(value, resolve)}
+	case name == "byte[]":
+		return &typeShape{Kind: "string"}
+	case name == "DateTimeOffset":
+		// swagger has no notion of a zone, its date-time strings are DateTime.
+		return &typeShape{Kind: "DateTime"}
+	case strings.HasSuffix(name, "[]"):
+		return &typeShape{Kind: "list", Elem: parseShape(strings.TrimSuffix(name, "[]"), resolve)}
+	}
+
+	if shape := resolve(name); shape != nil {
+		return shape
+	}
+
+	return &typeShape{Kind: name}
+}
+
+// integerConverters are the JsonConverters that write a property as an integer,
+// whatever its C# type.
+var integerConverters = map[string]bool{
+	timeEncodingTypes[timeEncodingNanoseconds].Converter: true,
+	timeEncodingTypes[timeEncodingSeconds].Converter:     true,
+	timeEncodingTypes[timeEncodingUnix].Converter:        true,
+}
+
+// propertyShape returns the shape of a property, which is an integer if its
+// JsonConverter writes one, e.g. a TimeSpan of nanoseconds.
+func propertyShape(p CSProperty, resolve func(string) *typeShape) *typeShape {
+	for _, a := range p.Attributes {
+		if a.Type.Name != "JsonConverter" || len(a.Arguments) == 0 {
+			continue
+		}
+
+		converter := strings.TrimSuffix(strings.TrimPrefix(a.Arguments[0].Value, "typeof("), ")")
+		if integerConverters[converter] {
+			return &typeShape{Kind: "integer"}
+		}
+	}
+
+	return parseShape(p.Type.Name, resolve)
+}
+
+// isSwaggerDefinition reports whether m is a swagger definition rather than an inline model.
+func isSwaggerDefinition(m *CSModelType) bool {
+	return strings.Count(m.SourceName, ".") == 1
+}
+
+// jsonPropertyName returns the wire name of a property, or false for query and header parameters.
+func jsonPropertyName(p CSProperty) (string, bool) {
+	for _, a := range p.Attributes {
+		if a.Type.Name == "JsonPropertyName" && len(a.Arguments) > 0 {
+			return a.Arguments[0].Value, true
+		}
+	}
+
+	return "", false
+}
+
+// matchSwaggerDefinition finds the swagger definition of a reflected Go type such as container.Summary.
+// exists reports whether the swagger document declares a definition.
+func matchSwaggerDefinition(sourceName string, exists func(name string) bool) (string, bool) {
+	if name, ok := swaggerDefinitionNames[sourceName]; ok {
+		return name, exists(name)
+	}
+
+	pkg, typeName, ok := strings.Cut(sourceName, ".")
+	if !ok || pkg == "main" {
+		return "", false
+	}
+
+	name := strings.ToUpper(pkg[:1]) + pkg[1:] + typeName
+	return name, exists(name)
+}
+
+// readKnownMismatches reads the -crosscheck-known file, by default the
+// crosscheck-known.txt next to the sources of specgen.
+func readKnownMismatches() (map[string]bool, error) {
+	name := *crossCheckKnown
+	if name == "" {
+		modulePath, err := findGoModulePath(specgenModule)
+		if err != nil {
+			return nil, fmt.Errorf("find the known mismatches, use -crosscheck-known: %w", err)
+		}
+
+		name = filepath.Join(modulePath, "crosscheck-known.txt")
+	}
+
+	verbosef("Reading %s", name)
+	return loadKnownMismatches(name)
+}
+
+// loadKnownMismatches reads a file with one mismatch the cross-check accepts
+// per line, as it reports them. Empty lines and lines starting with # are skipped.
+func loadKnownMismatches(name string) (map[string]bool, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("read known mismatches %s: %w", name, err)
+	}
+
+	known := map[string]bool{}
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			known[line] = true
+		}
+	}
+
+	return known, nil
+}
+
+// crossCheck compares the reflected models with the swagger models and writes
+// the mismatches to w. Known mismatches are written with a (known) suffix and
+// not counted, known mismatches that are no longer found are. It returns the
+// number of mismatches that are not known.
+func crossCheck(w io.Writer, reflected, swagger map[string]*CSModelType, known map[string]bool) int {
+	// C# model name -> swagger definition for both front-ends.
+	reflectedDefinitions := map[string]string{}
+
+	definitionExists := func(name string) bool {
+		m, ok := swagger[name]
+		return ok && isSwaggerDefinition(m)
+	}
+
+	// swagger definition -> the Go type matched to it, a definition has one counterpart.
+	matchedBy := map[string]string{}
+	duplicates := map[string]bool{}
+
+	mismatches := 0
+	found := map[string]bool{}
+	report := func(format string, a ...any) {
+		line := fmt.Sprintf(format, a...)
+		if known[line] {
+			found[line] = true
+			fmt.Fprintf(w, "%s (known)\n", line)
+			return
+		}
+
+		fmt.Fprintln(w, line)
+		mismatches++
+	}
+
+	reflectedKeys := sortedKeys(reflected)
+	for _, k := range reflectedKeys {
+		m := reflected[k]
+		def, ok := matchSwaggerDefinition(m.SourceName, definitionExists)
+		if !ok {
+			continue
+		}
+
+		if other, ok := matchedBy[def]; ok {
+			report("duplicate type: %s and %s both match swagger definition %s", other, m.SourceName, def)
+			duplicates[m.SourceName] = true
+			continue
+		}
+
+		matchedBy[def] = m.SourceName
+		reflectedDefinitions[m.Name] = def
+	}
+
+	resolveReflected := func(name string) *typeShape {
+		for _, e := range reflectedEnums {
+			if e.Name == name {
+				if e.IsString {
+					return &typeShape{Kind: "string"}
+				}
+
+				return &typeShape{Kind: "integer"}
+			}
+		}
+
+		if def, ok := reflectedDefinitions[name]; ok {
+			return &typeShape{Kind: "model", Model: def}
+		}
+
+		for _, m := range reflected {
+			if m.Name == name {
+				return &typeShape{Kind: "model"}
+			}
+		}
+
+		return nil
+	}
+
+	resolveSwagger := func(name string) *typeShape {
+		m, ok := swagger[name]
+		if !ok {
+			return nil
+		}
+
+		// Inline models have no counterpart to compare by name.
+		if !isSwaggerDefinition(m) {
+			return &typeShape{Kind: "model"}
+		}
+
+		return &typeShape{Kind: "model", Model: name}
+	}
+
+	for _, k := range reflectedKeys {
+		m := reflected[k]
+		if strings.HasPrefix(m.SourceName, "main.") {
+			continue
+		}
+
+		def, ok := reflectedDefinitions[m.Name]
+		if !ok && duplicates[m.SourceName] {
+			continue
+		}
+
+		if !ok {
+			report("missing type: %s (%s) has no swagger definition", m.SourceName, m.Name)
+			continue
+		}
+
+		s := swagger[def]
+
+		swaggerProps := map[string]CSProperty{}
+		for _, p := range s.Properties {
+			if name, ok := jsonPropertyName(p); ok {
+				swaggerProps[name] = p
+			}
+		}
+
+		seen := map[string]bool{}
+		for _, p := range m.Properties {
+			name, ok := jsonPropertyName(p)
+			if !ok {
+				continue
+			}
+
+			seen[name] = true
+
+			sp, ok := swaggerProps[name]
+			if !ok {
+				report("missing field: %s.%s is not in swagger definition %s", m.SourceName, name, def)
+				continue
+			}
+
+			rt, st := propertyShape(p, resolveReflected), parseShape(sp.Type.Name, resolveSwagger)
+			if !rt.matches(st) {
+				report("type mismatch: %s.%s is %s, swagger definition %s has %s", m.SourceName, name, rt, def, st)
+			}
+		}
+
+		for _, p := range s.Properties {
+			if name, ok := jsonPropertyName(p); ok && !seen[name] {
+				report("missing field: swagger definition %s.%s is not in %s", def, name, m.SourceName)
+			}
+		}
+	}
+
+	for _, k := range sortedKeys(swagger) {
+		if _, ok := matchedBy[k]; !ok && isSwaggerDefinition(swagger[k]) {
+			report("missing type: swagger definition %s is not reflected", k)
+		}
+	}
+
+	for _, line := range sortedKeys(known) {
+		if !found[line] {
+			report("fixed: %s is no longer found, remove it from the known mismatches", line)
+		}
+	}
+
+	return mismatches
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+// crossCheckProperty returns a property of a model written as name on the wire.
+func crossCheckProperty(name, typeName string, attributes ...CSAttribute) CSProperty {
+	attributes = append(attributes, CSAttribute{
+		Type:      CSType{"System.Text.Json.Serialization", "JsonPropertyName"},
+		Arguments: []CSArgument{{Value: name, Type: CSType{"", "string"}}},
+	})
+
+	return CSProperty{Name: name, Type: CSType{"", typeName}, Attributes: attributes}
+}
+
+// crossCheckModels returns the reflected Go type source and the swagger
+// definition name, each with one property of the given type.
+func crossCheckModels(name, reflectedType, swaggerType string, attributes ...CSAttribute) (map[string]*CSModelType, map[string]*CSModelType) {
+	reflected := map[string]*CSModelType{
+		"types.Golden": {Name: "Golden", SourceName: "types.Golden", Properties: []CSProperty{crossCheckProperty(name, reflectedType, attributes...)}},
+	}
+	swagger := map[string]*CSModelType{
+		"TypesGolden": {Name: "TypesGolden", SourceName: "swagger.TypesGolden", Properties: []CSProperty{crossCheckProperty(name, swaggerType)}},
+	}
+
+	return reflected, swagger
+}
+
+func TestCrossCheckTimes(t *testing.T) {
+	tests := []struct {
+		name           string
+		reflectedType  string
+		swaggerType    string
+		converter      string
+		wantMismatches int
+	}{
+		{"date time offset", "DateTimeOffset?", "DateTime?", "JsonGoTimeConverter", 0},
+		{"date time", "DateTime", "DateTime", "", 0},
+		{"date time offset string", "DateTimeOffset", "string", "", 1},
+		{"nanoseconds", "TimeSpan?", "long?", "JsonTimeSpanNanosecondsConverter", 0},
+		{"seconds", "TimeSpan", "int", "JsonTimeSpanSecondsConverter", 0},
+		{"unix timestamp", "DateTime", "long", "JsonUnixTimestampConverter", 0},
+		{"time span without converter", "TimeSpan", "long", "", 1},
+		{"unix timestamp string", "DateTime", "string", "JsonUnixTimestampConverter", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetGeneratorState(t)
+
+			var attributes []CSAttribute
+			if tt.converter != "" {
+				attributes = append(attributes, jsonConverterAttribute(tt.converter))
+			}
+
+			reflected, swagger := crossCheckModels("Created", tt.reflectedType, tt.swaggerType, attributes...)
+
+			var buf bytes.Buffer
+			if got := crossCheck(&buf, reflected, swagger, nil); got != tt.wantMismatches {
+				t.Errorf("crossCheck() = %d mismatches, want %d:\n%s", got, tt.wantMismatches, buf.String())
+			}
+		})
+	}
+}
+
+func TestParseShape(t *testing.T) {
+	resolve := func(name string) *typeShape {
+		switch name {
+		case "ContainerState":
+			return &typeShape{Kind: "model", Model: "ContainerState"}
+		case "RestartPolicyMode":
+			return &typeShape{Kind: "string"}
+		}
+
+		return nil
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"long?", "long"},
+		{"string", "string"},
+		{"byte[]", "string"},
+		{"DateTimeOffset?", "DateTime"},
+		{"DateTime", "DateTime"},
+		{"string[]", "IList<string>"},
+		{"IList<long>", "IList<long>"},
+		{"IDictionary<string, IList<string>>", "IDictionary<string, IList<string>>"},
+		{"ContainerState", "ContainerState"},
+		{"IList<ContainerState>", "IList<ContainerState>"},
+		{"RestartPolicyMode?", "string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseShape(tt.name, resolve).String(); got != tt.want {
+				t.Errorf("parseShape(%q) = %s, want %s", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeShapeMatches(t *testing.T) {
+	list := func(elem *typeShape) *typeShape { return &typeShape{Kind: "list", Elem: elem} }
+	dict := func(elem *typeShape) *typeShape { return &typeShape{Kind: "map", Elem: elem} }
+	model := func(name string) *typeShape { return &typeShape{Kind: "model", Model: name} }
+	kind := func(kind string) *typeShape { return &typeShape{Kind: kind} }
+
+	tests := []struct {
+		name string
+		a, b *typeShape
+		want bool
+	}{
+		{"same kind", kind("string"), kind("string"), true},
+		{"different kind", kind("string"), kind("long"), false},
+		{"different integers", kind("int"), kind("long"), false},
+		{"integer enum", kind("integer"), kind("int"), true},
+		{"integer enum reversed", kind("ulong"), kind("integer"), true},
+		{"integer enum string", kind("integer"), kind("string"), false},
+		{"same model", model("ContainerState"), model("ContainerState"), true},
+		{"different models", model("ContainerState"), model("HostConfig"), false},
+		{"inline model", model(""), model("HostConfig"), true},
+		{"model object", model("HostConfig"), kind("object"), true},
+		{"object model", kind("object"), model(""), true},
+		{"lists", list(kind("string")), list(kind("string")), true},
+		{"lists of different elements", list(kind("string")), list(kind("long")), false},
+		{"list map", list(kind("string")), dict(kind("string")), false},
+		{"maps of models", dict(model("")), dict(model("Mount")), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.matches(tt.b); got != tt.want {
+				t.Errorf("%s.matches(%s) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossCheckKnown(t *testing.T) {
+	const mismatch = "type mismatch: types.Golden.Size is ulong, swagger definition TypesGolden has long"
+
+	tests := []struct {
+		name           string
+		reflectedType  string
+		known          map[string]bool
+		wantMismatches int
+		want           string
+	}{
+		{"unknown", "ulong", nil, 1, mismatch + "\n"},
+		{"known", "ulong", map[string]bool{mismatch: true}, 0, mismatch + " (known)\n"},
+		{"fixed", "long", map[string]bool{mismatch: true}, 1, "fixed: " + mismatch + " is no longer found, remove it from the known mismatches\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetGeneratorState(t)
+
+			reflected, swagger := crossCheckModels("Size", tt.reflectedType, "long")
+
+			var buf bytes.Buffer
+			if got := crossCheck(&buf, reflected, swagger, tt.known); got != tt.wantMismatches {
+				t.Errorf("crossCheck() = %d mismatches, want %d", got, tt.wantMismatches)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("crossCheck() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossCheckDuplicateDefinition(t *testing.T) {
+	resetGeneratorState(t)
+
+	names := swaggerDefinitionNames
+	swaggerDefinitionNames = map[string]string{"swarm.Task": "Task", "network.Task": "Task"}
+	t.Cleanup(func() { swaggerDefinitionNames = names })
+
+	reflected := map[string]*CSModelType{
+		"network.Task": {Name: "NetworkTask", SourceName: "network.Task"},
+		"swarm.Task":   {Name: "Task", SourceName: "swarm.Task"},
+	}
+	swagger := map[string]*CSModelType{
+		"Task": {Name: "Task", SourceName: "swagger.Task"},
+	}
+
+	var buf bytes.Buffer
+	if got := crossCheck(&buf, reflected, swagger, nil); got != 1 {
+		t.Errorf("crossCheck() = %d mismatches, want 1", got)
+	}
+
+	want := "duplicate type: network.Task and swarm.Task both match swagger definition Task\n"
+	if got := buf.String(); got != want {
+		t.Errorf("crossCheck() wrote %q, want %q", got, want)
+	}
+}
+
+func TestMatchSwaggerDefinition(t *testing.T) {
+	definitions := map[string]bool{
+		"ContainerSummary":      true,
+		"Task":                  true,
+		"NetworkTaskInfo":       true,
+		"Secret":                true,
+		"ContainerConfig":       true,
+		"NetworkConnectRequest": true,
+	}
+	exists := func(name string) bool { return definitions[name] }
+
+	tests := []struct {
+		sourceName string
+		want       string
+		wantOK     bool
+	}{
+		{"container.Summary", "ContainerSummary", true},
+		{"container.Config", "ContainerConfig", true},
+		{"client.NetworkConnectOptions", "NetworkConnectRequest", true},
+		// Same named types of different packages only match their own definition.
+		{"swarm.Task", "Task", true},
+		{"network.Task", "NetworkTaskInfo", true},
+		{"swarm.Secret", "Secret", true},
+		{"volume.Secret", "", false},
+		{"image.Summary", "", false},
+		{"main.CreateContainerParameters", "", false},
+		{"Summary", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sourceName, func(t *testing.T) {
+			got, ok := matchSwaggerDefinition(tt.sourceName, exists)
+			if ok != tt.wantOK || ok && got != tt.want {
+				t.Errorf("matchSwaggerDefinition(%q) = %q, %t, want %q, %t", tt.sourceName, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSwaggerDefinitionNamesUnique(t *testing.T) {
+	seen := map[string]string{}
+	for _, sourceName := range sortedKeys(swaggerDefinitionNames) {
+		def := swaggerDefinitionNames[sourceName]
+		if other, ok := seen[def]; ok {
+			t.Errorf("%s and %s both map to swagger definition %s", other, sourceName, def)
+		}
+
+		seen[def] = sourceName
+	}
+}
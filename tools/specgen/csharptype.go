@@ -6,7 +6,6 @@ import (
 	"net"
 	"net/netip"
 	"os"
-	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -16,20 +15,15 @@ import (
 	"github.com/moby/moby/api/types/network"
 )
 
-var GlobalUsings map[string]bool
-
-func init() {
-	GlobalUsings = readGlobalUsings()
-}
+// GlobalUsings holds the global usings of the project the code is generated for,
+// which are omitted from the generated files.
+var GlobalUsings = map[string]bool{}
 
 // Reads the global usings from the Docker.DotNet.csproj file.
-func readGlobalUsings() map[string]bool {
-	csprojPath := filepath.Join("..", "..", "src", "Docker.DotNet", "Docker.DotNet.csproj")
-
+func readGlobalUsings(csprojPath string) (map[string]bool, error) {
 	data, err := os.ReadFile(csprojPath)
 	if err != nil {
-		fmt.Printf("Warning: Could not read .csproj file: %v\n", err)
-		return make(map[string]bool)
+		return nil, fmt.Errorf("read global usings: %w", err)
 	}
 
 	usings := make(map[string]bool)
@@ -43,7 +37,7 @@ func readGlobalUsings() map[string]bool {
 		}
 	}
 
-	return usings
+	return usings, nil
 }
 
 // EmptyStruct is a type that represents a struct with no exported values.
@@ -94,7 +88,7 @@ type CSArgument struct {
 
 func (a CSArgument) String() string {
 	if a.Type.Name == "string" {
-		return fmt.Sprintf("\"%s\"", a.Value)
+		return fmt.Sprintf("\"%s\"", escapeCSString(a.Value))
 	}
 
 	return a.Value
@@ -176,12 +170,28 @@ type CSConstructor struct {
 type CSProperty struct {
 	Name         string
 	Type         CSType
-	IsOpt        bool
+	IsNullable   bool
 	Attributes   []CSAttribute
 	DefaultValue string
 	Comment      string
+	// MinApiVersion is the oldest API version that understands the property, empty for all versions.
+	MinApiVersion string
+	// Deprecated is the deprecation message of the Go field, empty if it is not deprecated.
+	Deprecated string
 }
 
+// CSModelStyle selects how a model is declared in C#.
+type CSModelStyle string
+
+const (
+	// CSModelStyleClass is a class with mutable { get; set; } properties.
+	CSModelStyleClass CSModelStyle = "class"
+	// CSModelStyleInit is a class with { get; init; } properties.
+	CSModelStyleInit CSModelStyle = "init"
+	// CSModelStyleRecord is a record with { get; init; } properties, giving it value equality and with expressions.
+	CSModelStyleRecord CSModelStyle = "record"
+)
+
 // CSModelType is a type that represents a reflected type to generate a C# model for.
 type CSModelType struct {
 	Name         string
@@ -196,6 +206,32 @@ type CSModelType struct {
 	IsStarted                     bool
 	HasJsonSerializableProperties bool
 	Comment                       string
+	// MinApiVersion is the oldest API version that understands the model, empty for all versions.
+	MinApiVersion string
+	// Deprecated is the deprecation message of the Go type, empty if it is not deprecated.
+	Deprecated string
+	// Style is how the model is declared, empty for CSModelStyleClass.
+	Style CSModelStyle
+	// BaseType is the union the model is a member of, if any.
+	BaseType string
+}
+
+// keyword returns the C# keyword the model is declared with.
+func (t *CSModelType) keyword() string {
+	if t.Style == CSModelStyleRecord {
+		return "record"
+	}
+
+	return "class"
+}
+
+// setter returns the accessor the properties of the model are set with.
+func (t *CSModelType) setter() string {
+	if t.Style == CSModelStyleInit || t.Style == CSModelStyleRecord {
+		return "init"
+	}
+
+	return "set"
 }
 
 // NewModel creates a new model type with valid slices
@@ -221,7 +257,7 @@ func (t *CSModelType) Write(w io.Writer) {
 		fmt.Fprintln(w, "")
 	}
 
-	fmt.Fprintln(w, "namespace Docker.DotNet.Models")
+	fmt.Fprintf(w, "namespace %s\n", *modelsNamespace)
 	fmt.Fprintln(w, "{")
 
 	writeClass(w, t)
@@ -229,6 +265,100 @@ func (t *CSModelType) Write(w io.Writer) {
 	fmt.Fprintln(w, "}")
 }
 
+// CSEnumMember is a type that represents a member of a C# enum.
+type CSEnumMember struct {
+	Name    string
+	Value   string
+	Comment string
+}
+
+// CSEnumType is a type that represents a reflected named Go type with declared
+// constants to generate a C# enum for.
+type CSEnumType struct {
+	Name       string
+	SourceName string
+	Comment    string
+	// IsString is true for Go string types, whose members are serialized by
+	// their EnumMember value. Integer types are serialized by their number.
+	IsString bool
+	Members  []CSEnumMember
+}
+
+// Attributes returns the attributes of the enum type.
+func (e *CSEnumType) Attributes() []CSAttribute {
+	if !e.IsString {
+		return nil
+	}
+
+	return []CSAttribute{
+		{
+			Type:      CSType{"System.Text.Json.Serialization", "JsonConverter"},
+			Arguments: []CSArgument{{Value: fmt.Sprintf("typeof(JsonEnumMemberConverter<%s>)", e.Name)}},
+		},
+	}
+}
+
+// Write the specific enum type to the io writer given.
+func (e *CSEnumType) Write(w io.Writer) {
+	fmt.Fprintln(w, "#nullable enable")
+
+	added := make(map[string]bool)
+	var usings []string
+	for _, a := range e.Attributes() {
+		usings = safeAddUsing(a.Type.Namespace, usings, added)
+	}
+	if e.IsString {
+		usings = safeAddUsing("System.Runtime.Serialization", usings, added)
+	}
+	sortUsings(usings)
+
+	for _, u := range usings {
+		fmt.Fprintf(w, "using %s;\n", u)
+	}
+
+	if len(usings) > 0 {
+		fmt.Fprintln(w, "")
+	}
+
+	fmt.Fprintf(w, "namespace %s\n", *modelsNamespace)
+	fmt.Fprintln(w, "{")
+
+	writeXMLComment(w, e.Comment, "    ")
+
+	for _, a := range e.Attributes() {
+		fmt.Fprintf(w, "    %s\n", a)
+	}
+
+	fmt.Fprintf(w, "    public enum %s // (%s)\n", e.Name, e.SourceName)
+	fmt.Fprintln(w, "    {")
+
+	memberCount := len(e.Members)
+	for i, m := range e.Members {
+		writeXMLComment(w, m.Comment, "        ")
+
+		if e.IsString {
+			a := CSAttribute{
+				Type:           CSType{"System.Runtime.Serialization", "EnumMember"},
+				NamedArguments: []CSNamedArgument{{Name: "Value", Argument: CSArgument{m.Value, CSInboxTypesMap[reflect.String]}}},
+			}
+			fmt.Fprintf(w, "        %s\n", a)
+			fmt.Fprintf(w, "        %s", m.Name)
+		} else {
+			fmt.Fprintf(w, "        %s = %s", m.Name, m.Value)
+		}
+
+		if i != memberCount-1 {
+			fmt.Fprintln(w, ",")
+			fmt.Fprintln(w, "")
+		} else {
+			fmt.Fprintln(w)
+		}
+	}
+
+	fmt.Fprintln(w, "    }")
+	fmt.Fprintln(w, "}")
+}
+
 func calcUsings(t *CSModelType) []string {
 	added := make(map[string]bool)
 	var usings []string
@@ -244,6 +374,12 @@ func calcUsings(t *CSModelType) []string {
 		}
 	}
 
+	sortUsings(usings)
+
+	return usings
+}
+
+func sortUsings(usings []string) {
 	// C# convertion is that 'System' usings are first. Sort them as if they are
 	// the 'least' significant order so they appear first in the output.
 	sort.Slice(usings, func(i, j int) bool {
@@ -261,8 +397,6 @@ func calcUsings(t *CSModelType) []string {
 
 		return strings.Compare(usings[i], usings[j]) < 0
 	})
-
-	return usings
 }
 
 func safeAddUsing(using string, usings []string, added map[string]bool) []string {
@@ -280,14 +414,47 @@ func safeAddUsing(using string, usings []string, added map[string]bool) []string
 	return usings
 }
 
+// writeRemarks documents the oldest API version a model or property needs and
+// its deprecation, on one line if there is only one of them.
+func writeRemarks(w io.Writer, version string, deprecated string, indent string) {
+	var remarks []string
+	if version != "" {
+		remarks = append(remarks, fmt.Sprintf("Requires Docker Engine API v%s or later.", version))
+	}
+
+	if deprecated != "" {
+		remarks = append(remarks, "Deprecated: "+escapeXMLComment(deprecated))
+	}
+
+	switch len(remarks) {
+	case 0:
+		return
+	case 1:
+		fmt.Fprintf(w, "%s/// <remarks>%s</remarks>\n", indent, remarks[0])
+	default:
+		fmt.Fprintf(w, "%s/// <remarks>\n", indent)
+		for _, r := range remarks {
+			fmt.Fprintf(w, "%s/// %s\n", indent, r)
+		}
+		fmt.Fprintf(w, "%s/// </remarks>\n", indent)
+	}
+}
+
+// obsoleteAttribute returns the [Obsolete] attribute of a deprecated model or property.
+func obsoleteAttribute(deprecated string) CSAttribute {
+	return CSAttribute{
+		Type:      CSType{"System", "Obsolete"},
+		Arguments: []CSArgument{{Value: deprecated, Type: CSInboxTypesMap[reflect.String]}},
+	}
+}
+
 func writeXMLComment(w io.Writer, comment string, indent string) {
 	if comment == "" {
 		return
 	}
 
 	fmt.Fprintf(w, "%s/// <summary>\n", indent)
-	escapedComment := escapeXMLComment(comment)
-	for _, line := range strings.Split(escapedComment, "\n") {
+	for _, line := range xmlDocLines(comment) {
 		fmt.Fprintf(w, "%s/// %s\n", indent, line)
 	}
 	fmt.Fprintf(w, "%s/// </summary>\n", indent)
@@ -295,12 +462,25 @@ func writeXMLComment(w io.Writer, comment string, indent string) {
 
 func writeClass(w io.Writer, t *CSModelType) {
 	writeXMLComment(w, t.Comment, "    ")
+	writeRemarks(w, t.MinApiVersion, t.Deprecated, "    ")
 
 	for _, a := range t.Attributes {
 		fmt.Fprintf(w, "    %s\n", a)
 	}
 
-	fmt.Fprintf(w, "    public class %s // (%s)\n", t.Name, t.SourceName)
+	if t.MinApiVersion != "" {
+		fmt.Fprintf(w, "    [MinimumApiVersion(\"%s\")]\n", t.MinApiVersion)
+	}
+
+	if t.Deprecated != "" {
+		fmt.Fprintf(w, "    %s\n", obsoleteAttribute(t.Deprecated))
+	}
+
+	if t.BaseType != "" {
+		fmt.Fprintf(w, "    public %s %s : %s // (%s)\n", t.keyword(), t.Name, t.BaseType, t.SourceName)
+	} else {
+		fmt.Fprintf(w, "    public %s %s // (%s)\n", t.keyword(), t.Name, t.SourceName)
+	}
 	fmt.Fprintln(w, "    {")
 
 	if len(t.Constructors) > 0 {
@@ -312,7 +492,7 @@ func writeClass(w io.Writer, t *CSModelType) {
 	}
 
 	if len(t.Properties) > 0 {
-		writeProperties(w, t.Properties)
+		writeProperties(w, t.Properties, t.setter())
 	}
 
 	fmt.Fprintln(w, "    }")
@@ -361,24 +541,33 @@ func writeConstructors(w io.Writer, typeName string, constructors []CSConstructo
 	}
 }
 
-func writeProperties(w io.Writer, properties []CSProperty) {
+func writeProperties(w io.Writer, properties []CSProperty, setter string) {
 	propertyCount := len(properties)
 	for i, p := range properties {
 		writeXMLComment(w, p.Comment, "        ")
+		writeRemarks(w, p.MinApiVersion, p.Deprecated, "        ")
 
 		for _, a := range p.Attributes {
 			fmt.Fprintf(w, "        %s\n", a)
 		}
 
-		if p.IsOpt {
-			fmt.Fprintf(w, "        public %s? %s { get; set; }", p.Type.Name, p.Name)
+		if p.MinApiVersion != "" {
+			fmt.Fprintf(w, "        [MinimumApiVersion(\"%s\")]\n", p.MinApiVersion)
+		}
+
+		if p.Deprecated != "" {
+			fmt.Fprintf(w, "        %s\n", obsoleteAttribute(p.Deprecated))
+		}
+
+		if p.IsNullable {
+			fmt.Fprintf(w, "        public %s? %s { get; %s; }", p.Type.Name, p.Name, setter)
 		} else {
-			fmt.Fprintf(w, "        public %s %s { get; set; }", p.Type.Name, p.Name)
+			fmt.Fprintf(w, "        public %s %s { get; %s; }", p.Type.Name, p.Name, setter)
 		}
 
 		if p.DefaultValue != "" {
 			fmt.Fprintf(w, " = %s;", p.DefaultValue)
-		} else if !p.IsOpt {
+		} else if !p.IsNullable {
 			if p.Type.Name == "string" {
 				fmt.Fprintf(w, " = string.Empty;")
 			} else {
@@ -394,6 +583,15 @@ func writeProperties(w io.Writer, properties []CSProperty) {
 	}
 }
 
+// escapeCSString escapes text for a regular C# string literal.
+func escapeCSString(text string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		"\"", "\\\"",
+	)
+	return replacer.Replace(text)
+}
+
 func escapeXMLComment(text string) string {
 	replacer := strings.NewReplacer(
 		"&", "&amp;",
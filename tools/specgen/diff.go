@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// diffContext is the number of unchanged lines printed around each change.
+const diffContext = 3
+
+type diffOp struct {
+	Kind byte // ' ', '-' or '+'
+	Line []byte
+}
+
+// splitLines splits b after each newline. The last line has no newline if b does not end with one.
+func splitLines(b []byte) [][]byte {
+	var lines [][]byte
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			lines = append(lines, b)
+			break
+		}
+
+		lines = append(lines, b[:i+1])
+		b = b[i+1:]
+	}
+
+	return lines
+}
+
+// diffLines returns the edit script turning a into b, using the longest common
+// subsequence of the lines between the common prefix and suffix.
+func diffLines(a, b [][]byte) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && bytes.Equal(a[prefix], b[prefix]) {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && bytes.Equal(a[len(a)-1-suffix], b[len(b)-1-suffix]) {
+		suffix++
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of ma[i:] and mb[j:].
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if bytes.Equal(ma[i], mb[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, l := range a[:prefix] {
+		ops = append(ops, diffOp{' ', l})
+	}
+
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case i < len(ma) && j < len(mb) && bytes.Equal(ma[i], mb[j]):
+			ops = append(ops, diffOp{' ', ma[i]})
+			i++
+			j++
+		case i < len(ma) && (j == len(mb) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', ma[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', mb[j]})
+			j++
+		}
+	}
+
+	for _, l := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', l})
+	}
+
+	return ops
+}
+
+// writeUnifiedDiff writes the unified diff between a and b to w. It writes nothing if they are equal.
+func writeUnifiedDiff(w io.Writer, aName, bName string, a, b []byte) {
+	if bytes.Equal(a, b) {
+		return
+	}
+
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	fmt.Fprintf(w, "--- %s\n", aName)
+	fmt.Fprintf(w, "+++ %s\n", bName)
+
+	for start := 0; start < len(ops); {
+		// Find the next change and extend the hunk until more than twice the
+		// context of unchanged lines are between two changes.
+		first := start
+		for first < len(ops) && ops[first].Kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+
+		last := first
+		for k := first; k < len(ops) && k-last <= 2*diffContext+1; k++ {
+			if ops[k].Kind != ' ' {
+				last = k
+			}
+		}
+
+		from, to := max(first-diffContext, start), min(last+diffContext+1, len(ops))
+
+		aLine, bLine := 1, 1
+		for _, op := range ops[:from] {
+			if op.Kind != '+' {
+				aLine++
+			}
+			if op.Kind != '-' {
+				bLine++
+			}
+		}
+
+		aCount, bCount := 0, 0
+		for _, op := range ops[from:to] {
+			if op.Kind != '+' {
+				aCount++
+			}
+			if op.Kind != '-' {
+				bCount++
+			}
+		}
+
+		// An empty range refers to the line before it.
+		if aCount == 0 {
+			aLine--
+		}
+		if bCount == 0 {
+			bLine--
+		}
+
+		fmt.Fprintf(w, "@@ -%d,%d +%d,%d @@\n", aLine, aCount, bLine, bCount)
+		for _, op := range ops[from:to] {
+			fmt.Fprintf(w, "%c%s", op.Kind, op.Line)
+			if !bytes.HasSuffix(op.Line, []byte("\n")) {
+				fmt.Fprint(w, "\n\\ No newline at end of file\n")
+			}
+		}
+
+		start = to
+	}
+}
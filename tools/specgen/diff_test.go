@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteUnifiedDiff(t *testing.T) {
+	// lines joins the numbered lines from to to, each with a newline.
+	lines := func(from, to int) string {
+		var b strings.Builder
+		for i := from; i <= to; i++ {
+			b.WriteString(strings.Repeat("x", i) + "\n")
+		}
+
+		return b.String()
+	}
+
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{
+			name: "equal",
+			a:    "a\nb\n",
+			b:    "a\nb\n",
+			want: "",
+		},
+		{
+			name: "changed line",
+			a:    "a\nb\nc\n",
+			b:    "a\nB\nc\n",
+			want: "--- a\n+++ b\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		},
+		{
+			name: "new file",
+			a:    "",
+			b:    "a\nb\n",
+			want: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "deleted file",
+			a:    "a\n",
+			b:    "",
+			want: "--- a\n+++ b\n@@ -1,1 +0,0 @@\n-a\n",
+		},
+		{
+			name: "no newline at end of file",
+			a:    "a\nb",
+			b:    "a\nb\n",
+			want: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+		},
+		{
+			name: "separate hunks",
+			a:    "a\n" + lines(1, 10) + "b\n",
+			b:    "A\n" + lines(1, 10) + "B\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,4 +1,4 @@\n-a\n+A\n x\n xx\n xxx\n" +
+				"@@ -9,4 +9,4 @@\n xxxxxxxx\n xxxxxxxxx\n xxxxxxxxxx\n-b\n+B\n",
+		},
+		{
+			name: "merged hunks",
+			a:    "a\n" + lines(1, 6) + "b\n",
+			b:    "A\n" + lines(1, 6) + "B\n",
+			want: "--- a\n+++ b\n@@ -1,8 +1,8 @@\n-a\n+A\n x\n xx\n xxx\n xxxx\n xxxxx\n xxxxxx\n-b\n+B\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeUnifiedDiff(&buf, "a", "b", []byte(tt.a), []byte(tt.b))
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writeUnifiedDiff() wrote\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"go/doc/comment"
+	"go/token"
+	"strings"
+)
+
+// goPackageNames maps the import path of the moby packages whose comments were
+// extracted to their package name, which the reflected type keys start with.
+var goPackageNames = map[string]string{}
+
+// goDocBaseURL is where the doc links that are not generated as C# types point to.
+const goDocBaseURL = "https://pkg.go.dev"
+
+// lookupGoPackage resolves the package name of a doc link, e.g. container in
+// [container.Config], to the import path of the moby package.
+func lookupGoPackage(name string) (string, bool) {
+	importPath := ""
+	for p, n := range goPackageNames {
+		if n != name {
+			continue
+		}
+
+		// A name several packages have cannot be resolved.
+		if importPath != "" {
+			return "", false
+		}
+
+		importPath = p
+	}
+
+	return importPath, importPath != ""
+}
+
+// qualifyDocLinks prefixes the doc links of a comment of the package
+// importPath to its own symbols, e.g. [Config], with the package name, so
+// they can be resolved without knowing which package the comment is from.
+func qualifyDocLinks(importPath string, text string) string {
+	name, ok := goPackageNames[importPath]
+	if !ok || !strings.Contains(text, "[") {
+		return text
+	}
+
+	p := comment.Parser{
+		LookupPackage: lookupGoPackage,
+		// Only the exported symbols of the package can be linked to.
+		LookupSym: func(recv, name string) bool {
+			return (recv == "" || token.IsExported(recv)) && token.IsExported(name)
+		},
+	}
+
+	doc := p.Parse(text)
+
+	qualified := false
+	forEachText(doc.Content, func(t comment.Text) {
+		if l, ok := t.(*comment.DocLink); ok && l.ImportPath == "" {
+			l.Text = []comment.Text{comment.Plain(name + "." + docLinkName(l))}
+			qualified = true
+		}
+	})
+
+	if !qualified {
+		return text
+	}
+
+	var printer comment.Printer
+	return strings.TrimSpace(string(printer.Comment(doc)))
+}
+
+// forEachText calls f for every inline text of the blocks.
+func forEachText(blocks []comment.Block, f func(comment.Text)) {
+	for _, b := range blocks {
+		var texts []comment.Text
+		switch b := b.(type) {
+		case *comment.Paragraph:
+			texts = b.Text
+		case *comment.Heading:
+			texts = b.Text
+		case *comment.List:
+			for _, item := range b.Items {
+				forEachText(item.Content, f)
+			}
+		}
+
+		for _, t := range texts {
+			f(t)
+		}
+	}
+}
+
+// docLinkName returns the symbol a doc link refers to, e.g. Config.Image.
+func docLinkName(l *comment.DocLink) string {
+	if l.Recv != "" {
+		return l.Recv + "." + l.Name
+	}
+
+	return l.Name
+}
+
+// xmlDocLines translates a Go doc comment to the lines of a C# XML
+// documentation comment. A single paragraph is returned as is, otherwise
+// paragraphs, code blocks and lists are wrapped in <para>, <code> and <list>.
+func xmlDocLines(text string) []string {
+	p := comment.Parser{LookupPackage: lookupGoPackage}
+	doc := p.Parse(text)
+
+	if len(doc.Content) == 1 {
+		if para, ok := doc.Content[0].(*comment.Paragraph); ok {
+			return strings.Split(xmlDocText(para.Text), "\n")
+		}
+	}
+
+	var lines []string
+	for _, b := range doc.Content {
+		switch b := b.(type) {
+		case *comment.Paragraph:
+			lines = append(lines, "<para>")
+			lines = append(lines, strings.Split(xmlDocText(b.Text), "\n")...)
+			lines = append(lines, "</para>")
+		case *comment.Heading:
+			lines = append(lines, "<para><b>"+xmlDocText(b.Text)+"</b></para>")
+		case *comment.Code:
+			lines = append(lines, "<code>")
+			lines = append(lines, strings.Split(escapeXMLComment(strings.TrimSuffix(b.Text, "\n")), "\n")...)
+			lines = append(lines, "</code>")
+		case *comment.List:
+			listType := "bullet"
+			if len(b.Items) > 0 && b.Items[0].Number != "" {
+				listType = "number"
+			}
+
+			lines = append(lines, "<list type=\""+listType+"\">")
+			for _, item := range b.Items {
+				var texts []string
+				for _, c := range item.Content {
+					if para, ok := c.(*comment.Paragraph); ok {
+						texts = append(texts, xmlDocText(para.Text))
+					}
+				}
+
+				description := "<item><description>" + strings.Join(texts, "\n") + "</description></item>"
+				lines = append(lines, strings.Split(description, "\n")...)
+			}
+			lines = append(lines, "</list>")
+		}
+	}
+
+	return lines
+}
+
+// xmlDocText translates the inline text of a Go doc comment, with links to
+// URLs as <see href> and doc links to generated C# types as <see cref>.
+func xmlDocText(texts []comment.Text) string {
+	var b strings.Builder
+	for _, t := range texts {
+		switch t := t.(type) {
+		case comment.Plain:
+			b.WriteString(escapeXMLComment(string(t)))
+		case comment.Italic:
+			b.WriteString("<i>" + escapeXMLComment(string(t)) + "</i>")
+		case *comment.Link:
+			if t.Auto {
+				b.WriteString("<see href=\"" + escapeXMLComment(t.URL) + "\"/>")
+			} else {
+				b.WriteString("<see href=\"" + escapeXMLComment(t.URL) + "\">" + xmlDocText(t.Text) + "</see>")
+			}
+		case *comment.DocLink:
+			if cref := docLinkCref(t); cref != "" {
+				b.WriteString("<see cref=\"" + cref + "\"/>")
+			} else {
+				b.WriteString("<see href=\"" + escapeXMLComment(t.DefaultURL(goDocBaseURL)) + "\">" + xmlDocText(t.Text) + "</see>")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// docLinkCref returns the generated C# model, enum or property a doc link to
+// a moby type or struct field refers to, empty if it is not generated.
+func docLinkCref(l *comment.DocLink) string {
+	pkg, ok := goPackageNames[l.ImportPath]
+	if !ok {
+		return ""
+	}
+
+	if l.Recv == "" {
+		if m, ok := reflectedTypes[pkg+"."+l.Name]; ok {
+			return m.Name
+		}
+
+		if e, ok := reflectedEnums[pkg+"."+l.Name]; ok {
+			return e.Name
+		}
+
+		return ""
+	}
+
+	// [Type.Field] is meant for methods, but moby uses it for fields as well.
+	if m, ok := reflectedTypes[pkg+"."+l.Recv]; ok {
+		for _, p := range m.Properties {
+			if p.Name == l.Name {
+				return m.Name + "." + p.Name
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,1143 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/common"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+)
+
+// validContainerName matches the container names moby accepts.
+var validContainerName = regexp.MustCompile(`^/?[a-zA-Z0-9][a-zA-Z0-9_.-]+$`)
+
+// fakeSignals are the exit codes of the containers stopped or killed with a
+// signal, 128 plus the number of the signal.
+var fakeSignals = map[string]int{
+	"SIGHUP": 1, "SIGINT": 2, "SIGQUIT": 3, "SIGKILL": 9, "SIGUSR1": 10, "SIGUSR2": 12, "SIGTERM": 15,
+}
+
+// fakeContainer is a container of the fake daemon, kept in the shape moby
+// inspects it in.
+type fakeContainer struct {
+	inspect container.InspectResponse
+	created time.Time
+	// exit is closed and replaced when the container stops, removed is closed
+	// when it is removed. Both wake up the waits.
+	exit    chan struct{}
+	removed chan struct{}
+}
+
+// fakeExec is an exec instance of the fake daemon. The commands run to
+// completion when they are started, echo writes its arguments, false exits
+// with 1 and everything else exits with 0 without output.
+type fakeExec struct {
+	inspect container.ExecInspectResponse
+	cmd     []string
+}
+
+func (d *fakeDaemon) addContainerRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /containers/create", d.handle(d.createContainer))
+	mux.HandleFunc("GET /containers/json", d.handle(d.listContainers))
+	mux.HandleFunc("POST /containers/prune", d.handle(d.pruneContainers))
+	mux.HandleFunc("GET /containers/{id}/json", d.handle(d.inspectContainer))
+	mux.HandleFunc("POST /containers/{id}/start", d.handle(d.startContainerRoute))
+	mux.HandleFunc("POST /containers/{id}/stop", d.handle(d.stopContainerRoute))
+	mux.HandleFunc("POST /containers/{id}/restart", d.handle(d.restartContainer))
+	mux.HandleFunc("POST /containers/{id}/kill", d.handle(d.killContainer))
+	mux.HandleFunc("POST /containers/{id}/pause", d.handle(d.pauseContainer))
+	mux.HandleFunc("POST /containers/{id}/unpause", d.handle(d.unpauseContainer))
+	mux.HandleFunc("POST /containers/{id}/wait", d.handle(d.waitContainer))
+	mux.HandleFunc("POST /containers/{id}/rename", d.handle(d.renameContainer))
+	mux.HandleFunc("POST /containers/{id}/resize", d.handle(d.resizeContainer))
+	mux.HandleFunc("GET /containers/{id}/logs", d.handle(d.containerLogs))
+	mux.HandleFunc("DELETE /containers/{id}", d.handle(d.removeContainerRoute))
+	mux.HandleFunc("POST /containers/{id}/exec", d.handle(d.createExec))
+	mux.HandleFunc("POST /exec/{id}/start", d.handle(d.startExec))
+	mux.HandleFunc("POST /exec/{id}/resize", d.handle(d.resizeExec))
+	mux.HandleFunc("GET /exec/{id}/json", d.handle(d.inspectExec))
+}
+
+// container returns the container with an ID, name or unique ID prefix.
+func (d *fakeDaemon) container(ref string) (*fakeContainer, error) {
+	if c, ok := d.containers[ref]; ok {
+		return c, nil
+	}
+
+	if c := d.containerByName(ref); c != nil {
+		return c, nil
+	}
+
+	var found *fakeContainer
+	for id, c := range d.containers {
+		if ref != "" && strings.HasPrefix(id, ref) {
+			if found != nil {
+				return nil, errorf(http.StatusBadRequest, "multiple IDs found with provided prefix: %s", ref)
+			}
+			found = c
+		}
+	}
+
+	if found == nil {
+		return nil, errorf(http.StatusNotFound, "No such container: %s", ref)
+	}
+
+	return found, nil
+}
+
+// containerByName returns the container with a name, with or without the leading slash.
+func (d *fakeDaemon) containerByName(name string) *fakeContainer {
+	name = "/" + strings.TrimPrefix(name, "/")
+	for _, c := range d.containers {
+		if c.inspect.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+func (d *fakeDaemon) createContainer(w http.ResponseWriter, r *http.Request) error {
+	var req container.CreateRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	if req.Config == nil {
+		return errorf(http.StatusBadRequest, "config cannot be empty in order to create a container")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	id := newFakeID()
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		name = "fake_" + shortID(id)
+	}
+
+	if !validContainerName.MatchString(name) {
+		return errorf(http.StatusBadRequest, "Invalid container name (%s), only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", name)
+	}
+
+	if other := d.containerByName(name); other != nil {
+		return errorf(http.StatusConflict, "Conflict. The container name \"/%s\" is already in use by container \"%s\". You have to remove (or rename) that container to be able to reuse that name.", strings.TrimPrefix(name, "/"), other.inspect.ID)
+	}
+
+	img, err := d.image(req.Config.Image)
+	if err != nil {
+		return err
+	}
+
+	hostConfig := req.HostConfig
+	if hostConfig == nil {
+		hostConfig = &container.HostConfig{}
+	}
+
+	if hostConfig.NetworkMode == "" || hostConfig.NetworkMode == network.NetworkDefault {
+		hostConfig.NetworkMode = network.NetworkBridge
+	}
+
+	// The networks are looked up first, so a missing one creates nothing.
+	networks := map[*fakeNetwork]*network.EndpointSettings{}
+	if !hostConfig.NetworkMode.IsContainer() {
+		n, err := d.network(string(hostConfig.NetworkMode))
+		if err != nil {
+			return err
+		}
+		networks[n] = nil
+	}
+
+	if req.NetworkingConfig != nil {
+		for ref, settings := range req.NetworkingConfig.EndpointsConfig {
+			n, err := d.network(ref)
+			if err != nil {
+				return err
+			}
+			networks[n] = settings
+		}
+	}
+
+	// The container inherits the command and environment of its image, like with moby.
+	if len(req.Config.Entrypoint) == 0 && len(req.Config.Cmd) == 0 {
+		req.Config.Entrypoint, req.Config.Cmd = img.config.Entrypoint, img.config.Cmd
+	}
+
+	req.Config.Env = append(slices.Clone(img.config.Env), req.Config.Env...)
+
+	now := time.Now().UTC()
+	cmd := slices.Concat(req.Config.Entrypoint, req.Config.Cmd)
+
+	c := &fakeContainer{
+		created: now,
+		exit:    make(chan struct{}),
+		removed: make(chan struct{}),
+		inspect: container.InspectResponse{
+			ID:      id,
+			Created: now.Format(time.RFC3339Nano),
+			Args:    []string{},
+			State: &container.State{
+				Status:     container.StateCreated,
+				StartedAt:  goZeroTime,
+				FinishedAt: goZeroTime,
+			},
+			Image:          img.id,
+			ResolvConfPath: "/var/lib/docker/containers/" + id + "/resolv.conf",
+			HostnamePath:   "/var/lib/docker/containers/" + id + "/hostname",
+			HostsPath:      "/var/lib/docker/containers/" + id + "/hosts",
+			LogPath:        "/var/lib/docker/containers/" + id + "/" + id + "-json.log",
+			Name:           "/" + strings.TrimPrefix(name, "/"),
+			Driver:         "overlay2",
+			Platform:       "linux",
+			HostConfig:     hostConfig,
+			Config:         req.Config,
+			Mounts:         []container.MountPoint{},
+			NetworkSettings: &container.NetworkSettings{
+				Ports:    network.PortMap{},
+				Networks: map[string]*network.EndpointSettings{},
+			},
+		},
+	}
+
+	if len(cmd) > 0 {
+		c.inspect.Path, c.inspect.Args = cmd[0], cmd[1:]
+	}
+
+	if c.inspect.Config.Hostname == "" {
+		c.inspect.Config.Hostname = shortID(id)
+	}
+
+	if c.inspect.Config.Labels == nil {
+		c.inspect.Config.Labels = map[string]string{}
+	}
+
+	if err := d.mountVolumes(c); err != nil {
+		return err
+	}
+
+	for n, settings := range networks {
+		d.connectContainer(c, n, settings)
+	}
+
+	d.containers[id] = c
+
+	return writeJSON(w, http.StatusCreated, container.CreateResponse{ID: id, Warnings: []string{}})
+}
+
+// mountVolumes creates the mount points of the binds, mounts and volumes of
+// a container that is created, and the volumes they name that do not exist.
+func (d *fakeDaemon) mountVolumes(c *fakeContainer) error {
+	var mounts []container.MountPoint
+	for _, bind := range c.inspect.HostConfig.Binds {
+		parts := strings.Split(bind, ":")
+		if len(parts) < 2 {
+			return errorf(http.StatusBadRequest, "invalid volume specification: '%s'", bind)
+		}
+
+		mode := ""
+		if len(parts) > 2 {
+			mode = parts[2]
+		}
+
+		mp := container.MountPoint{Source: parts[0], Destination: parts[1], Mode: mode, RW: !strings.Contains(mode, "ro")}
+		if strings.HasPrefix(parts[0], "/") {
+			mp.Type = mount.TypeBind
+			mp.Propagation = mount.PropagationRPrivate
+		} else {
+			v := d.createVolume(parts[0], "local", nil, nil)
+			mp.Type, mp.Name, mp.Source, mp.Driver = mount.TypeVolume, v.volume.Name, v.volume.Mountpoint, v.volume.Driver
+		}
+
+		mounts = append(mounts, mp)
+	}
+
+	for _, m := range c.inspect.HostConfig.Mounts {
+		mp := container.MountPoint{Type: m.Type, Source: m.Source, Destination: m.Target, RW: !m.ReadOnly}
+		if m.Type == mount.TypeVolume {
+			var labels map[string]string
+			if m.VolumeOptions != nil {
+				labels = m.VolumeOptions.Labels
+			}
+
+			v := d.createVolume(m.Source, "local", nil, labels)
+			mp.Name, mp.Source, mp.Driver = v.volume.Name, v.volume.Mountpoint, v.volume.Driver
+		}
+
+		mounts = append(mounts, mp)
+	}
+
+	for _, dst := range sortedKeys(c.inspect.Config.Volumes) {
+		if slices.ContainsFunc(mounts, func(mp container.MountPoint) bool { return mp.Destination == dst }) {
+			continue
+		}
+
+		v := d.createVolume("", "local", nil, nil)
+		mounts = append(mounts, container.MountPoint{Type: mount.TypeVolume, Name: v.volume.Name, Source: v.volume.Mountpoint, Destination: dst, Driver: v.volume.Driver, RW: true})
+	}
+
+	if mounts != nil {
+		c.inspect.Mounts = mounts
+	}
+
+	return nil
+}
+
+func (d *fakeDaemon) listContainers(w http.ResponseWriter, r *http.Request) error {
+	q := r.URL.Query()
+	filters, err := parseFilters(q)
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("id", "name", "label", "status", "ancestor", "network", "volume", "exited"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	all := queryBool(q, "all") || len(filters["status"]) > 0 || len(filters["exited"]) > 0
+
+	var matched []*fakeContainer
+	for _, c := range d.containers {
+		if (all || c.inspect.State.Running) && d.matchContainer(c, filters) {
+			matched = append(matched, c)
+		}
+	}
+
+	// Newest first, like moby.
+	slices.SortFunc(matched, func(a, b *fakeContainer) int {
+		return b.created.Compare(a.created)
+	})
+
+	if limit, err := strconv.Atoi(q.Get("limit")); err == nil && limit > 0 && limit < len(matched) {
+		matched = matched[:limit]
+	}
+
+	summaries := []container.Summary{}
+	for _, c := range matched {
+		summaries = append(summaries, d.containerSummary(c, queryBool(q, "size")))
+	}
+
+	return writeJSON(w, http.StatusOK, summaries)
+}
+
+// matchContainer reports whether a container matches the filters of a list.
+func (d *fakeDaemon) matchContainer(c *fakeContainer, filters fakeFilters) bool {
+	return filters.match("id", func(v string) bool { return strings.HasPrefix(c.inspect.ID, v) }) &&
+		filters.match("name", func(v string) bool { return strings.Contains(c.inspect.Name, strings.TrimPrefix(v, "/")) }) &&
+		filters.match("status", func(v string) bool { return string(c.inspect.State.Status) == v }) &&
+		filters.match("exited", func(v string) bool {
+			return c.inspect.State.Status == container.StateExited && strconv.Itoa(c.inspect.State.ExitCode) == v
+		}) &&
+		filters.match("ancestor", func(v string) bool {
+			img, err := d.image(v)
+			return err == nil && img.id == c.inspect.Image
+		}) &&
+		filters.match("network", func(v string) bool {
+			return slices.ContainsFunc(sortedKeys(c.inspect.NetworkSettings.Networks), func(name string) bool {
+				n, err := d.network(name)
+				return name == v || err == nil && strings.HasPrefix(n.inspect.ID, v)
+			})
+		}) &&
+		filters.match("volume", func(v string) bool {
+			return slices.ContainsFunc(c.inspect.Mounts, func(mp container.MountPoint) bool { return mp.Name == v || mp.Destination == v })
+		}) &&
+		filters.matchLabels(c.inspect.Config.Labels)
+}
+
+// containerSummary returns a container as it is listed.
+func (d *fakeDaemon) containerSummary(c *fakeContainer, size bool) container.Summary {
+	s := container.Summary{
+		ID:      c.inspect.ID,
+		Names:   []string{c.inspect.Name},
+		Image:   c.inspect.Config.Image,
+		ImageID: c.inspect.Image,
+		Command: strings.Join(append([]string{c.inspect.Path}, c.inspect.Args...), " "),
+		Created: c.created.Unix(),
+		Ports:   []container.PortSummary{},
+		Labels:  c.inspect.Config.Labels,
+		State:   c.inspect.State.Status,
+		Status:  containerStatus(c.inspect.State),
+		NetworkSettings: &container.NetworkSettingsSummary{
+			Networks: c.inspect.NetworkSettings.Networks,
+		},
+		Mounts: c.inspect.Mounts,
+	}
+
+	s.HostConfig.NetworkMode = string(c.inspect.HostConfig.NetworkMode)
+
+	for _, port := range sortedPorts(c.inspect.NetworkSettings.Ports) {
+		for _, binding := range c.inspect.NetworkSettings.Ports[port] {
+			public, _ := strconv.ParseUint(binding.HostPort, 10, 16)
+			s.Ports = append(s.Ports, container.PortSummary{IP: binding.HostIP, PrivatePort: port.Num(), PublicPort: uint16(public), Type: string(port.Proto())})
+		}
+	}
+
+	if size {
+		s.SizeRw = 0
+		if img, ok := d.images[c.inspect.Image]; ok {
+			s.SizeRootFs = img.size
+		}
+	}
+
+	return s
+}
+
+// sortedPorts returns the ports of a port map in their order.
+func sortedPorts(ports network.PortMap) []network.Port {
+	keys := make([]network.Port, 0, len(ports))
+	for port := range ports {
+		keys = append(keys, port)
+	}
+
+	slices.SortFunc(keys, func(a, b network.Port) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return keys
+}
+
+// containerStatus returns the status docker ps prints for a container, e.g. Up 5 minutes.
+func containerStatus(state *container.State) string {
+	switch state.Status {
+	case container.StateRunning, container.StatePaused:
+		started, _ := time.Parse(time.RFC3339Nano, state.StartedAt)
+		status := "Up " + humanDuration(time.Since(started))
+		if state.Paused {
+			status += " (Paused)"
+		}
+		return status
+	case container.StateExited:
+		finished, _ := time.Parse(time.RFC3339Nano, state.FinishedAt)
+		return fmt.Sprintf("Exited (%d) %s ago", state.ExitCode, humanDuration(time.Since(finished)))
+	case container.StateCreated:
+		return "Created"
+	default:
+		return strings.ToUpper(string(state.Status[:1])) + string(state.Status[1:])
+	}
+}
+
+// humanDuration returns a duration the way docker prints it, e.g. About a minute.
+func humanDuration(d time.Duration) string {
+	switch seconds := int(d.Seconds()); {
+	case seconds < 1:
+		return "Less than a second"
+	case seconds == 1:
+		return "1 second"
+	case seconds < 60:
+		return fmt.Sprintf("%d seconds", seconds)
+	}
+
+	switch minutes := int(d.Minutes()); {
+	case minutes == 1:
+		return "About a minute"
+	case minutes < 60:
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+
+	switch hours := int(d.Round(time.Hour).Hours()); {
+	case hours == 1:
+		return "About an hour"
+	case hours < 48:
+		return fmt.Sprintf("%d hours", hours)
+	default:
+		return fmt.Sprintf("%d days", hours/24)
+	}
+}
+
+func (d *fakeDaemon) inspectContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, c.inspect)
+}
+
+func (d *fakeDaemon) startContainerRoute(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	if c.inspect.State.Running {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	d.startContainer(c)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// startContainer moves a container to the running state, with an endpoint
+// and addresses in each of its networks and the published ports bound.
+func (d *fakeDaemon) startContainer(c *fakeContainer) {
+	d.nextPid++
+
+	state := c.inspect.State
+	state.Status = container.StateRunning
+	state.Running = true
+	state.Paused = false
+	state.Restarting = false
+	state.OOMKilled = false
+	state.Dead = false
+	state.Pid = d.nextPid
+	state.ExitCode = 0
+	state.Error = ""
+	state.StartedAt = time.Now().UTC().Format(time.RFC3339Nano)
+
+	c.inspect.NetworkSettings.SandboxID = newFakeID()
+	c.inspect.NetworkSettings.SandboxKey = "/var/run/docker/netns/" + shortID(c.inspect.NetworkSettings.SandboxID)
+
+	for _, name := range sortedKeys(c.inspect.NetworkSettings.Networks) {
+		if n, ok := d.networks[c.inspect.NetworkSettings.Networks[name].NetworkID]; ok {
+			d.attachEndpoint(c, n)
+		}
+	}
+
+	ports := network.PortMap{}
+	for port, bindings := range c.inspect.HostConfig.PortBindings {
+		for _, binding := range bindings {
+			if binding.HostPort == "" {
+				binding.HostPort = strconv.Itoa(d.nextHostPort())
+			}
+			ports[port] = append(ports[port], binding)
+		}
+	}
+
+	c.inspect.NetworkSettings.Ports = ports
+}
+
+// nextHostPort returns an ephemeral port for a published port without host port.
+func (d *fakeDaemon) nextHostPort() int {
+	port := 32768
+	for _, c := range d.containers {
+		for _, bindings := range c.inspect.NetworkSettings.Ports {
+			for _, binding := range bindings {
+				if p, err := strconv.Atoi(binding.HostPort); err == nil && p >= port {
+					port = p + 1
+				}
+			}
+		}
+	}
+
+	return port
+}
+
+// stopContainer moves a running container to the exited state and removes it
+// if it was created with AutoRemove.
+func (d *fakeDaemon) stopContainer(c *fakeContainer, exitCode int) {
+	state := c.inspect.State
+	state.Status = container.StateExited
+	state.Running = false
+	state.Paused = false
+	state.Pid = 0
+	state.ExitCode = exitCode
+	state.FinishedAt = time.Now().UTC().Format(time.RFC3339Nano)
+
+	c.inspect.NetworkSettings.SandboxID = ""
+	c.inspect.NetworkSettings.SandboxKey = ""
+	c.inspect.NetworkSettings.Ports = network.PortMap{}
+
+	for _, name := range sortedKeys(c.inspect.NetworkSettings.Networks) {
+		if n, ok := d.networks[c.inspect.NetworkSettings.Networks[name].NetworkID]; ok {
+			d.detachEndpoint(c, n)
+		}
+	}
+
+	close(c.exit)
+	c.exit = make(chan struct{})
+
+	if c.inspect.HostConfig.AutoRemove {
+		d.removeContainer(c, true)
+	}
+}
+
+// stopSignal returns the exit code of a container stopped with a signal, the
+// one of the request, else the stop signal of the container, else SIGTERM.
+func stopSignal(c *fakeContainer, signal string) (int, error) {
+	if signal == "" {
+		signal = c.inspect.Config.StopSignal
+	}
+
+	if signal == "" {
+		signal = "SIGTERM"
+	}
+
+	if n, err := strconv.Atoi(signal); err == nil && n > 0 && n < 65 {
+		return 128 + n, nil
+	}
+
+	name := strings.ToUpper(signal)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+
+	n, ok := fakeSignals[name]
+	if !ok {
+		return 0, errorf(http.StatusBadRequest, "Invalid signal: %s", signal)
+	}
+
+	return 128 + n, nil
+}
+
+func (d *fakeDaemon) stopContainerRoute(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	exitCode, err := stopSignal(c, r.URL.Query().Get("signal"))
+	if err != nil {
+		return err
+	}
+
+	if !c.inspect.State.Running {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+
+	d.stopContainer(c, exitCode)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (d *fakeDaemon) restartContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	if c.inspect.State.Running {
+		exitCode, err := stopSignal(c, r.URL.Query().Get("signal"))
+		if err != nil {
+			return err
+		}
+
+		// A restart keeps the container, also with AutoRemove.
+		autoRemove := c.inspect.HostConfig.AutoRemove
+		c.inspect.HostConfig.AutoRemove = false
+		d.stopContainer(c, exitCode)
+		c.inspect.HostConfig.AutoRemove = autoRemove
+	}
+
+	d.startContainer(c)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (d *fakeDaemon) killContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	signal := r.URL.Query().Get("signal")
+	if signal == "" {
+		signal = "SIGKILL"
+	}
+
+	exitCode, err := stopSignal(c, signal)
+	if err != nil {
+		return err
+	}
+
+	if !c.inspect.State.Running {
+		return errorf(http.StatusConflict, "cannot kill container: %s: container %s is not running", r.PathValue("id"), c.inspect.ID)
+	}
+
+	d.stopContainer(c, exitCode)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (d *fakeDaemon) pauseContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !c.inspect.State.Running:
+		return errorf(http.StatusConflict, "container %s is not running", c.inspect.ID)
+	case c.inspect.State.Paused:
+		return errorf(http.StatusConflict, "container %s is already paused", c.inspect.ID)
+	}
+
+	c.inspect.State.Paused = true
+	c.inspect.State.Status = container.StatePaused
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (d *fakeDaemon) unpauseContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	if !c.inspect.State.Paused {
+		return errorf(http.StatusConflict, "container %s is not paused", c.inspect.ID)
+	}
+
+	c.inspect.State.Paused = false
+	c.inspect.State.Status = container.StateRunning
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (d *fakeDaemon) waitContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		d.mu.Unlock()
+		return err
+	}
+
+	var done chan struct{}
+	switch condition := r.URL.Query().Get("condition"); condition {
+	case "", string(container.WaitConditionNotRunning):
+		if c.inspect.State.Running {
+			done = c.exit
+		}
+	case string(container.WaitConditionNextExit):
+		done = c.exit
+	case string(container.WaitConditionRemoved):
+		done = c.removed
+	default:
+		d.mu.Unlock()
+		return errorf(http.StatusBadRequest, "invalid condition: %q", condition)
+	}
+	d.mu.Unlock()
+
+	// The headers are sent before the wait, so clients know the wait started.
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	if done != nil {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+			return nil
+		}
+	}
+
+	d.mu.Lock()
+	exitCode := c.inspect.State.ExitCode
+	d.mu.Unlock()
+
+	return json.NewEncoder(w).Encode(container.WaitResponse{StatusCode: int64(exitCode)})
+}
+
+func (d *fakeDaemon) renameContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimPrefix(r.URL.Query().Get("name"), "/")
+	if !validContainerName.MatchString(name) {
+		return errorf(http.StatusBadRequest, "Invalid container name (%s), only [a-zA-Z0-9][a-zA-Z0-9_.-] are allowed", name)
+	}
+
+	if other := d.containerByName(name); other != nil {
+		if other == c {
+			return errorf(http.StatusBadRequest, "Renaming a container with the same name as its current name")
+		}
+		return errorf(http.StatusConflict, "Conflict. The container name \"/%s\" is already in use by container \"%s\". You have to remove (or rename) that container to be able to reuse that name.", name, other.inspect.ID)
+	}
+
+	c.inspect.Name = "/" + name
+	for _, id := range sortedKeys(c.inspect.NetworkSettings.Networks) {
+		if n, ok := d.networks[c.inspect.NetworkSettings.Networks[id].NetworkID]; ok {
+			if endpoint, ok := n.inspect.Containers[c.inspect.ID]; ok {
+				endpoint.Name = name
+				n.inspect.Containers[c.inspect.ID] = endpoint
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (d *fakeDaemon) resizeContainer(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	if !c.inspect.State.Running {
+		return errorf(http.StatusConflict, "Container %s is not running", c.inspect.ID)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// containerLogs answers with an empty stream, the containers write no output.
+// With follow, the stream ends when the container stops.
+func (d *fakeDaemon) containerLogs(w http.ResponseWriter, r *http.Request) error {
+	q := r.URL.Query()
+	if !queryBool(q, "stdout") && !queryBool(q, "stderr") {
+		return errorf(http.StatusBadRequest, "Bad parameters: you must choose at least one stream")
+	}
+
+	d.mu.Lock()
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		d.mu.Unlock()
+		return err
+	}
+
+	var done chan struct{}
+	if queryBool(q, "follow") && c.inspect.State.Running {
+		done = c.exit
+	}
+	tty := c.inspect.Config.Tty
+	d.mu.Unlock()
+
+	w.Header().Set("Content-Type", streamContentType(tty))
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	if done != nil {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}
+
+	return nil
+}
+
+func (d *fakeDaemon) removeContainerRoute(w http.ResponseWriter, r *http.Request) error {
+	q := r.URL.Query()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	if queryBool(q, "link") {
+		return errorf(http.StatusBadRequest, "Conflict, cannot remove the default link name of the container")
+	}
+
+	if c.inspect.State.Running {
+		if !queryBool(q, "force") {
+			state := "running"
+			if c.inspect.State.Paused {
+				state = "paused"
+			}
+			return errorf(http.StatusConflict, "cannot remove container \"%s\": container is %s: stop the container before removing or force remove", c.inspect.Name, state)
+		}
+
+		// The container is removed below, not when it stops.
+		c.inspect.HostConfig.AutoRemove = false
+		d.stopContainer(c, 128+fakeSignals["SIGKILL"])
+	}
+
+	d.removeContainer(c, queryBool(q, "v"))
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// removeContainer removes a stopped container, its execs and its endpoints,
+// and with removeVolumes the anonymous volumes it mounts.
+func (d *fakeDaemon) removeContainer(c *fakeContainer, removeVolumes bool) {
+	delete(d.containers, c.inspect.ID)
+
+	for _, id := range c.inspect.ExecIDs {
+		delete(d.execs, id)
+	}
+
+	for _, name := range sortedKeys(c.inspect.NetworkSettings.Networks) {
+		if n, ok := d.networks[c.inspect.NetworkSettings.Networks[name].NetworkID]; ok {
+			d.detachEndpoint(c, n)
+		}
+	}
+
+	if removeVolumes {
+		for _, mp := range c.inspect.Mounts {
+			if v, ok := d.volumes[mp.Name]; ok && v.anonymous() && len(d.volumeUsers(mp.Name)) == 0 {
+				delete(d.volumes, mp.Name)
+			}
+		}
+	}
+
+	c.inspect.State.Status = container.StateRemoving
+	close(c.removed)
+}
+
+func (d *fakeDaemon) pruneContainers(w http.ResponseWriter, r *http.Request) error {
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("label", "until"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	until, err := parseUntil(filters)
+	if err != nil {
+		return err
+	}
+
+	report := container.PruneReport{}
+	for _, id := range sortedKeys(d.containers) {
+		c := d.containers[id]
+		if c.inspect.State.Running || !filters.matchLabels(c.inspect.Config.Labels) || c.created.After(until) {
+			continue
+		}
+
+		d.removeContainer(c, false)
+		report.ContainersDeleted = append(report.ContainersDeleted, id)
+	}
+
+	return writeJSON(w, http.StatusOK, report)
+}
+
+// parseUntil returns the until filter of a prune, a unix timestamp, an
+// RFC 3339 time or a duration before now, the distant future if it is not set.
+func parseUntil(filters fakeFilters) (time.Time, error) {
+	values := filters["until"]
+	if len(values) == 0 {
+		return time.Now().Add(24 * time.Hour), nil
+	}
+
+	value := values[0]
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Unix(0, int64(seconds*float64(time.Second))), nil
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+
+	return time.Time{}, errorf(http.StatusBadRequest, "invalid until filter: %s", value)
+}
+
+func (d *fakeDaemon) createExec(w http.ResponseWriter, r *http.Request) error {
+	var req container.ExecCreateRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	if len(req.Cmd) == 0 {
+		return errorf(http.StatusBadRequest, "No exec command specified")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c, err := d.container(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !c.inspect.State.Running:
+		return errorf(http.StatusConflict, "container %s is not running", c.inspect.ID)
+	case c.inspect.State.Paused:
+		return errorf(http.StatusConflict, "Container %s is paused, unpause the container before exec", c.inspect.ID)
+	}
+
+	privileged := req.Privileged
+	e := &fakeExec{
+		cmd: req.Cmd,
+		inspect: container.ExecInspectResponse{
+			ID: newFakeID(),
+			ProcessConfig: &container.ExecProcessConfig{
+				Tty:        req.Tty,
+				Entrypoint: req.Cmd[0],
+				Arguments:  req.Cmd[1:],
+				Privileged: &privileged,
+				User:       req.User,
+			},
+			OpenStdin:   req.AttachStdin,
+			OpenStderr:  req.AttachStderr,
+			OpenStdout:  req.AttachStdout,
+			ContainerID: c.inspect.ID,
+			DetachKeys:  []byte(req.DetachKeys),
+		},
+	}
+
+	if e.inspect.ProcessConfig.Arguments == nil {
+		e.inspect.ProcessConfig.Arguments = []string{}
+	}
+
+	d.execs[e.inspect.ID] = e
+	c.inspect.ExecIDs = append(c.inspect.ExecIDs, e.inspect.ID)
+
+	return writeJSON(w, http.StatusCreated, common.IDResponse{ID: e.inspect.ID})
+}
+
+// exec returns the exec instance with an ID.
+func (d *fakeDaemon) exec(id string) (*fakeExec, error) {
+	e, ok := d.execs[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "No such exec instance: %s", id)
+	}
+
+	return e, nil
+}
+
+// run runs the command of an exec and returns its output and exit code.
+func (e *fakeExec) run() ([]byte, int) {
+	switch e.cmd[0] {
+	case "echo":
+		return []byte(strings.Join(e.cmd[1:], " ") + "\n"), 0
+	case "false":
+		return nil, 1
+	default:
+		return nil, 0
+	}
+}
+
+func (d *fakeDaemon) startExec(w http.ResponseWriter, r *http.Request) error {
+	var req container.ExecStartRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	e, err := d.exec(r.PathValue("id"))
+	if err != nil {
+		d.mu.Unlock()
+		return err
+	}
+
+	if e.inspect.ExitCode != nil {
+		d.mu.Unlock()
+		return errorf(http.StatusConflict, "Error: Exec command %s has already run", e.inspect.ID)
+	}
+
+	c, err := d.container(e.inspect.ContainerID)
+	if err != nil || !c.inspect.State.Running {
+		d.mu.Unlock()
+		return errorf(http.StatusConflict, "container %s is not running", e.inspect.ContainerID)
+	}
+
+	output, exitCode := e.run()
+	e.inspect.ExitCode = &exitCode
+	e.inspect.Pid = c.inspect.State.Pid + len(c.inspect.ExecIDs)
+	tty := e.inspect.ProcessConfig.Tty
+	d.mu.Unlock()
+
+	if req.Detach {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	stream := fakeStreamWriter{w: w, tty: tty}
+
+	// Clients that attach upgrade the connection to a raw TCP stream.
+	if r.Header.Get("Upgrade") != "" {
+		hj, ok := w.(http.Hijacker)
+		if !ok {
+			return errorf(http.StatusInternalServerError, "the connection cannot be hijacked")
+		}
+
+		conn, buf, err := hj.Hijack()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		fmt.Fprintf(buf, "HTTP/1.1 101 UPGRADED\r\nContent-Type: %s\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n", streamContentType(tty))
+		stream.w = buf
+		if len(output) > 0 {
+			stream.writeFrame(1, output)
+		}
+		return buf.Flush()
+	}
+
+	w.Header().Set("Content-Type", streamContentType(tty))
+	w.WriteHeader(http.StatusOK)
+	if len(output) > 0 {
+		bw := bufio.NewWriter(w)
+		stream.w = bw
+		stream.writeFrame(1, output)
+		return bw.Flush()
+	}
+
+	return nil
+}
+
+func (d *fakeDaemon) resizeExec(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, err := d.exec(r.PathValue("id")); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (d *fakeDaemon) inspectExec(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	e, err := d.exec(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, e.inspect)
+}
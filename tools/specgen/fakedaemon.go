@@ -0,0 +1,470 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"path"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/moby/moby/api/types/common"
+	"github.com/moby/moby/api/types/system"
+	"github.com/moby/moby/client"
+)
+
+// fakeDaemonVersion is the version the fake daemon reports for itself.
+const fakeDaemonVersion = "0.0.0-specgen"
+
+// goZeroTime is how moby writes an unset time.Time, e.g. State.FinishedAt of a
+// container that never exited.
+const goZeroTime = "0001-01-01T00:00:00Z"
+
+// fakeDaemon serves an in-memory Docker Engine API with the containers,
+// images, networks, volumes and execs it was asked to create. Containers run
+// no processes, they only go through the state transitions of real ones.
+type fakeDaemon struct {
+	mu         sync.Mutex
+	id         string
+	apiVersion string
+	started    time.Time
+
+	containers map[string]*fakeContainer
+	images     map[string]*fakeImage
+	networks   map[string]*fakeNetwork
+	volumes    map[string]*fakeVolume
+	execs      map[string]*fakeExec
+
+	// nextPid and nextSubnet are the pid of the next started container and the
+	// second octet of the subnet of the next created network.
+	nextPid    int
+	nextSubnet int
+}
+
+// fakeError is an error the fake daemon answers a request with, written as
+// moby's ErrorResponse.
+type fakeError struct {
+	status  int
+	message string
+}
+
+func (e *fakeError) Error() string {
+	return e.message
+}
+
+// errorf returns a fakeError with the status code and message.
+func errorf(status int, format string, args ...any) error {
+	return &fakeError{status: status, message: fmt.Sprintf(format, args...)}
+}
+
+// newFakeDaemon returns a fake daemon that supports the API versions up to
+// apiVersion, with the predefined networks of a fresh Docker installation.
+func newFakeDaemon(apiVersion string) *fakeDaemon {
+	d := &fakeDaemon{
+		id:         newFakeID(),
+		apiVersion: apiVersion,
+		started:    time.Now().UTC(),
+		containers: map[string]*fakeContainer{},
+		images:     map[string]*fakeImage{},
+		networks:   map[string]*fakeNetwork{},
+		volumes:    map[string]*fakeVolume{},
+		execs:      map[string]*fakeExec{},
+		nextPid:    1000,
+		nextSubnet: 18,
+	}
+
+	d.addPredefinedNetworks()
+	return d
+}
+
+// handler returns the HTTP handler of the Docker Engine API routes the fake
+// daemon implements. The paths are served with and without API version.
+func (d *fakeDaemon) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /_ping", d.ping)
+	mux.HandleFunc("HEAD /_ping", d.ping)
+	mux.HandleFunc("GET /version", d.handle(d.version))
+	mux.HandleFunc("GET /info", d.handle(d.info))
+	mux.HandleFunc("POST /swarm/init", d.handle(d.swarmUnsupported))
+	mux.HandleFunc("POST /swarm/join", d.handle(d.swarmUnsupported))
+
+	d.addContainerRoutes(mux)
+	d.addImageRoutes(mux)
+	d.addNetworkRoutes(mux)
+	d.addVolumeRoutes(mux)
+
+	mux.HandleFunc("/", d.handle(func(w http.ResponseWriter, r *http.Request) error {
+		return errorf(http.StatusNotFound, "page not found")
+	}))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Api-Version", d.apiVersion)
+		w.Header().Set("Server", "Docker/"+fakeDaemonVersion+" ("+runtime.GOOS+")")
+
+		if version := apiVersionPrefix.FindString(r.URL.Path); version != "" {
+			if compareAPIVersions(strings.TrimPrefix(version, "/v"), d.apiVersion) > 0 {
+				writeError(w, errorf(http.StatusBadRequest, "client version %s is too new. Maximum supported API version is %s", strings.TrimPrefix(version, "/v"), d.apiVersion))
+				return
+			}
+
+			r.URL.Path = strings.TrimPrefix(r.URL.Path, version)
+			r.URL.RawPath = ""
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// handle adapts a handler that returns an error to an http.HandlerFunc, the
+// error is written as moby's ErrorResponse. The handlers are serialized by
+// the lock of the daemon, except while they wait.
+func (d *fakeDaemon) handle(h func(w http.ResponseWriter, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := h(w, r); err != nil {
+			writeError(w, err)
+		}
+	}
+}
+
+func (d *fakeDaemon) ping(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Set("Pragma", "no-cache")
+	w.Header().Set("Docker-Experimental", "false")
+	w.Header().Set("Ostype", "linux")
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method != http.MethodHead {
+		io.WriteString(w, "OK")
+	}
+}
+
+func (d *fakeDaemon) version(w http.ResponseWriter, r *http.Request) error {
+	return writeJSON(w, http.StatusOK, system.VersionResponse{
+		Platform:      system.PlatformInfo{Name: "Docker.DotNet specgen fake daemon"},
+		Version:       fakeDaemonVersion,
+		APIVersion:    d.apiVersion,
+		MinAPIVersion: client.MinAPIVersion,
+		Os:            "linux",
+		Arch:          runtime.GOARCH,
+		GoVersion:     runtime.Version(),
+		KernelVersion: "6.0.0-fake",
+		BuildTime:     d.started.Format(time.RFC3339Nano),
+	})
+}
+
+func (d *fakeDaemon) info(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	info := system.Info{
+		ID:                 d.id,
+		Images:             len(d.images),
+		Driver:             "overlay2",
+		LoggingDriver:      "json-file",
+		CgroupDriver:       "systemd",
+		CgroupVersion:      "2",
+		SystemTime:         time.Now().UTC().Format(time.RFC3339Nano),
+		KernelVersion:      "6.0.0-fake",
+		OperatingSystem:    "specgen fake daemon",
+		OSType:             "linux",
+		Architecture:       runtime.GOARCH,
+		IndexServerAddress: "https://index.docker.io/v1/",
+		NCPU:               runtime.NumCPU(),
+		DockerRootDir:      "/var/lib/docker",
+		Name:               "fake-daemon",
+		ServerVersion:      fakeDaemonVersion,
+	}
+
+	for _, c := range d.containers {
+		info.Containers++
+		switch {
+		case c.inspect.State.Paused:
+			info.ContainersPaused++
+		case c.inspect.State.Running:
+			info.ContainersRunning++
+		default:
+			info.ContainersStopped++
+		}
+	}
+
+	return writeJSON(w, http.StatusOK, info)
+}
+
+// swarmUnsupported answers the swarm routes like a node that cannot join a
+// swarm, the status code tells clients that the node is not available.
+func (d *fakeDaemon) swarmUnsupported(w http.ResponseWriter, r *http.Request) error {
+	return errorf(http.StatusServiceUnavailable, "swarm mode is not supported by the fake daemon")
+}
+
+// writeJSON writes v as the JSON body of a response.
+func writeJSON(w http.ResponseWriter, status int, v any) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes err as moby's ErrorResponse, with status 500 unless it is
+// a fakeError.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var fe *fakeError
+	if errors.As(err, &fe) {
+		status = fe.status
+	}
+
+	writeJSON(w, status, common.ErrorResponse{Message: err.Error()})
+}
+
+// readJSON decodes the JSON body of a request into v, an empty body leaves v unchanged.
+func readJSON(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return errorf(http.StatusBadRequest, "invalid JSON: %v", err)
+	}
+
+	return nil
+}
+
+// queryBool reads a boolean query parameter, see parseBool.
+func queryBool(q url.Values, name string) bool {
+	return parseBool(q.Get(name))
+}
+
+// parseBool reads a boolean the way moby reads query parameters and filters,
+// 0, false, no and an empty value are false.
+func parseBool(v string) bool {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "", "0", "false", "no":
+		return false
+	default:
+		return true
+	}
+}
+
+// fakeFilters are the filters query parameter, by key and value.
+type fakeFilters map[string][]string
+
+// parseFilters reads the filters query parameter in both of its encodings,
+// {"key":{"value":true}} and the legacy {"key":["value"]}.
+func parseFilters(q url.Values) (fakeFilters, error) {
+	raw := q.Get("filters")
+	if raw == "" {
+		return fakeFilters{}, nil
+	}
+
+	var byValue map[string]map[string]bool
+	if err := json.Unmarshal([]byte(raw), &byValue); err == nil {
+		filters := fakeFilters{}
+		for key, values := range byValue {
+			for value, ok := range values {
+				if ok {
+					filters[key] = append(filters[key], value)
+				}
+			}
+			slices.Sort(filters[key])
+		}
+		return filters, nil
+	}
+
+	var legacy map[string][]string
+	if err := json.Unmarshal([]byte(raw), &legacy); err != nil {
+		return nil, errorf(http.StatusBadRequest, "invalid filter: %v", err)
+	}
+
+	return fakeFilters(legacy), nil
+}
+
+// validate reports the keys that the route does not support.
+func (f fakeFilters) validate(keys ...string) error {
+	for key := range f {
+		if !slices.Contains(keys, key) {
+			return errorf(http.StatusBadRequest, "invalid filter '%s'", key)
+		}
+	}
+
+	return nil
+}
+
+// match reports whether one of the values of a filter matches, or the filter is not set.
+func (f fakeFilters) match(key string, matches func(value string) bool) bool {
+	values, ok := f[key]
+	if !ok {
+		return true
+	}
+
+	return slices.ContainsFunc(values, matches)
+}
+
+// matchLabels reports whether the labels have all the label filters, each
+// either a key or a key=value pair.
+func (f fakeFilters) matchLabels(labels map[string]string) bool {
+	for _, filter := range f["label"] {
+		key, value, hasValue := strings.Cut(filter, "=")
+		actual, ok := labels[key]
+		if !ok || hasValue && actual != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchGlob reports whether a value matches a filter pattern of moby, which
+// are shell patterns for references.
+func matchGlob(pattern, value string) bool {
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+// newFakeID returns a random 64 hex digit ID, like the IDs of moby's objects.
+func newFakeID() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// shortID returns the 12 digit prefix of an ID docker prints.
+func shortID(id string) string {
+	id = strings.TrimPrefix(id, "sha256:")
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+// compareAPIVersions compares two API versions, e.g. 1.9 and 1.41, by their numbers.
+func compareAPIVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		if an != bn {
+			return an - bn
+		}
+	}
+
+	return 0
+}
+
+// fakeStreamWriter writes the output of a container or exec, multiplexed
+// with a header per frame as moby does for streams without TTY.
+type fakeStreamWriter struct {
+	w   io.Writer
+	tty bool
+}
+
+// writeFrame writes the payload of stream 1 (stdout) or 2 (stderr).
+func (s fakeStreamWriter) writeFrame(stream byte, payload []byte) error {
+	if s.tty {
+		_, err := s.w.Write(payload)
+		return err
+	}
+
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	if _, err := s.w.Write(header); err != nil {
+		return err
+	}
+
+	_, err := s.w.Write(payload)
+	return err
+}
+
+// streamContentType returns the media type of the output streams moby uses.
+func streamContentType(tty bool) string {
+	if tty {
+		return "application/vnd.docker.raw-stream"
+	}
+	return "application/vnd.docker.multiplexed-stream"
+}
+
+// listenFakeDaemon listens on a DOCKER_HOST address, unix:///path or tcp://host:port.
+func listenFakeDaemon(host string) (net.Listener, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("parse host %s: %w", host, err)
+	}
+
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("remove socket %s: %w", socket, err)
+		}
+		return net.Listen("unix", socket)
+	case "tcp":
+		return net.Listen("tcp", u.Host)
+	default:
+		return nil, fmt.Errorf("host %s is neither unix:// nor tcp://", host)
+	}
+}
+
+// runFakeDaemon implements specgen fake-daemon, which serves the fake daemon
+// until it is interrupted.
+func runFakeDaemon(args []string) int {
+	fs := flag.NewFlagSet("specgen fake-daemon", flag.ContinueOnError)
+	host := fs.String("host", "unix:///tmp/specgen-fake-daemon.sock", "Address to listen on, unix:///path or tcp://host:port, the format of DOCKER_HOST.")
+	apiVersion := fs.String("api-version", client.MaxAPIVersion, "Highest API version the fake daemon supports.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: specgen fake-daemon [flags]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	l, err := listenFakeDaemon(*host)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		return exitUsage
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := &http.Server{Handler: newFakeDaemon(*apiVersion).handler()}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdown)
+	}()
+
+	fmt.Fprintf(os.Stderr, "specgen: fake daemon listening on %s, API version %s\n", *host, *apiVersion)
+
+	if err := server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		return exitIO
+	}
+
+	return exitOK
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/moby/moby/api/pkg/stdcopy"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/client"
+)
+
+// newFakeDaemonClient serves a fake daemon for the test and returns moby's
+// client connected to it.
+func newFakeDaemonClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(newFakeDaemon(client.MaxAPIVersion).handler())
+	t.Cleanup(server.Close)
+
+	c, err := client.New(client.WithHost("tcp://"+server.Listener.Addr().String()), client.WithAPIVersion(client.MaxAPIVersion))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// pullFakeImage pulls an image from the fake daemon and drains the progress.
+func pullFakeImage(t *testing.T, c *client.Client, ref string) {
+	t.Helper()
+
+	resp, err := c.ImagePull(t.Context(), ref, client.ImagePullOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := resp.Wait(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFakeDaemonContainerLifecycle(t *testing.T) {
+	c := newFakeDaemonClient(t)
+	ctx := t.Context()
+
+	pullFakeImage(t, c, "alpine:3.20")
+
+	created, err := c.ContainerCreate(ctx, client.ContainerCreateOptions{
+		Name:   "app",
+		Config: &container.Config{Image: "alpine:3.20", Cmd: []string{"sleep", "infinity"}, Labels: map[string]string{"app": "true"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ContainerStart(ctx, "app", client.ContainerStartOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	inspect, err := c.ContainerInspect(ctx, created.ID, client.ContainerInspectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !inspect.Container.State.Running || inspect.Container.Name != "/app" || inspect.Container.Path != "sleep" {
+		t.Errorf("inspect: got running %v, name %s, path %s", inspect.Container.State.Running, inspect.Container.Name, inspect.Container.Path)
+	}
+
+	if bridge := inspect.Container.NetworkSettings.Networks[network.NetworkBridge]; bridge == nil || bridge.IPAddress.String() != "172.17.0.2" {
+		t.Errorf("inspect: got bridge endpoint %+v, want 172.17.0.2", bridge)
+	}
+
+	list, err := c.ContainerList(ctx, client.ContainerListOptions{Filters: make(client.Filters).Add("label", "app=true")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list.Items) != 1 || list.Items[0].ID != created.ID {
+		t.Errorf("list: got %+v, want the container", list.Items)
+	}
+
+	exec, err := c.ExecCreate(ctx, created.ID, client.ExecCreateOptions{Cmd: []string{"echo", "hello"}, AttachStdout: true, AttachStderr: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attach, err := c.ExecAttach(ctx, exec.ID, client.ExecAttachOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	_, err = stdcopy.StdCopy(&stdout, &stderr, attach.Reader)
+	attach.Close()
+	if err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+
+	if stdout.String() != "hello\n" {
+		t.Errorf("exec: got stdout %q, want %q", stdout.String(), "hello\n")
+	}
+
+	wait := c.ContainerWait(ctx, created.ID, client.ContainerWaitOptions{Condition: container.WaitConditionNextExit})
+
+	if _, err := c.ContainerStop(ctx, created.ID, client.ContainerStopOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case resp := <-wait.Result:
+		if resp.StatusCode != 143 {
+			t.Errorf("wait: got exit code %d, want 143", resp.StatusCode)
+		}
+	case err := <-wait.Error:
+		t.Fatal(err)
+	}
+
+	if _, err := c.ContainerRemove(ctx, created.ID, client.ContainerRemoveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ContainerInspect(ctx, created.ID, client.ContainerInspectOptions{}); err == nil || !strings.Contains(err.Error(), "No such container") {
+		t.Errorf("inspect after remove: got %v, want no such container", err)
+	}
+}
+
+func TestFakeDaemonConflicts(t *testing.T) {
+	c := newFakeDaemonClient(t)
+	ctx := t.Context()
+
+	pullFakeImage(t, c, "alpine:3.20")
+
+	created, err := c.ContainerCreate(ctx, client.ContainerCreateOptions{Name: "app", Config: &container.Config{Image: "alpine:3.20"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ContainerStart(ctx, created.ID, client.ContainerStartOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context) error
+		want string
+	}{
+		{"duplicate container name", func(ctx context.Context) error {
+			_, err := c.ContainerCreate(ctx, client.ContainerCreateOptions{Name: "app", Config: &container.Config{Image: "alpine:3.20"}})
+			return err
+		}, "is already in use"},
+		{"missing image", func(ctx context.Context) error {
+			_, err := c.ContainerCreate(ctx, client.ContainerCreateOptions{Config: &container.Config{Image: "missing"}})
+			return err
+		}, "No such image"},
+		{"remove running container", func(ctx context.Context) error {
+			_, err := c.ContainerRemove(ctx, created.ID, client.ContainerRemoveOptions{})
+			return err
+		}, "cannot remove container"},
+		{"remove image in use", func(ctx context.Context) error {
+			_, err := c.ImageRemove(ctx, "alpine:3.20", client.ImageRemoveOptions{})
+			return err
+		}, "is being used by running container"},
+		{"remove predefined network", func(ctx context.Context) error {
+			_, err := c.NetworkRemove(ctx, network.NetworkBridge, client.NetworkRemoveOptions{})
+			return err
+		}, "pre-defined network"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(t.Context()); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFakeDaemonNetworksAndVolumes(t *testing.T) {
+	c := newFakeDaemonClient(t)
+	ctx := t.Context()
+
+	pullFakeImage(t, c, "alpine:3.20")
+
+	created, err := c.NetworkCreate(ctx, "backend", client.NetworkCreateOptions{Labels: map[string]string{"app": "true"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.VolumeCreate(ctx, client.VolumeCreateOptions{Name: "data"}); err != nil {
+		t.Fatal(err)
+	}
+
+	app, err := c.ContainerCreate(ctx, client.ContainerCreateOptions{
+		Name:       "app",
+		Config:     &container.Config{Image: "alpine:3.20"},
+		HostConfig: &container.HostConfig{NetworkMode: "backend", Binds: []string{"data:/data"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ContainerStart(ctx, app.ID, client.ContainerStartOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	inspect, err := c.NetworkInspect(ctx, created.ID, client.NetworkInspectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if endpoint, ok := inspect.Network.Containers[app.ID]; !ok || endpoint.IPv4Address.String() != "172.18.0.2/16" {
+		t.Errorf("network inspect: got endpoints %+v, want app at 172.18.0.2/16", inspect.Network.Containers)
+	}
+
+	if _, err := c.NetworkRemove(ctx, created.ID, client.NetworkRemoveOptions{}); err == nil || !strings.Contains(err.Error(), "has active endpoints") {
+		t.Errorf("network remove: got %v, want active endpoints error", err)
+	}
+
+	if _, err := c.VolumeRemove(ctx, "data", client.VolumeRemoveOptions{}); err == nil || !strings.Contains(err.Error(), "volume is in use") {
+		t.Errorf("volume remove: got %v, want in use error", err)
+	}
+
+	if _, err := c.ContainerRemove(ctx, app.ID, client.ContainerRemoveOptions{Force: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.NetworkRemove(ctx, created.ID, client.NetworkRemoveOptions{}); err != nil {
+		t.Errorf("network remove: %v", err)
+	}
+
+	if _, err := c.VolumeRemove(ctx, "data", client.VolumeRemoveOptions{}); err != nil {
+		t.Errorf("volume remove: %v", err)
+	}
+
+	volumes, err := c.VolumeList(ctx, client.VolumeListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes.Items) != 0 {
+		t.Errorf("volume list: got %+v, want none", volumes.Items)
+	}
+}
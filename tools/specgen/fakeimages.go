@@ -0,0 +1,455 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	dockerspec "github.com/moby/docker-image-spec/specs-go/v1"
+	"github.com/moby/moby/api/types/image"
+	"github.com/moby/moby/api/types/jsonstream"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// validRepository matches the repository names moby accepts, lower case path
+// components with an optional registry host.
+var validRepository = regexp.MustCompile(`^([a-zA-Z0-9.-]+(:[0-9]+)?/)?[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+
+// hexDigits matches the ID prefixes images are looked up with.
+var hexDigits = regexp.MustCompile(`^[a-f0-9]+$`)
+
+// fakeImage is an image of the fake daemon. Pulling a reference always
+// succeeds, the ID and digest of the image are derived from the reference, so
+// they are the same in every run.
+type fakeImage struct {
+	id      string
+	tags    []string
+	digests []string
+	created time.Time
+	size    int64
+	layers  []string
+	config  ocispec.ImageConfig
+}
+
+func (d *fakeDaemon) addImageRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /images/create", d.handle(d.pullImage))
+	mux.HandleFunc("GET /images/json", d.handle(d.listImages))
+	mux.HandleFunc("POST /images/prune", d.handle(d.pruneImages))
+
+	// Image names have slashes, so the routes that take a name are matched by suffix, like moby does.
+	mux.HandleFunc("/images/", d.handle(func(w http.ResponseWriter, r *http.Request) error {
+		name := strings.TrimPrefix(r.URL.Path, "/images/")
+		switch {
+		case r.Method == http.MethodDelete:
+			return d.removeImage(w, r, name)
+		case r.Method == http.MethodGet && strings.HasSuffix(name, "/json"):
+			return d.inspectImage(w, r, strings.TrimSuffix(name, "/json"))
+		case r.Method == http.MethodGet && strings.HasSuffix(name, "/history"):
+			return d.imageHistory(w, r, strings.TrimSuffix(name, "/history"))
+		case r.Method == http.MethodPost && strings.HasSuffix(name, "/tag"):
+			return d.tagImage(w, r, strings.TrimSuffix(name, "/tag"))
+		default:
+			return errorf(http.StatusNotFound, "page not found")
+		}
+	}))
+}
+
+// fakeDigest returns a sha256 digest of the parts, the IDs and digests of
+// the fake images.
+func fakeDigest(parts ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return "sha256:" + hex.EncodeToString(h[:])
+}
+
+// parseReference splits an image reference into its familiar name, e.g.
+// alpine for docker.io/library/alpine, its tag and its digest. The tag is
+// latest if the reference has neither.
+func parseReference(ref string) (name, tag, digest string) {
+	name, digest, _ = strings.Cut(ref, "@")
+	if i := strings.LastIndexByte(name, ':'); i > strings.LastIndexByte(name, '/') {
+		name, tag = name[:i], name[i+1:]
+	}
+
+	if tag == "" && digest == "" {
+		tag = "latest"
+	}
+
+	name = strings.TrimPrefix(name, "docker.io/")
+	name = strings.TrimPrefix(name, "library/")
+	return name, tag, digest
+}
+
+// image returns the image with an ID, unique ID prefix, tag or digest reference.
+func (d *fakeDaemon) image(ref string) (*fakeImage, error) {
+	if ref == "" {
+		return nil, errorf(http.StatusNotFound, "No such image: %s", ref)
+	}
+
+	if img, ok := d.images[ref]; ok {
+		return img, nil
+	}
+
+	if img, ok := d.images["sha256:"+ref]; ok {
+		return img, nil
+	}
+
+	name, tag, digest := parseReference(ref)
+	for _, img := range d.images {
+		if tag != "" && slices.Contains(img.tags, name+":"+tag) || digest != "" && slices.Contains(img.digests, name+"@"+digest) {
+			return img, nil
+		}
+	}
+
+	var found *fakeImage
+	prefix := strings.TrimPrefix(ref, "sha256:")
+	if hexDigits.MatchString(prefix) {
+		for id, img := range d.images {
+			if strings.HasPrefix(strings.TrimPrefix(id, "sha256:"), prefix) {
+				if found != nil {
+					return nil, errorf(http.StatusBadRequest, "multiple IDs found with provided prefix: %s", ref)
+				}
+				found = img
+			}
+		}
+	}
+
+	if found == nil {
+		return nil, errorf(http.StatusNotFound, "No such image: %s", ref)
+	}
+
+	return found, nil
+}
+
+// imageUsers returns the IDs of the containers created from an image.
+func (d *fakeDaemon) imageUsers(img *fakeImage) []string {
+	var ids []string
+	for _, id := range sortedKeys(d.containers) {
+		if d.containers[id].inspect.Image == img.id {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// untag removes a tag from the image that has it.
+func (d *fakeDaemon) untag(ref string) {
+	for _, img := range d.images {
+		img.tags = slices.DeleteFunc(img.tags, func(tag string) bool { return tag == ref })
+	}
+}
+
+// pullImage streams the progress messages of a pull like moby and adds the
+// image, or reports that it is up to date.
+func (d *fakeDaemon) pullImage(w http.ResponseWriter, r *http.Request) error {
+	q := r.URL.Query()
+	if q.Get("fromImage") == "" {
+		return errorf(http.StatusBadRequest, "only pulling images with fromImage is supported by the fake daemon")
+	}
+
+	name, tag, digest := parseReference(q.Get("fromImage"))
+	if t := q.Get("tag"); t != "" {
+		if strings.HasPrefix(t, "sha256:") {
+			tag, digest = "", t
+		} else {
+			tag = t
+		}
+	}
+
+	if !validRepository.MatchString(name) {
+		return errorf(http.StatusBadRequest, "invalid reference format: repository name (%s) must be lowercase", name)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ref, reference := name+":"+tag, tag
+	if digest != "" {
+		ref, reference = name+"@"+digest, digest
+	}
+
+	repository := name
+	if !strings.Contains(name, "/") {
+		repository = "library/" + name
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(w)
+
+	if img, err := d.image(ref); err == nil {
+		enc.Encode(jsonstream.Message{Status: "Pulling from " + repository, ID: reference})
+		enc.Encode(jsonstream.Message{Status: "Digest: " + strings.SplitN(img.digests[0], "@", 2)[1]})
+		return enc.Encode(jsonstream.Message{Status: "Status: Image is up to date for " + ref})
+	}
+
+	manifest := digest
+	if manifest == "" {
+		manifest = fakeDigest("manifest", name, tag)
+	}
+
+	img := &fakeImage{
+		id:      fakeDigest("image", name, manifest),
+		digests: []string{name + "@" + manifest},
+		created: time.Unix(1_700_000_000, 0).UTC(),
+		size:    7_000_000 + int64(len(ref))*1_000,
+		layers:  []string{fakeDigest("layer", name, manifest)},
+		config: ocispec.ImageConfig{
+			Env: []string{"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"},
+			Cmd: []string{"/bin/sh"},
+		},
+	}
+
+	if tag != "" {
+		d.untag(ref)
+		img.tags = []string{ref}
+	}
+
+	if existing, ok := d.images[img.id]; ok {
+		existing.tags = append(existing.tags, img.tags...)
+		img = existing
+	} else {
+		d.images[img.id] = img
+	}
+
+	enc.Encode(jsonstream.Message{Status: "Pulling from " + repository, ID: reference})
+	for _, layer := range img.layers {
+		id := shortID(layer)
+		enc.Encode(jsonstream.Message{Status: "Pulling fs layer", ID: id})
+		enc.Encode(jsonstream.Message{Status: "Downloading", ID: id, Progress: &jsonstream.Progress{Current: img.size, Total: img.size}})
+		enc.Encode(jsonstream.Message{Status: "Download complete", ID: id})
+		enc.Encode(jsonstream.Message{Status: "Pull complete", ID: id})
+	}
+
+	enc.Encode(jsonstream.Message{Status: "Digest: " + manifest})
+	return enc.Encode(jsonstream.Message{Status: "Status: Downloaded newer image for " + ref})
+}
+
+func (d *fakeDaemon) listImages(w http.ResponseWriter, r *http.Request) error {
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("reference", "label", "dangling", "until"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var matched []*fakeImage
+	for _, img := range d.images {
+		if d.matchImage(img, filters) {
+			matched = append(matched, img)
+		}
+	}
+
+	slices.SortFunc(matched, func(a, b *fakeImage) int {
+		if c := b.created.Compare(a.created); c != 0 {
+			return c
+		}
+		return strings.Compare(a.id, b.id)
+	})
+
+	summaries := []image.Summary{}
+	for _, img := range matched {
+		summaries = append(summaries, image.Summary{
+			Containers:  -1,
+			Created:     img.created.Unix(),
+			ID:          img.id,
+			Labels:      img.config.Labels,
+			RepoDigests: append([]string{}, img.digests...),
+			RepoTags:    append([]string{}, img.tags...),
+			SharedSize:  -1,
+			Size:        img.size,
+		})
+	}
+
+	return writeJSON(w, http.StatusOK, summaries)
+}
+
+// matchImage reports whether an image matches the filters of a list or prune.
+func (d *fakeDaemon) matchImage(img *fakeImage, filters fakeFilters) bool {
+	until, err := parseUntil(filters)
+	if err != nil {
+		return false
+	}
+
+	return filters.match("reference", func(v string) bool {
+		return slices.ContainsFunc(img.tags, func(tag string) bool {
+			name, _, _ := parseReference(tag)
+			return matchGlob(v, tag) || matchGlob(v, name)
+		})
+	}) &&
+		filters.match("dangling", func(v string) bool { return (len(img.tags) == 0) == parseBool(v) }) &&
+		filters.matchLabels(img.config.Labels) &&
+		!img.created.After(until)
+}
+
+func (d *fakeDaemon) inspectImage(w http.ResponseWriter, r *http.Request, name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	img, err := d.image(name)
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, image.InspectResponse{
+		ID:           img.id,
+		RepoTags:     append([]string{}, img.tags...),
+		RepoDigests:  append([]string{}, img.digests...),
+		Created:      img.created.Format(time.RFC3339Nano),
+		Config:       &dockerspec.DockerOCIImageConfig{ImageConfig: img.config},
+		Architecture: runtime.GOARCH,
+		Os:           "linux",
+		Size:         img.size,
+		RootFS:       image.RootFS{Type: "layers", Layers: img.layers},
+	})
+}
+
+func (d *fakeDaemon) imageHistory(w http.ResponseWriter, r *http.Request, name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	img, err := d.image(name)
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, []image.HistoryResponseItem{{
+		ID:        img.id,
+		Created:   img.created.Unix(),
+		CreatedBy: "/bin/sh -c #(nop)  CMD [\"/bin/sh\"]",
+		Tags:      append([]string{}, img.tags...),
+		Size:      img.size,
+	}})
+}
+
+func (d *fakeDaemon) tagImage(w http.ResponseWriter, r *http.Request, name string) error {
+	q := r.URL.Query()
+	repo, tag := q.Get("repo"), q.Get("tag")
+	if repo == "" {
+		return errorf(http.StatusBadRequest, "repository name must have at least one component")
+	}
+
+	if tag == "" {
+		tag = "latest"
+	}
+
+	repo, _, _ = parseReference(repo)
+	if !validRepository.MatchString(repo) {
+		return errorf(http.StatusBadRequest, "invalid reference format: repository name (%s) must be lowercase", repo)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	img, err := d.image(name)
+	if err != nil {
+		return err
+	}
+
+	ref := repo + ":" + tag
+	if !slices.Contains(img.tags, ref) {
+		d.untag(ref)
+		img.tags = append(img.tags, ref)
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (d *fakeDaemon) removeImage(w http.ResponseWriter, r *http.Request, name string) error {
+	force := queryBool(r.URL.Query(), "force")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	img, err := d.image(name)
+	if err != nil {
+		return err
+	}
+
+	// A tag of an image with other tags is only untagged.
+	refName, refTag, _ := parseReference(name)
+	ref := refName + ":" + refTag
+	if slices.Contains(img.tags, ref) && len(img.tags) > 1 {
+		d.untag(ref)
+		return writeJSON(w, http.StatusOK, []image.DeleteResponse{{Untagged: ref}})
+	}
+
+	if !slices.Contains(img.tags, ref) && len(img.tags) > 1 && !force {
+		return errorf(http.StatusConflict, "conflict: unable to delete %s (must be forced) - image is referenced in multiple repositories", shortID(img.id))
+	}
+
+	for _, id := range d.imageUsers(img) {
+		c := d.containers[id]
+		if c.inspect.State.Running {
+			return errorf(http.StatusConflict, "conflict: unable to delete %s (cannot be forced) - image is being used by running container %s", shortID(img.id), shortID(id))
+		}
+
+		if !force {
+			return errorf(http.StatusConflict, "conflict: unable to delete %s (must be forced) - image is being used by stopped container %s", shortID(img.id), shortID(id))
+		}
+	}
+
+	var deleted []image.DeleteResponse
+	for _, ref := range slices.Concat(img.tags, img.digests) {
+		deleted = append(deleted, image.DeleteResponse{Untagged: ref})
+	}
+
+	deleted = append(deleted, image.DeleteResponse{Deleted: img.id})
+	for _, layer := range img.layers {
+		deleted = append(deleted, image.DeleteResponse{Deleted: layer})
+	}
+
+	delete(d.images, img.id)
+	return writeJSON(w, http.StatusOK, deleted)
+}
+
+func (d *fakeDaemon) pruneImages(w http.ResponseWriter, r *http.Request) error {
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("label", "dangling", "until"); err != nil {
+		return err
+	}
+
+	// Only the dangling images are pruned, unless dangling=false.
+	if _, ok := filters["dangling"]; !ok {
+		filters["dangling"] = []string{"true"}
+	} else if !slices.ContainsFunc(filters["dangling"], parseBool) {
+		delete(filters, "dangling")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	report := image.PruneReport{}
+	for _, id := range sortedKeys(d.images) {
+		img := d.images[id]
+		if len(d.imageUsers(img)) > 0 || !d.matchImage(img, filters) {
+			continue
+		}
+
+		for _, ref := range slices.Concat(img.tags, img.digests) {
+			report.ImagesDeleted = append(report.ImagesDeleted, image.DeleteResponse{Untagged: ref})
+		}
+
+		report.ImagesDeleted = append(report.ImagesDeleted, image.DeleteResponse{Deleted: img.id})
+		report.SpaceReclaimed += uint64(img.size)
+		delete(d.images, id)
+	}
+
+	return writeJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,457 @@
+package main
+
+import (
+	"encoding/binary"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/network"
+)
+
+// fakeNetwork is a network of the fake daemon, kept in the shape moby
+// inspects it in. Networks with a subnet hand out its addresses in order.
+type fakeNetwork struct {
+	inspect    network.Inspect
+	predefined bool
+	// lastHost is the host part of the last address handed out, the gateway is 1.
+	lastHost uint32
+}
+
+// addPredefinedNetworks adds the networks of a fresh Docker installation,
+// bridge, host and none.
+func (d *fakeDaemon) addPredefinedNetworks() {
+	bridge := d.addNetwork(network.NetworkBridge, "bridge", netip.MustParsePrefix("172.17.0.0/16"), nil, nil)
+	bridge.inspect.Options = map[string]string{
+		"com.docker.network.bridge.default_bridge":       "true",
+		"com.docker.network.bridge.enable_icc":           "true",
+		"com.docker.network.bridge.enable_ip_masquerade": "true",
+		"com.docker.network.bridge.name":                 "docker0",
+		"com.docker.network.driver.mtu":                  "1500",
+	}
+
+	d.addNetwork(network.NetworkHost, "host", netip.Prefix{}, nil, nil)
+	d.addNetwork(network.NetworkNone, "null", netip.Prefix{}, nil, nil)
+
+	for _, n := range d.networks {
+		n.predefined = true
+	}
+}
+
+// addNetwork adds a local network, with IPAM config if the subnet is valid.
+func (d *fakeDaemon) addNetwork(name, driver string, subnet netip.Prefix, options, labels map[string]string) *fakeNetwork {
+	if options == nil {
+		options = map[string]string{}
+	}
+
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	n := &fakeNetwork{
+		lastHost: 1,
+		inspect: network.Inspect{
+			Network: network.Network{
+				Name:       name,
+				ID:         newFakeID(),
+				Created:    time.Now().UTC(),
+				Scope:      "local",
+				Driver:     driver,
+				EnableIPv4: true,
+				IPAM:       network.IPAM{Driver: "default", Options: map[string]string{}, Config: []network.IPAMConfig{}},
+				Options:    options,
+				Labels:     labels,
+			},
+			Containers: map[string]network.EndpointResource{},
+		},
+	}
+
+	if subnet.IsValid() {
+		n.inspect.IPAM.Config = append(n.inspect.IPAM.Config, network.IPAMConfig{Subnet: subnet, Gateway: hostAddress(subnet, 1)})
+	}
+
+	d.networks[n.inspect.ID] = n
+	return n
+}
+
+// hostAddress returns the address with a host part in an IPv4 subnet.
+func hostAddress(subnet netip.Prefix, host uint32) netip.Addr {
+	a := subnet.Masked().Addr().As4()
+	binary.BigEndian.PutUint32(a[:], binary.BigEndian.Uint32(a[:])+host)
+	return netip.AddrFrom4(a)
+}
+
+// subnet returns the IPv4 subnet of the network, or an invalid prefix if it has none.
+func (n *fakeNetwork) subnet() netip.Prefix {
+	return n.ipv4Config().Subnet
+}
+
+// gateway returns the IPv4 gateway of the network.
+func (n *fakeNetwork) gateway() netip.Addr {
+	return n.ipv4Config().Gateway
+}
+
+func (n *fakeNetwork) ipv4Config() network.IPAMConfig {
+	for _, config := range n.inspect.IPAM.Config {
+		if config.Subnet.Addr().Is4() {
+			return config
+		}
+	}
+
+	return network.IPAMConfig{}
+}
+
+func (d *fakeDaemon) addNetworkRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /networks", d.handle(d.listNetworks))
+	mux.HandleFunc("POST /networks/create", d.handle(d.createNetwork))
+	mux.HandleFunc("POST /networks/prune", d.handle(d.pruneNetworks))
+	mux.HandleFunc("GET /networks/{id}", d.handle(d.inspectNetwork))
+	mux.HandleFunc("DELETE /networks/{id}", d.handle(d.removeNetwork))
+	mux.HandleFunc("POST /networks/{id}/connect", d.handle(d.connectNetwork))
+	mux.HandleFunc("POST /networks/{id}/disconnect", d.handle(d.disconnectNetwork))
+}
+
+// network returns the network with an ID, name or unique ID prefix.
+func (d *fakeDaemon) network(ref string) (*fakeNetwork, error) {
+	if n, ok := d.networks[ref]; ok {
+		return n, nil
+	}
+
+	for _, n := range d.networks {
+		if n.inspect.Name == ref {
+			return n, nil
+		}
+	}
+
+	var found *fakeNetwork
+	if ref != "" {
+		for id, n := range d.networks {
+			if strings.HasPrefix(id, ref) {
+				if found != nil {
+					return nil, errorf(http.StatusBadRequest, "network %s is ambiguous", ref)
+				}
+				found = n
+			}
+		}
+	}
+
+	if found == nil {
+		return nil, errorf(http.StatusNotFound, "network %s not found", ref)
+	}
+
+	return found, nil
+}
+
+// connectContainer adds a network to the networks of a container, with an
+// endpoint if the container is running.
+func (d *fakeDaemon) connectContainer(c *fakeContainer, n *fakeNetwork, settings *network.EndpointSettings) {
+	settings = settings.Copy()
+	if settings == nil {
+		settings = &network.EndpointSettings{}
+	}
+
+	settings.NetworkID = n.inspect.ID
+	c.inspect.NetworkSettings.Networks[n.inspect.Name] = settings
+
+	if c.inspect.State.Running {
+		d.attachEndpoint(c, n)
+	}
+}
+
+// attachEndpoint creates the endpoint of a running container in a network,
+// with the next address of the subnet of the network.
+func (d *fakeDaemon) attachEndpoint(c *fakeContainer, n *fakeNetwork) {
+	settings := c.inspect.NetworkSettings.Networks[n.inspect.Name]
+	settings.EndpointID = newFakeID()
+
+	resource := network.EndpointResource{
+		Name:       strings.TrimPrefix(c.inspect.Name, "/"),
+		EndpointID: settings.EndpointID,
+	}
+
+	if subnet := n.subnet(); subnet.IsValid() {
+		n.lastHost++
+		ip := hostAddress(subnet, n.lastHost)
+		if settings.IPAMConfig != nil && settings.IPAMConfig.IPv4Address.IsValid() {
+			ip = settings.IPAMConfig.IPv4Address
+		}
+
+		// Like moby, the MAC address is 02:42 followed by the IPv4 address.
+		ip4 := ip.As4()
+		mac := network.HardwareAddr{0x02, 0x42, ip4[0], ip4[1], ip4[2], ip4[3]}
+
+		settings.IPAddress, settings.IPPrefixLen, settings.Gateway, settings.MacAddress = ip, subnet.Bits(), n.gateway(), mac
+		resource.IPv4Address, resource.MacAddress = netip.PrefixFrom(ip, subnet.Bits()), mac
+	}
+
+	// Containers are resolvable by name, short ID and aliases in user defined networks.
+	if !n.predefined {
+		settings.DNSNames = append([]string{resource.Name, shortID(c.inspect.ID)}, settings.Aliases...)
+	}
+
+	n.inspect.Containers[c.inspect.ID] = resource
+}
+
+// detachEndpoint removes the endpoint of a container that stops or leaves a network.
+func (d *fakeDaemon) detachEndpoint(c *fakeContainer, n *fakeNetwork) {
+	if settings, ok := c.inspect.NetworkSettings.Networks[n.inspect.Name]; ok {
+		settings.EndpointID = ""
+		settings.IPAddress, settings.IPPrefixLen, settings.Gateway, settings.MacAddress = netip.Addr{}, 0, netip.Addr{}, nil
+		settings.DNSNames = nil
+	}
+
+	delete(n.inspect.Containers, c.inspect.ID)
+}
+
+// networkUsers returns the IDs of the containers connected to a network.
+func (d *fakeDaemon) networkUsers(n *fakeNetwork) []string {
+	var ids []string
+	for _, id := range sortedKeys(d.containers) {
+		if settings, ok := d.containers[id].inspect.NetworkSettings.Networks[n.inspect.Name]; ok && settings.NetworkID == n.inspect.ID {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func (d *fakeDaemon) listNetworks(w http.ResponseWriter, r *http.Request) error {
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("dangling", "driver", "id", "label", "name", "scope", "type"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	networks := []network.Summary{}
+	for _, id := range sortedKeys(d.networks) {
+		n := d.networks[id]
+		if d.matchNetwork(n, filters) {
+			networks = append(networks, network.Summary{Network: n.inspect.Network})
+		}
+	}
+
+	slices.SortFunc(networks, func(a, b network.Summary) int { return strings.Compare(a.Name, b.Name) })
+
+	return writeJSON(w, http.StatusOK, networks)
+}
+
+// matchNetwork reports whether a network matches the filters of a list or prune.
+func (d *fakeDaemon) matchNetwork(n *fakeNetwork, filters fakeFilters) bool {
+	return filters.match("name", func(v string) bool { return strings.Contains(n.inspect.Name, v) }) &&
+		filters.match("id", func(v string) bool { return strings.HasPrefix(n.inspect.ID, v) }) &&
+		filters.match("driver", func(v string) bool { return n.inspect.Driver == v }) &&
+		filters.match("scope", func(v string) bool { return n.inspect.Scope == v }) &&
+		filters.match("type", func(v string) bool {
+			return v == "builtin" && n.predefined || v == "custom" && !n.predefined
+		}) &&
+		filters.match("dangling", func(v string) bool {
+			return (!n.predefined && len(d.networkUsers(n)) == 0) == parseBool(v)
+		}) &&
+		filters.matchLabels(n.inspect.Labels)
+}
+
+func (d *fakeDaemon) inspectNetwork(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n, err := d.network(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(w, http.StatusOK, n.inspect)
+}
+
+func (d *fakeDaemon) createNetwork(w http.ResponseWriter, r *http.Request) error {
+	var req network.CreateRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	if req.Name == "" {
+		return errorf(http.StatusBadRequest, "network name cannot be empty")
+	}
+
+	if req.Scope != "" && req.Scope != "local" {
+		return errorf(http.StatusBadRequest, "only local networks are supported by the fake daemon")
+	}
+
+	if req.Driver == "" {
+		req.Driver = "bridge"
+	}
+
+	if req.Driver == "host" || req.Driver == "null" {
+		return errorf(http.StatusForbidden, "only one instance of \"%s\" network is allowed", req.Driver)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, n := range d.networks {
+		if n.inspect.Name == req.Name {
+			return errorf(http.StatusConflict, "network with name %s already exists", req.Name)
+		}
+	}
+
+	n := d.addNetwork(req.Name, req.Driver, netip.Prefix{}, req.Options, req.Labels)
+	n.inspect.Internal, n.inspect.Attachable = req.Internal, req.Attachable
+	if req.EnableIPv4 != nil {
+		n.inspect.EnableIPv4 = *req.EnableIPv4
+	}
+
+	// Networks without IPAM config get the next 172.x.0.0/16 subnet, like moby's default address pools.
+	if req.IPAM != nil && len(req.IPAM.Config) > 0 {
+		n.inspect.IPAM.Config = slices.Clone(req.IPAM.Config)
+	} else {
+		n.inspect.IPAM.Config = []network.IPAMConfig{{Subnet: netip.PrefixFrom(netip.AddrFrom4([4]byte{172, byte(d.nextSubnet), 0, 0}), 16)}}
+		d.nextSubnet++
+	}
+
+	for i, config := range n.inspect.IPAM.Config {
+		if config.Subnet.Addr().Is4() && !config.Gateway.IsValid() {
+			n.inspect.IPAM.Config[i].Gateway = hostAddress(config.Subnet, 1)
+		}
+	}
+
+	return writeJSON(w, http.StatusCreated, network.CreateResponse{ID: n.inspect.ID})
+}
+
+func (d *fakeDaemon) removeNetwork(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n, err := d.network(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	if n.predefined {
+		return errorf(http.StatusForbidden, "%s is a pre-defined network and cannot be removed", n.inspect.Name)
+	}
+
+	if len(n.inspect.Containers) > 0 {
+		return errorf(http.StatusForbidden, "error while removing network: network %s id %s has active endpoints", n.inspect.Name, n.inspect.ID)
+	}
+
+	d.dropNetwork(n)
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// dropNetwork removes a network and disconnects the stopped containers from it.
+func (d *fakeDaemon) dropNetwork(n *fakeNetwork) {
+	for _, id := range d.networkUsers(n) {
+		delete(d.containers[id].inspect.NetworkSettings.Networks, n.inspect.Name)
+	}
+
+	delete(d.networks, n.inspect.ID)
+}
+
+func (d *fakeDaemon) connectNetwork(w http.ResponseWriter, r *http.Request) error {
+	var req network.ConnectRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n, err := d.network(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	c, err := d.container(req.Container)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.inspect.NetworkSettings.Networks[n.inspect.Name]; ok {
+		return errorf(http.StatusForbidden, "endpoint with name %s already exists in network %s", strings.TrimPrefix(c.inspect.Name, "/"), n.inspect.Name)
+	}
+
+	mode := c.inspect.HostConfig.NetworkMode
+	if mode.IsHost() || mode.IsNone() || mode.IsContainer() || n.inspect.Driver == "host" || n.inspect.Driver == "null" {
+		return errorf(http.StatusForbidden, "container sharing network namespace with another container or host cannot be connected to any other network")
+	}
+
+	d.connectContainer(c, n, req.EndpointConfig)
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (d *fakeDaemon) disconnectNetwork(w http.ResponseWriter, r *http.Request) error {
+	var req network.DisconnectRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n, err := d.network(r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	c, err := d.container(req.Container)
+	if err != nil {
+		if req.Force {
+			w.WriteHeader(http.StatusOK)
+			return nil
+		}
+		return err
+	}
+
+	if _, ok := c.inspect.NetworkSettings.Networks[n.inspect.Name]; !ok {
+		return errorf(http.StatusForbidden, "container %s is not connected to network %s", c.inspect.ID, n.inspect.Name)
+	}
+
+	d.detachEndpoint(c, n)
+	delete(c.inspect.NetworkSettings.Networks, n.inspect.Name)
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (d *fakeDaemon) pruneNetworks(w http.ResponseWriter, r *http.Request) error {
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("label", "until"); err != nil {
+		return err
+	}
+
+	until, err := parseUntil(filters)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	report := network.PruneReport{NetworksDeleted: []string{}}
+	for _, id := range sortedKeys(d.networks) {
+		n := d.networks[id]
+		if n.predefined || len(d.networkUsers(n)) > 0 || !filters.matchLabels(n.inspect.Labels) || !n.inspect.Created.Before(until) {
+			continue
+		}
+
+		d.dropNetwork(n)
+		report.NetworksDeleted = append(report.NetworksDeleted, n.inspect.Name)
+	}
+
+	return writeJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/volume"
+)
+
+// anonymousVolumeLabel is the label moby marks the volumes it creates for
+// containers without a name with.
+const anonymousVolumeLabel = "com.docker.volume.anonymous"
+
+// fakeVolume is a volume of the fake daemon. Its mountpoint is where moby
+// would keep it, nothing is created on disk.
+type fakeVolume struct {
+	volume volume.Volume
+}
+
+// anonymous reports whether the volume was created without a name.
+func (v *fakeVolume) anonymous() bool {
+	_, ok := v.volume.Labels[anonymousVolumeLabel]
+	return ok
+}
+
+func (d *fakeDaemon) addVolumeRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /volumes", d.handle(d.listVolumes))
+	mux.HandleFunc("POST /volumes/create", d.handle(d.createVolumeRoute))
+	mux.HandleFunc("POST /volumes/prune", d.handle(d.pruneVolumes))
+	mux.HandleFunc("GET /volumes/{name}", d.handle(d.inspectVolume))
+	mux.HandleFunc("DELETE /volumes/{name}", d.handle(d.removeVolume))
+}
+
+// createVolume returns the volume with a name, created if it does not exist.
+// A volume without name is anonymous and gets a random one.
+func (d *fakeDaemon) createVolume(name, driver string, opts, labels map[string]string) *fakeVolume {
+	if v, ok := d.volumes[name]; ok {
+		return v
+	}
+
+	if name == "" {
+		name = newFakeID()
+		labels = map[string]string{anonymousVolumeLabel: ""}
+	}
+
+	if driver == "" {
+		driver = "local"
+	}
+
+	v := &fakeVolume{volume: volume.Volume{
+		CreatedAt:  time.Now().UTC().Format(time.RFC3339),
+		Driver:     driver,
+		Labels:     labels,
+		Mountpoint: "/var/lib/docker/volumes/" + name + "/_data",
+		Name:       name,
+		Options:    opts,
+		Scope:      "local",
+	}}
+
+	d.volumes[name] = v
+	return v
+}
+
+// volumeUsers returns the IDs of the containers that mount a volume.
+func (d *fakeDaemon) volumeUsers(name string) []string {
+	var ids []string
+	for _, id := range sortedKeys(d.containers) {
+		for _, mp := range d.containers[id].inspect.Mounts {
+			if mp.Name == name {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+
+	return ids
+}
+
+func (d *fakeDaemon) listVolumes(w http.ResponseWriter, r *http.Request) error {
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("dangling", "driver", "label", "name"); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	resp := volume.ListResponse{Volumes: []volume.Volume{}, Warnings: []string{}}
+	for _, name := range sortedKeys(d.volumes) {
+		v := d.volumes[name]
+		if filters.match("name", func(f string) bool { return strings.Contains(name, f) }) &&
+			filters.match("driver", func(f string) bool { return v.volume.Driver == f }) &&
+			filters.match("dangling", func(f string) bool { return (len(d.volumeUsers(name)) == 0) == parseBool(f) }) &&
+			filters.matchLabels(v.volume.Labels) {
+			resp.Volumes = append(resp.Volumes, v.volume)
+		}
+	}
+
+	return writeJSON(w, http.StatusOK, resp)
+}
+
+func (d *fakeDaemon) createVolumeRoute(w http.ResponseWriter, r *http.Request) error {
+	var req volume.CreateRequest
+	if err := readJSON(r, &req); err != nil {
+		return err
+	}
+
+	if req.ClusterVolumeSpec != nil {
+		return errorf(http.StatusServiceUnavailable, "swarm mode is not supported by the fake daemon")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if v, ok := d.volumes[req.Name]; ok && req.Driver != "" && v.volume.Driver != req.Driver {
+		return errorf(http.StatusConflict, "volume name %s already in use with driver %s", req.Name, v.volume.Driver)
+	}
+
+	v := d.createVolume(req.Name, req.Driver, req.DriverOpts, req.Labels)
+
+	return writeJSON(w, http.StatusCreated, v.volume)
+}
+
+func (d *fakeDaemon) inspectVolume(w http.ResponseWriter, r *http.Request) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	v, ok := d.volumes[r.PathValue("name")]
+	if !ok {
+		return errorf(http.StatusNotFound, "get %s: no such volume", r.PathValue("name"))
+	}
+
+	return writeJSON(w, http.StatusOK, v.volume)
+}
+
+// removeVolume removes a volume no container mounts. With force a missing
+// volume is not an error, like with moby.
+func (d *fakeDaemon) removeVolume(w http.ResponseWriter, r *http.Request) error {
+	name := r.PathValue("name")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.volumes[name]; !ok {
+		if queryBool(r.URL.Query(), "force") {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+		return errorf(http.StatusNotFound, "get %s: no such volume", name)
+	}
+
+	if users := d.volumeUsers(name); len(users) > 0 {
+		return errorf(http.StatusConflict, "remove %s: volume is in use - [%s]", name, strings.Join(users, ", "))
+	}
+
+	delete(d.volumes, name)
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// pruneVolumes removes the anonymous volumes no container mounts, or all of
+// them with the all filter.
+func (d *fakeDaemon) pruneVolumes(w http.ResponseWriter, r *http.Request) error {
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		return err
+	}
+
+	if err := filters.validate("all", "label"); err != nil {
+		return err
+	}
+
+	all := slices.ContainsFunc(filters["all"], parseBool)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	report := volume.PruneReport{VolumesDeleted: []string{}}
+	for _, name := range sortedKeys(d.volumes) {
+		v := d.volumes[name]
+		if !all && !v.anonymous() || len(d.volumeUsers(name)) > 0 || !filters.matchLabels(v.volume.Labels) {
+			continue
+		}
+
+		delete(d.volumes, name)
+		report.VolumesDeleted = append(report.VolumesDeleted, name)
+	}
+
+	return writeJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/api/types/swarm"
+)
+
+// CSFilter is a key of the filters query parameter of a route.
+type CSFilter struct {
+	Key string
+	// Type is the Go enum, bool or int type of the values, nil for strings.
+	Type    reflect.Type
+	Comment string
+}
+
+// MethodName returns the name of the builder method, e.g. IsTask for is-task.
+func (f CSFilter) MethodName() string {
+	return pascalCase(f.Key)
+}
+
+// CSFiltersType is a builder of the filters query parameter of a parameters
+// model, generated as a C# class that derives from the dictionary the
+// parameter is declared as.
+type CSFiltersType struct {
+	Name string
+	// Parameters and Property are the C# model and property the builder is for.
+	Parameters string
+	Property   string
+	Routes     []string
+	Filters    []CSFilter
+}
+
+// filterValueTypes declares the values of filter keys that are not plain
+// strings, by the route and the key.
+var filterValueTypes = map[string]reflect.Type{
+	"GET /containers/json status":    reflect.TypeOf(container.ContainerState("")),
+	"GET /containers/json health":    reflect.TypeOf(container.HealthStatus("")),
+	"GET /containers/json is-task":   reflect.TypeOf(false),
+	"GET /containers/json exited":    reflect.TypeOf(0),
+	"GET /events type":               reflect.TypeOf(events.Type("")),
+	"GET /images/json dangling":      reflect.TypeOf(false),
+	"POST /images/prune dangling":    reflect.TypeOf(false),
+	"GET /images/search is-official": reflect.TypeOf(false),
+	"GET /images/search stars":       reflect.TypeOf(0),
+	"GET /networks dangling":         reflect.TypeOf(false),
+	"GET /plugins enable":            reflect.TypeOf(false),
+	"GET /volumes dangling":          reflect.TypeOf(false),
+	"POST /volumes/prune all":        reflect.TypeOf(false),
+	"GET /tasks desired-state":       reflect.TypeOf(swarm.TaskState("")),
+}
+
+var reflectedFilters = map[string]*CSFiltersType{}
+
+// filterKeyPattern matches the list items of a filters parameter description,
+// e.g. - `label=key` or `label="key=value"` of a container label.
+var filterKeyPattern = regexp.MustCompile("^- `([a-z][a-z0-9._-]*)")
+
+// parseFilterKeys returns the filter keys listed in the description of a filters
+// parameter, with the text of their list item as comment.
+func parseFilterKeys(description string) []CSFilter {
+	var filters []CSFilter
+	item := -1
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := filterKeyPattern.FindStringSubmatch(line); match != nil {
+			item = -1
+			if !slices.ContainsFunc(filters, func(f CSFilter) bool { return f.Key == match[1] }) {
+				filters = append(filters, CSFilter{Key: match[1], Comment: strings.TrimPrefix(line, "- ")})
+				item = len(filters) - 1
+			}
+
+			continue
+		}
+
+		// Items continue on the following lines up to the next item or an empty line.
+		if line == "" || strings.HasPrefix(line, "- ") {
+			item = -1
+		} else if item >= 0 {
+			filters[item].Comment += " " + line
+		}
+	}
+
+	return filters
+}
+
+// reflectFilters reads the keys of the query parameters of type Args of the
+// routes from their swagger description and declares a builder for each, with
+// the values of the keys in valueTypes typed.
+func reflectFilters(routes []Route, spec *swaggerSpec, valueTypes map[string]reflect.Type) {
+	used := map[string]bool{}
+
+	for _, r := range routes {
+		if r.Parameters == nil {
+			continue
+		}
+
+		pt := ultimateType(r.Parameters)
+		m := reflectedTypes[typeToKey(pt)]
+		if m == nil || pt.Kind() != reflect.Struct {
+			continue
+		}
+
+		for _, f := range reflect.VisibleFields(pt) {
+			if f.Type != reflect.TypeOf(Args{}) {
+				continue
+			}
+
+			restTag, err := RestTagFromString(f.Tag.Get("rest"))
+			if err != nil || restTag.In != query {
+				continue
+			}
+
+			if restTag.Name == "" {
+				restTag.Name = strings.ToLower(f.Name)
+			}
+
+			var param *swaggerParameter
+			if _, item := findSwaggerPath(spec, r.Path); item != nil {
+				if op := item.operation(r.Method); op != nil {
+					if i := slices.IndexFunc(op.Parameters, func(p swaggerParameter) bool {
+						return p.In == "query" && p.Name == restTag.Name
+					}); i >= 0 {
+						param = &op.Parameters[i]
+					}
+				}
+			}
+
+			if param == nil {
+				reportError("route (%s) has no query parameter (%s) in swagger.yaml", r, restTag.Name)
+				continue
+			}
+
+			k := typeToKey(pt) + "." + f.Name
+			ft, ok := reflectedFilters[k]
+			if !ok {
+				ft = &CSFiltersType{
+					Name:       strings.TrimSuffix(m.Name, "Parameters") + "Filters",
+					Parameters: m.Name,
+					Property:   f.Name,
+				}
+				reflectedFilters[k] = ft
+			}
+
+			ft.Routes = append(ft.Routes, r.String())
+
+			for _, filter := range parseFilterKeys(param.Description) {
+				vk := r.String() + " " + filter.Key
+				if t, ok := valueTypes[vk]; ok {
+					used[vk] = true
+					if t.Kind() == reflect.Bool || t.Kind() == reflect.Int || reflectEnum(t) != nil {
+						filter.Type = t
+					} else {
+						reportError("filter (%s) value type (%s) is not an enum, bool or int", vk, t)
+					}
+				}
+
+				if !slices.ContainsFunc(ft.Filters, func(f CSFilter) bool { return f.Key == filter.Key }) {
+					ft.Filters = append(ft.Filters, filter)
+				}
+			}
+		}
+	}
+
+	// An entry the swagger description no longer lists would silently be ignored.
+	for _, vk := range sortedKeys(valueTypes) {
+		if !used[vk] {
+			reportError("filter (%s) with value type (%s) is not listed in swagger.yaml", vk, valueTypes[vk])
+		}
+	}
+}
+
+// Write the filters builder to the io writer given.
+func (ft *CSFiltersType) Write(w io.Writer) {
+	fmt.Fprintln(w, "#nullable enable")
+	fmt.Fprintf(w, "namespace %s\n", *modelsNamespace)
+	fmt.Fprintln(w, "{")
+
+	routes := make([]string, 0, len(ft.Routes))
+	for _, r := range ft.Routes {
+		routes = append(routes, "<c>"+escapeXMLComment(r)+"</c>")
+	}
+
+	fmt.Fprintln(w, "    /// <summary>")
+	fmt.Fprintf(w, "    /// Builds the <see cref=\"%s.%s\"/> of %s.\n", ft.Parameters, ft.Property, strings.Join(routes, ", "))
+	fmt.Fprintln(w, "    /// </summary>")
+	fmt.Fprintf(w, "    public sealed class %s : FiltersBuilder<%s> // (%s.%s)\n", ft.Name, ft.Name, ft.Parameters, ft.Property)
+	fmt.Fprintln(w, "    {")
+
+	for i, f := range ft.Filters {
+		if i > 0 {
+			fmt.Fprintln(w, "")
+		}
+
+		writeXMLComment(w, f.Comment, "        ")
+
+		switch {
+		case f.Type == nil:
+			fmt.Fprintf(w, "        public %s %s(string value) => AddFilter(\"%s\", value);\n", ft.Name, f.MethodName(), f.Key)
+
+			// Labels are matched by key or by key and value.
+			if f.Key == "label" {
+				fmt.Fprintln(w, "")
+				writeXMLComment(w, "Matches the label key with the value, the same as label=key=value.", "        ")
+				fmt.Fprintf(w, "        public %s %s(string key, string value) => AddFilter(\"%s\", key + \"=\" + value);\n", ft.Name, f.MethodName(), f.Key)
+			}
+		case f.Type.Kind() == reflect.Int:
+			fmt.Fprintf(w, "        public %s %s(string value) => AddFilter(\"%s\", value);\n", ft.Name, f.MethodName(), f.Key)
+			fmt.Fprintln(w, "")
+			writeXMLComment(w, f.Comment, "        ")
+			fmt.Fprintf(w, "        public %s %s(int value) => AddFilter(\"%s\", value.ToString(CultureInfo.InvariantCulture));\n", ft.Name, f.MethodName(), f.Key)
+		case f.Type.Kind() == reflect.Bool:
+			fmt.Fprintf(w, "        public %s %s(bool value) => AddFilter(\"%s\", value ? \"true\" : \"false\");\n", ft.Name, f.MethodName(), f.Key)
+		default:
+			e := reflectedEnums[typeToKey(f.Type)]
+
+			fmt.Fprintf(w, "        public %s %s(%s value) => AddFilter(\"%s\", value switch\n", ft.Name, f.MethodName(), e.Name, f.Key)
+			fmt.Fprintln(w, "        {")
+			for _, m := range e.Members {
+				// The zero value is no filter value.
+				if m.Value == "" {
+					continue
+				}
+
+				fmt.Fprintf(w, "            %s.%s => \"%s\",\n", e.Name, m.Name, m.Value)
+			}
+			fmt.Fprintln(w, "            _ => throw new ArgumentOutOfRangeException(nameof(value), value, null),")
+			fmt.Fprintln(w, "        });")
+		}
+	}
+
+	fmt.Fprintln(w, "    }")
+	fmt.Fprintln(w, "}")
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/network"
+)
+
+// fixturesClassName is the xUnit test class that round-trips the fixtures.
+const fixturesClassName = "ModelRoundTripTests"
+
+// fixtureMaxDepth limits how deep populateFixture follows pointers, slices and maps,
+// so recursive types end with a zero value.
+const fixtureMaxDepth = 8
+
+// fixtureValues are the values of the Go types that are generated as a C#
+// string, DateTime or TimeSpan, chosen so they survive the round-trip.
+var fixtureValues = map[reflect.Type]func(r *rand.Rand) any{
+	reflect.TypeOf(net.IP{}): func(r *rand.Rand) any {
+		return net.IPv4(10, 0, byte(r.IntN(256)), byte(r.IntN(255)+1))
+	},
+	reflect.TypeOf(netip.Addr{}): func(r *rand.Rand) any {
+		return netip.AddrFrom4([4]byte{10, 0, byte(r.IntN(256)), byte(r.IntN(255) + 1)})
+	},
+	reflect.TypeOf(netip.Prefix{}): func(r *rand.Rand) any {
+		return netip.PrefixFrom(netip.AddrFrom4([4]byte{10, byte(r.IntN(256)), 0, 0}), 16)
+	},
+	reflect.TypeOf(network.HardwareAddr{}): func(r *rand.Rand) any {
+		return network.HardwareAddr{0x02, 0x42, 0xac, 0x11, 0x00, byte(r.IntN(256))}
+	},
+	reflect.TypeOf(network.Port{}): func(r *rand.Rand) any {
+		return network.MustParsePort(strconv.Itoa(r.IntN(65535)+1) + "/tcp")
+	},
+	// DateTime and TimeSpan have a precision of 100ns, whole seconds are exact.
+	reflect.TypeOf(time.Time{}): func(r *rand.Rand) any {
+		return time.Unix(1_600_000_000+r.Int64N(100_000_000), 0).UTC()
+	},
+	reflect.TypeOf(time.Duration(0)): func(r *rand.Rand) any {
+		return time.Duration(r.IntN(3600)+1) * time.Second
+	},
+	reflect.TypeOf([]byte(nil)): func(r *rand.Rand) any {
+		return []byte(fmt.Sprintf("bytes-%d", r.IntN(10000)))
+	},
+}
+
+// Fixture is the JSON encoding/json marshals for a Go type populated by
+// populateFixture, which the generated C# model has to round-trip.
+type Fixture struct {
+	Name  string
+	Model string
+	JSON  []byte
+}
+
+// modelFixtures returns a fixture of every reflected Go type that is sent or
+// received as a JSON body, sorted by model name. The parameter types, whose
+// properties are sent in the query string or headers, have none.
+func modelFixtures(roots []reflect.Type) []Fixture {
+	seen := map[string]reflect.Type{}
+	for _, t := range roots {
+		collectTypes(t, seen)
+	}
+
+	var fixtures []Fixture
+	for _, k := range sortedKeys(seen) {
+		t := seen[k]
+		m, ok := reflectedTypes[k]
+		if !ok || t.Kind() != reflect.Struct || len(m.Properties) == 0 {
+			continue
+		}
+
+		if hasParameterAttribute(t, "QueryString") || hasParameterAttribute(t, "Header") {
+			continue
+		}
+
+		v := reflect.New(t).Elem()
+		populateFixture(v, fixtureRand(k), "", 0)
+
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v.Interface()); err != nil {
+			reportError("marshal fixture of type (%s): %w", t, err)
+			continue
+		}
+
+		fixtures = append(fixtures, Fixture{Name: m.Name + ".Generated.json", Model: m.Name, JSON: b.Bytes()})
+	}
+
+	slices.SortFunc(fixtures, func(a, b Fixture) int {
+		return strings.Compare(a.Model, b.Model)
+	})
+
+	return fixtures
+}
+
+// fixtureRand returns the random source of the fixture of a type, seeded with
+// its key so a fixture only changes when its type does.
+func fixtureRand(key string) *rand.Rand {
+	h := fnv.New64a()
+	io.WriteString(h, key)
+	seed := h.Sum64()
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// populateFixture sets v and its fields to non-zero values, so encoding/json
+// writes every field, also the omitempty ones. enc is the time encoding of the
+// field v is the value of, if any.
+func populateFixture(v reflect.Value, r *rand.Rand, enc timeEncoding, depth int) {
+	if f, ok := fixtureValues[v.Type()]; ok {
+		v.Set(reflect.ValueOf(f(r)))
+		return
+	}
+
+	if populateEnum(v, r) {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if enc == timeEncodingRFC3339Nano {
+			v.SetString(time.Unix(1_600_000_000+r.Int64N(100_000_000), 0).UTC().Format(time.RFC3339))
+		} else {
+			v.SetString(fmt.Sprintf("value-%d", r.IntN(10000)))
+		}
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(r.IntN(100) + 1))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(r.IntN(100) + 1))
+	case reflect.Float32, reflect.Float64:
+		// Quarters are exact in binary, so both sides print them the same.
+		v.SetFloat(float64(r.IntN(400)+1) / 4)
+	case reflect.Ptr:
+		if depth < fixtureMaxDepth {
+			v.Set(reflect.New(v.Type().Elem()))
+			populateFixture(v.Elem(), r, enc, depth+1)
+		}
+	case reflect.Slice:
+		if depth < fixtureMaxDepth {
+			n := r.IntN(2) + 1
+			v.Set(reflect.MakeSlice(v.Type(), n, n))
+			for i := 0; i < n; i++ {
+				populateFixture(v.Index(i), r, "", depth+1)
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			populateFixture(v.Index(i), r, "", depth+1)
+		}
+	case reflect.Map:
+		if depth < fixtureMaxDepth {
+			v.Set(reflect.MakeMap(v.Type()))
+			key := reflect.New(v.Type().Key()).Elem()
+			value := reflect.New(v.Type().Elem()).Elem()
+			populateFixture(key, r, "", depth+1)
+			populateFixture(value, r, "", depth+1)
+			v.SetMapIndex(key, value)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !v.Field(i).CanSet() || strings.Split(f.Tag.Get("json"), ",")[0] == "-" {
+				continue
+			}
+
+			// Interface and raw JSON fields are unions, their members have fixtures of their own.
+			if _, ok := unionTypes[typeToKey(t)+"."+f.Name]; ok {
+				continue
+			}
+
+			fieldConfig := typeConfigs[configKey(t)]
+			var fpc *PropertyConfig
+			if fieldConfig != nil {
+				fpc = fieldConfig.Properties[f.Name]
+			}
+
+			if fpc != nil && fpc.Exclude {
+				continue
+			}
+
+			populateFixture(v.Field(i), r, fieldTimeEncoding(t, f, fpc), depth)
+		}
+	}
+}
+
+// populateEnum sets v to one of the constants declared for its type, if it is
+// generated as a C# enum, and reports whether it did.
+func populateEnum(v reflect.Value, r *rand.Rand) bool {
+	t := v.Type()
+	if !generatesCSType(t) || t.Kind() == reflect.Struct {
+		return false
+	}
+
+	consts := goEnumConsts[t.PkgPath()+"."+t.Name()]
+	c := consts[r.IntN(len(consts))]
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(c.Value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(c.Value, 0, 64)
+		if err != nil {
+			return false
+		}
+		v.SetInt(n)
+	default:
+		n, err := strconv.ParseUint(c.Value, 0, 64)
+		if err != nil {
+			return false
+		}
+		v.SetUint(n)
+	}
+
+	return true
+}
+
+// renderFixtures adds the fixtures and the test class that round-trips them
+// to the fixtures directory of the test project.
+func renderFixtures(files generatedFiles, fixturesPath string, fixtures []Fixture) {
+	for _, f := range fixtures {
+		files.add(filepath.Join(fixturesPath, f.Name), generatedFile{TypeName: f.Model, Kind: "fixture"}, func(w io.Writer) {
+			w.Write(f.JSON)
+		})
+	}
+
+	files.add(filepath.Join(fixturesPath, fixturesClassName+".Generated.cs"), generatedFile{TypeName: fixturesClassName, Kind: "class"}, func(w io.Writer) {
+		writeFixtureTests(w, fixtures)
+	})
+}
+
+// writeFixtureTests writes a test per fixture, which deserializes it into the
+// C# model, serializes the model and compares the JSON with the fixture.
+func writeFixtureTests(w io.Writer, fixtures []Fixture) {
+	fmt.Fprintln(w, "namespace Docker.DotNet.Tests;")
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "public sealed partial class %s\n", fixturesClassName)
+	fmt.Fprintln(w, "{")
+
+	for i, f := range fixtures {
+		if i > 0 {
+			fmt.Fprintln(w, "")
+		}
+
+		fmt.Fprintln(w, "    [Fact]")
+		fmt.Fprintf(w, "    public void RoundTrip_%s_MatchesGoJson()\n", f.Model)
+		fmt.Fprintln(w, "    {")
+		fmt.Fprintf(w, "        AssertRoundTrip<%s>(\"%s\");\n", f.Model, f.Name)
+		fmt.Fprintln(w, "    }")
+	}
+
+	fmt.Fprintln(w, "}")
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestModelFixtures populates the golden types and writes their fixtures and
+// the test class that round-trips them. The parameters have no fixture.
+func TestModelFixtures(t *testing.T) {
+	resetGeneratorState(t)
+	extractGoldenComments(t)
+
+	types := []reflect.Type{reflect.TypeOf(GoldenEnums{}), reflect.TypeOf(GoldenPointers{}), reflect.TypeOf(GoldenParameters{})}
+	for _, typ := range types {
+		reflectType(typ)
+	}
+
+	files := generatedFiles{}
+	renderFixtures(files, ".", modelFixtures(types))
+
+	compareGolden(t, "fixtures", writeGoldenFiles(files))
+}
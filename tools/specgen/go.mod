@@ -3,8 +3,11 @@ module github.com/dotnet/Docker.DotNet/tools/specgen
 go 1.24.0
 
 require (
+	github.com/moby/docker-image-spec v1.3.1
 	github.com/moby/moby/api v1.54.3-0.20260420162417-6c91b92cc710
 	github.com/moby/moby/client v0.4.2-0.20260420162417-6c91b92cc710
+	github.com/opencontainers/image-spec v1.1.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -18,9 +21,7 @@ require (
 	github.com/felixge/httpsnoop v1.0.4 // indirect
 	github.com/go-logr/logr v1.4.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
-	github.com/moby/docker-image-spec v1.3.1 // indirect
 	github.com/opencontainers/go-digest v1.0.0 // indirect
-	github.com/opencontainers/image-spec v1.1.1 // indirect
 	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.65.0 // indirect
 	go.opentelemetry.io/otel v1.40.0 // indirect
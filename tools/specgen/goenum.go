@@ -0,0 +1,349 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// GoEnumConst is a constant declared with a named Go type in one of the moby packages.
+type GoEnumConst struct {
+	Name    string
+	Value   string
+	Comment string
+}
+
+// goEnumConsts maps package.TypeName to the constants declared with that type, in declaration order.
+var goEnumConsts = map[string][]GoEnumConst{}
+
+// reflectedEnums maps the reflected Go type key to the C# enum generated for it.
+var reflectedEnums = map[string]*CSEnumType{}
+
+// unhandledEnumConsts maps package.TypeName to the constants declared with that
+// type whose value constTypeAndValue cannot evaluate, reported by reflectEnum.
+var unhandledEnumConsts = map[string][]string{}
+
+// goConstValues maps package.Name to the values of the constants evaluated so
+// far, so a constant can be declared as another one.
+var goConstValues = map[string]constant.Value{}
+
+// collectEnumConsts records every typed constant in a const block so that named
+// string and integer types can later be generated as C# enums.
+func collectEnumConsts(importPath string, d *ast.GenDecl) {
+	var lastType ast.Expr
+	var lastValues []ast.Expr
+
+	for index, spec := range d.Specs {
+		valueSpec, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		// A spec without a type and values repeats the previous one (implicit repetition).
+		typeExpr, values := valueSpec.Type, valueSpec.Values
+		if typeExpr == nil && len(values) == 0 {
+			typeExpr, values = lastType, lastValues
+		} else {
+			lastType, lastValues = typeExpr, values
+		}
+
+		for i, name := range valueSpec.Names {
+			if !name.IsExported() || i >= len(values) {
+				continue
+			}
+
+			typeName, value, ok := constTypeAndValue(importPath, typeExpr, values[i], index)
+			if typeName == "" {
+				continue
+			}
+
+			key := importPath + "." + typeName
+			if !ok {
+				unhandledEnumConsts[key] = append(unhandledEnumConsts[key], name.Name+" = "+types.ExprString(values[i]))
+				continue
+			}
+
+			goConstValues[importPath+"."+name.Name] = value
+			goEnumConsts[key] = append(goEnumConsts[key], GoEnumConst{
+				Name:    name.Name,
+				Value:   constantString(value),
+				Comment: qualifyDocLinks(importPath, commentText(valueSpec.Doc, valueSpec.Comment)),
+			})
+		}
+	}
+}
+
+// constTypeAndValue resolves the local type name and value of a constant
+// declared either as `X Type = v` or `X = Type(v)`. The type name is empty for
+// an untyped constant, ok is false if v is not a constant expression of
+// literals, iota and the constants of the package, e.g. `1 << iota`.
+func constTypeAndValue(importPath string, typeExpr, valueExpr ast.Expr, index int) (string, constant.Value, bool) {
+	if typeExpr == nil {
+		call, ok := valueExpr.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return "", nil, false
+		}
+
+		typeExpr, valueExpr = call.Fun, call.Args[0]
+	}
+
+	typeIdent, ok := typeExpr.(*ast.Ident)
+	if !ok {
+		return "", nil, false
+	}
+
+	value, ok := evalConstExpr(importPath, valueExpr, index)
+	if !ok || value.Kind() != constant.String && value.Kind() != constant.Int {
+		return typeIdent.Name, nil, false
+	}
+
+	return typeIdent.Name, value, true
+}
+
+// evalConstExpr evaluates a constant expression of literals, iota and the
+// constants of the package collected so far.
+func evalConstExpr(importPath string, expr ast.Expr, iota int) (constant.Value, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		v := constant.MakeFromLiteral(e.Value, e.Kind, 0)
+		return v, v.Kind() != constant.Unknown
+	case *ast.Ident:
+		if e.Name == "iota" {
+			return constant.MakeInt64(int64(iota)), true
+		}
+
+		v, ok := goConstValues[importPath+"."+e.Name]
+		return v, ok
+	case *ast.ParenExpr:
+		return evalConstExpr(importPath, e.X, iota)
+	case *ast.CallExpr:
+		// A conversion to a type of the package, e.g. Mode(1 << iota).
+		if _, ok := e.Fun.(*ast.Ident); !ok || len(e.Args) != 1 {
+			return nil, false
+		}
+
+		return evalConstExpr(importPath, e.Args[0], iota)
+	case *ast.UnaryExpr:
+		x, ok := evalConstExpr(importPath, e.X, iota)
+		if !ok {
+			return nil, false
+		}
+
+		return constant.UnaryOp(e.Op, x, 0), true
+	case *ast.BinaryExpr:
+		x, ok := evalConstExpr(importPath, e.X, iota)
+		if !ok {
+			return nil, false
+		}
+
+		y, ok := evalConstExpr(importPath, e.Y, iota)
+		if !ok {
+			return nil, false
+		}
+
+		switch e.Op {
+		case token.SHL, token.SHR:
+			n, ok := constant.Uint64Val(y)
+			if !ok {
+				return nil, false
+			}
+			return constant.Shift(x, e.Op, uint(n)), true
+		case token.QUO:
+			if x.Kind() == constant.Int && y.Kind() == constant.Int {
+				if constant.Sign(y) == 0 {
+					return nil, false
+				}
+				return constant.BinaryOp(x, token.QUO_ASSIGN, y), true
+			}
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ, token.LAND, token.LOR:
+			return nil, false
+		}
+
+		v := constant.BinaryOp(x, e.Op, y)
+		return v, v.Kind() != constant.Unknown
+	}
+
+	return nil, false
+}
+
+// constantString formats the value of a string or integer constant.
+func constantString(v constant.Value) string {
+	if v.Kind() == constant.String {
+		return constant.StringVal(v)
+	}
+
+	return v.ExactString()
+}
+
+// isEnumKind reports whether values of kind k can be represented by a C# enum.
+func isEnumKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+
+	return false
+}
+
+// reflectEnum returns the C# enum for a named Go type with declared constants,
+// creating it on first use. It returns nil if t should not become an enum.
+func reflectEnum(t reflect.Type) *CSEnumType {
+	if t.Name() == "" || !isEnumKind(t.Kind()) {
+		return nil
+	}
+
+	k := typeToKey(t)
+	if e, ok := reflectedEnums[k]; ok {
+		return e
+	}
+
+	consts := goEnumConsts[t.PkgPath()+"."+t.Name()]
+	if len(consts) == 0 {
+		return nil
+	}
+
+	tc := typeConfig(t)
+	if tc != nil && tc.OpenValues {
+		return nil
+	}
+
+	// An enum without all the constants of its type would not read the ones it
+	// misses, the type stays a string or integer until specgen evaluates them.
+	// The constants are reported once, the entry stays to keep the type one.
+	if unhandled, ok := unhandledEnumConsts[t.PkgPath()+"."+t.Name()]; ok {
+		if len(unhandled) > 0 {
+			reportError("enum (%s) has constants that cannot be evaluated: %s", t, strings.Join(unhandled, ", "))
+			unhandledEnumConsts[t.PkgPath()+"."+t.Name()] = nil
+		}
+
+		return nil
+	}
+
+	e := &CSEnumType{
+		Name:       csTypeName(t, tc),
+		SourceName: t.String(),
+		Comment:    getTypeComment(t),
+		IsString:   t.Kind() == reflect.String,
+	}
+
+	used := map[string]bool{}
+	hasZero := false
+	for _, c := range consts {
+		if c.Value == "" {
+			hasZero = true
+		}
+	}
+
+	// Go's zero value for a string type is "", which the daemon sends for unset
+	// fields, so it needs a member even if moby does not declare a constant for it.
+	// The member comes last, so the constants keep the values of the hand-written
+	// enums, unless the config declares it first as a hand-written enum did.
+	undefined := e.IsString && !hasZero
+	undefinedFirst := undefined && tc != nil && tc.UndefinedFirst
+	if undefined {
+		used["Undefined"] = true
+	}
+
+	if undefinedFirst {
+		e.Members = append(e.Members, CSEnumMember{Name: "Undefined", Value: ""})
+	}
+
+	for _, c := range consts {
+		name := enumMemberName(t.Name(), c, consts, e.IsString)
+		if used[name] {
+			name = trimConstPrefix(t.Name(), c.Name, consts)
+		}
+		used[name] = true
+
+		e.Members = append(e.Members, CSEnumMember{Name: name, Value: c.Value, Comment: c.Comment})
+	}
+
+	if undefined && !undefinedFirst {
+		e.Members = append(e.Members, CSEnumMember{Name: "Undefined", Value: ""})
+	}
+
+	reflectedEnums[k] = e
+	return e
+}
+
+// enumMemberName derives the C# member name. String enums are named after their
+// wire value (e.g. "on-failure" becomes OnFailure); values that do not form a
+// valid identifier and integer enums fall back to the Go constant name.
+func enumMemberName(typeName string, c GoEnumConst, consts []GoEnumConst, isString bool) string {
+	if isString {
+		if c.Value == "" {
+			return "Undefined"
+		}
+
+		if name := pascalCase(c.Value); name != "" && !unicode.IsDigit(rune(name[0])) {
+			return name
+		}
+	}
+
+	return trimConstPrefix(typeName, c.Name, consts)
+}
+
+// trimConstPrefix removes the type name or the prefix shared by all constants
+// of the type from a constant name (e.g. TaskStateNew becomes New).
+func trimConstPrefix(typeName, constName string, consts []GoEnumConst) string {
+	if s := strings.TrimPrefix(constName, typeName); s != constName && s != "" {
+		return s
+	}
+
+	if len(consts) < 2 {
+		return constName
+	}
+
+	prefix := consts[0].Name
+	for _, c := range consts[1:] {
+		for !strings.HasPrefix(c.Name, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	// Only cut at a word boundary so ChangeModify/ChangeAdd become Modify/Add.
+	for len(prefix) > 0 {
+		rest := constName[len(prefix):]
+		if rest != "" && unicode.IsUpper(rune(rest[0])) {
+			for _, c := range consts {
+				if r := c.Name[len(prefix):]; r == "" || !unicode.IsUpper(rune(r[0])) {
+					rest = ""
+					break
+				}
+			}
+			if rest != "" {
+				return rest
+			}
+		}
+		prefix = prefix[:len(prefix)-1]
+	}
+
+	return constName
+}
+
+// pascalCase converts a wire value such as "rollback_started" to RollbackStarted.
+func pascalCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/swarm"
+)
+
+// TestHandWrittenEnumValues checks that the enums generated for the Go types of
+// the enums that used to be hand-written declare their members in the same
+// order, so each member keeps its value.
+func TestHandWrittenEnumValues(t *testing.T) {
+	resetGeneratorState(t)
+
+	cfg, err := loadConfig("specgen.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configs := typeConfigs
+	typeConfigs = cfg.Types
+	t.Cleanup(func() { typeConfigs = configs })
+
+	const module = "github.com/moby/moby/api"
+	modulePath, err := findGoModulePath(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := extractGoCommentsRecursive(filepath.Join(modulePath, "types"), module+"/types"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		typ reflect.Type
+		// want are the members of the hand-written enum, in order.
+		want []string
+	}{
+		{reflect.TypeOf(swarm.TaskState("")), []string{
+			"New", "Allocated", "Pending", "Assigned", "Accepted", "Preparing", "Ready", "Starting",
+			"Running", "Complete", "Shutdown", "Failed", "Rejected", "Remove", "Orphaned",
+		}},
+		{reflect.TypeOf(container.RestartPolicyMode("")), []string{"Undefined", "No", "Always", "OnFailure", "UnlessStopped"}},
+		{reflect.TypeOf(container.ChangeType(0)), []string{"Modify", "Add", "Delete"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			e := reflectEnum(tt.typ)
+			if e == nil {
+				t.Fatalf("reflectEnum(%s) = nil", tt.typ)
+			}
+
+			var got []string
+			for _, m := range e.Members {
+				got = append(got, m.Name)
+			}
+
+			// Members added since are appended and do not change the values.
+			if len(got) < len(tt.want) || !slices.Equal(got[:len(tt.want)], tt.want) {
+				t.Errorf("members of %s = %v, want %v first", e.Name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,539 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// GoldenBase is embedded by GoldenEmbedded.
+type GoldenBase struct {
+	// ID is the unique identifier.
+	ID     string
+	Labels map[string]string
+}
+
+// GoldenEmbedded inlines the members of its anonymous fields.
+type GoldenEmbedded struct {
+	GoldenBase
+	*GoldenChild
+
+	Name string
+}
+
+// GoldenChild is referenced by other golden types.
+type GoldenChild struct {
+	Value string `json:"value"`
+}
+
+type GoldenInline struct {
+	Config struct {
+		Image string
+		Tty   bool `json:",omitempty"`
+	}
+}
+
+type GoldenPointers struct {
+	Count    *int
+	Child    *GoldenChild
+	Children []*GoldenChild
+	Started  *time.Time
+	Timeout  time.Duration
+	Raw      []byte
+}
+
+type GoldenSets struct {
+	ExposedPorts map[string]struct{}
+	Volumes      map[string]struct{} `json:",omitempty"`
+	Nested       map[string]map[string]bool
+}
+
+type GoldenParameters struct {
+	All      bool                       `rest:"query"`
+	Limit    int                        `rest:"query,limit,,10"`
+	Names    []string                   `rest:"query,name,required"`
+	Filters  map[string]map[string]bool `rest:"query"`
+	Auth     GoldenChild                `rest:"header,X-Registry-Auth"`
+	Token    string                     `rest:"headers,X-Token,required"`
+	Platform string                     `rest:"headers,X-Platform" json:",omitempty"`
+	Body     *GoldenChild               `rest:"body"`
+}
+
+type GoldenJSON struct {
+	Skipped  string `json:"-"`
+	Renamed  string `json:"renamed"`
+	Optional string `json:"optional,omitempty"`
+	Plain    int64
+	Any      interface{}
+}
+
+// GoldenMode is the mode of a golden type.
+type GoldenMode string
+
+const (
+	// GoldenModeDefault is the default mode.
+	GoldenModeDefault GoldenMode = "default"
+	GoldenModeOnFail  GoldenMode = "on-failure"
+)
+
+type GoldenEnums struct {
+	Mode  GoldenMode
+	Modes []GoldenMode
+}
+
+// GoldenConstants has enums whose constants are declared with expressions.
+type GoldenConstants struct {
+	Priority GoldenPriority
+	Flags    GoldenFlags
+	Timeout  GoldenTimeout
+}
+
+// GoldenPriority starts at one.
+type GoldenPriority int
+
+const (
+	GoldenPriorityLow GoldenPriority = iota + 1
+	GoldenPriorityHigh
+	GoldenPriorityDefault = GoldenPriorityLow
+)
+
+// GoldenFlags are bit flags.
+type GoldenFlags uint8
+
+const (
+	GoldenFlagRead GoldenFlags = 1 << iota
+	GoldenFlagWrite
+	GoldenFlagAll = GoldenFlags(GoldenFlagRead | GoldenFlagWrite)
+)
+
+// GoldenTimeout is declared in another unit, which specgen cannot evaluate.
+type GoldenTimeout int64
+
+const (
+	GoldenTimeoutShort GoldenTimeout = 1
+	GoldenTimeoutLong  GoldenTimeout = GoldenTimeout(time.Minute)
+)
+
+// GoldenLevel declares its zero value after another constant.
+type GoldenLevel string
+
+const (
+	GoldenLevelHigh  GoldenLevel = "high"
+	GoldenLevelUnset GoldenLevel = ""
+)
+
+type GoldenNullability struct {
+	Struct   GoldenChild `json:",omitempty"`
+	OmitZero GoldenChild `json:",omitzero"`
+	Started  time.Time   `json:",omitempty"`
+	Mode     GoldenMode  `json:",omitempty"`
+	Level    GoldenLevel `json:",omitempty"`
+	Size     int64       `json:",omitempty"`
+	Limit    *int64      `json:",omitempty"`
+	Address  net.IP      `json:",omitempty"`
+}
+
+// GoldenUnion holds a GoldenChild or a GoldenBase.
+type GoldenUnion struct {
+	// Payload is either a child or a base.
+	Payload interface{} `json:"payload,omitempty"`
+}
+
+// GoldenDeprecated has deprecated fields.
+//
+// Deprecated: use GoldenChild instead.
+type GoldenDeprecated struct {
+	// Name is the name.
+	Name string
+	// IsLegacy reports whether the name is a legacy one.
+	//
+	// Deprecated: the "is_legacy" field is always false,
+	// use Name instead.
+	IsLegacy bool `json:"is_legacy"`
+}
+
+// GoldenDocs is documented with the syntax of Go doc comments. It embeds
+// a [GoldenBase], whose [GoldenBase.ID] is set, and refers to a [GoldenMode].
+//
+// The values are read by [encoding/json.Unmarshal], see https://go.dev/doc/comment
+// or [the spec] for the syntax:
+//
+//	{"Source": "golden"}
+//
+// # Values
+//
+// Every value is either:
+//   - a plain <string>, or
+//   - a [GoldenChild].
+//
+// [the spec]: https://go.dev/ref/spec
+type GoldenDocs struct {
+	GoldenBase
+
+	// Source is copied from [GoldenChild.Value] and never from [GoldenChild.Missing].
+	Source string
+}
+
+// WaitExitError has the same name as container.WaitExitError.
+type WaitExitError struct {
+	Code int
+}
+
+// GoldenCollision refers to two types with the same name.
+type GoldenCollision struct {
+	Local WaitExitError
+	Moby  container.WaitExitError
+}
+
+// GoldenConfig is customized by testdata/config.yaml.
+type GoldenConfig struct {
+	Timeout  int64
+	Internal string
+	Created  string `json:",omitempty"`
+	Mode     GoldenMode
+	Level    GoldenLevel
+	Secret   string `json:"-"`
+	Born     int64
+	Elapsed  time.Duration
+	Expires  string
+	Stamp    time.Time
+	Count    int64
+}
+
+// GoldenListParameters has a filters query parameter.
+type GoldenListParameters struct {
+	All     bool `rest:"query"`
+	Filters Args `rest:"query"`
+}
+
+// GoldenRemoveParameters is expanded into the arguments of its operation.
+type GoldenRemoveParameters struct {
+	Force bool `rest:"query"`
+}
+
+type GoldenInvalid struct {
+	Cookie string `rest:"cookie,session"`
+	Valid  string
+}
+
+type GoldenVersioned struct {
+	Name  string
+	Added string
+}
+
+type GoldenVersionedParameters struct {
+	All   bool   `rest:"query"`
+	Limit int    `rest:"query"`
+	Token string `rest:"header,X-Token"`
+}
+
+type GoldenNew struct {
+	Value string
+}
+
+// GoldenTimes has the time and duration fields whose encoding specgen infers.
+type GoldenTimes struct {
+	Started  time.Time
+	Finished *time.Time
+	Interval time.Duration `json:",omitempty"`
+	// Grace is the time to wait (in seconds) before the golden is killed.
+	Grace int
+	// Created is the creation time as a Unix timestamp.
+	Created int64
+	// Updated is the time of the last update in RFC 3339 format.
+	Updated string `json:",omitempty"`
+	Expires string
+	// Ticks is the number of ticks since the golden started.
+	Ticks int64
+}
+
+// annotateGoldenApiVersions derives the API versions of the golden types from testdata/apiversions.
+func annotateGoldenApiVersions(t *testing.T) {
+	specs, err := loadVersionedSwaggers(filepath.Join("testdata", "apiversions"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes := []Route{
+		{Method: "GET", Path: "/golden/{name}",
+			Parameters: reflect.TypeOf(GoldenVersionedParameters{}),
+			Response:   reflect.TypeOf(GoldenVersioned{})},
+		{Method: "POST", Path: "/golden/new",
+			Response: reflect.TypeOf(GoldenNew{})},
+	}
+
+	annotateApiVersions(specs, routes, reflectedTypes, func(m *CSModelType) (string, bool) {
+		return m.Name, true
+	})
+}
+
+// goldenStyle declares the golden types that are only responses with style.
+func goldenStyle(style CSModelStyle) func(t *testing.T) {
+	return func(t *testing.T) {
+		routes := []Route{
+			{Method: "GET", Path: "/golden",
+				Parameters: reflect.TypeOf(GoldenParameters{}),
+				Response:   reflect.TypeOf(GoldenPointers{})},
+		}
+
+		applyResponseStyle(routes, reflectedTypes, style)
+	}
+}
+
+// reflectGoldenFilters declares the filters of the golden routes from testdata/filters.yaml.
+func reflectGoldenFilters(t *testing.T) {
+	spec, err := loadSwagger(filepath.Join("testdata", "filters.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes := []Route{
+		{Method: "GET", Path: "/golden",
+			Parameters: reflect.TypeOf(GoldenListParameters{})},
+	}
+
+	reflectFilters(routes, spec, map[string]reflect.Type{
+		"GET /golden count":    reflect.TypeOf(0),
+		"GET /golden dangling": reflect.TypeOf(false),
+		"GET /golden mode":     reflect.TypeOf(GoldenMode("")),
+		"GET /golden stale":    reflect.TypeOf(false),
+	})
+}
+
+// useGoldenConfig customizes the golden types with testdata/config.yaml, which
+// has entries for a type and a field that do not exist and for a type and a
+// field that are not generated, and checks it after the types are reflected.
+func useGoldenConfig() (before, after func(t *testing.T)) {
+	var cfg *Config
+
+	before = func(t *testing.T) {
+		var err error
+		cfg, err = loadConfig(filepath.Join("testdata", "config.yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		configs := typeConfigs
+		typeConfigs = cfg.Types
+		t.Cleanup(func() { typeConfigs = configs })
+	}
+
+	after = func(t *testing.T) {
+		validateConfig(cfg, goTypeFields)
+	}
+
+	return before, after
+}
+
+// useGoldenTimes declares the swagger definitions of testdata/times.yaml, from
+// which the encoding of the GoldenTimes fields is inferred next to their Go
+// types and their docs.
+func useGoldenTimes(t *testing.T) {
+	spec, err := loadSwagger(filepath.Join("testdata", "times.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definitions := swaggerDefinitions
+	swaggerDefinitions = spec.Definitions
+	swaggerDefinitionNames["main.GoldenTimes"] = "GoldenTimes"
+	t.Cleanup(func() {
+		swaggerDefinitions = definitions
+		delete(swaggerDefinitionNames, "main.GoldenTimes")
+	})
+}
+
+// TestGenerateGolden compares the C# generated for each case with
+// testdata/<name>.golden. The cases read the swagger documents and the
+// configuration they need from testdata as well.
+func TestGenerateGolden(t *testing.T) {
+	unionKey := typeToKey(reflect.TypeOf(GoldenUnion{})) + ".Payload"
+	unionTypes[unionKey] = &CSUnionType{
+		Name: "GoldenPayload",
+		Members: []CSUnionMember{
+			{Type: reflect.TypeOf(GoldenChild{}), Property: "value"},
+			{Type: reflect.TypeOf(GoldenBase{}), Property: "ID"},
+		},
+	}
+	rawKey := typeToKey(reflect.TypeOf(GoldenJSON{})) + ".Any"
+	rawJSONFields[rawKey] = true
+	t.Cleanup(func() {
+		delete(unionTypes, unionKey)
+		delete(rawJSONFields, rawKey)
+	})
+
+	configBefore, configAfter := useGoldenConfig()
+
+	tests := []struct {
+		name  string
+		types []reflect.Type
+		// before runs before the generator state is reset, after runs after the types are reflected.
+		before, after func(t *testing.T)
+	}{
+		{"embedded", []reflect.Type{reflect.TypeOf(GoldenEmbedded{})}, nil, nil},
+		{"inline", []reflect.Type{reflect.TypeOf(GoldenInline{})}, nil, nil},
+		{"pointers", []reflect.Type{reflect.TypeOf(GoldenPointers{})}, nil, nil},
+		{"sets", []reflect.Type{reflect.TypeOf(GoldenSets{})}, nil, nil},
+		{"parameters", []reflect.Type{reflect.TypeOf(GoldenParameters{})}, nil, nil},
+		{"json", []reflect.Type{reflect.TypeOf(GoldenJSON{})}, nil, nil},
+		{"enums", []reflect.Type{reflect.TypeOf(GoldenEnums{})}, nil, nil},
+		{"constants", []reflect.Type{reflect.TypeOf(GoldenConstants{})}, nil, nil},
+		{"nullability", []reflect.Type{reflect.TypeOf(GoldenNullability{})}, nil, nil},
+		{"unions", []reflect.Type{reflect.TypeOf(GoldenUnion{})}, nil, nil},
+		{"deprecated", []reflect.Type{reflect.TypeOf(GoldenDeprecated{})}, nil, nil},
+		{"docs", []reflect.Type{reflect.TypeOf(GoldenDocs{}), reflect.TypeOf(GoldenChild{}), reflect.TypeOf(GoldenEnums{})}, nil, nil},
+		{"filters", []reflect.Type{reflect.TypeOf(GoldenListParameters{})}, nil, reflectGoldenFilters},
+		{"invalid", []reflect.Type{reflect.TypeOf(GoldenInvalid{})}, nil, nil},
+		{"init", []reflect.Type{reflect.TypeOf(GoldenParameters{}), reflect.TypeOf(GoldenPointers{})}, nil, goldenStyle(CSModelStyleInit)},
+		{"records", []reflect.Type{reflect.TypeOf(GoldenParameters{}), reflect.TypeOf(GoldenPointers{})}, nil, goldenStyle(CSModelStyleRecord)},
+		{"apiversions", []reflect.Type{reflect.TypeOf(GoldenVersioned{}), reflect.TypeOf(GoldenVersionedParameters{}), reflect.TypeOf(GoldenNew{})}, nil, annotateGoldenApiVersions},
+		{"config", []reflect.Type{reflect.TypeOf(GoldenConfig{})}, configBefore, configAfter},
+		{"times", []reflect.Type{reflect.TypeOf(GoldenTimes{})}, useGoldenTimes, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.before != nil {
+				tt.before(t)
+			}
+
+			compareGolden(t, tt.name, generateGolden(t, tt.types, tt.after))
+		})
+	}
+}
+
+// compareGolden compares got with testdata/<name>.golden, or writes it with -update.
+func compareGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	name = filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(name, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		var diff bytes.Buffer
+		writeUnifiedDiff(&diff, name, "generated", want, got)
+		t.Errorf("generated code does not match %s (run go test -update to accept it):\n%s", name, &diff)
+	}
+}
+
+// generateGolden reflects types with a clean generator state and returns the
+// generated files and errors in the order specgen writes them.
+func generateGolden(t *testing.T, types []reflect.Type, after func(t *testing.T)) []byte {
+	t.Helper()
+
+	resetGeneratorState(t)
+	extractGoldenComments(t)
+
+	for _, typ := range types {
+		reflectType(typ)
+	}
+
+	if after != nil {
+		after(t)
+	}
+
+	files := generatedFiles{}
+	renderModels(files, ".", reflectedTypes)
+	for _, k := range sortedKeys(reflectedEnums) {
+		e := reflectedEnums[k]
+		files.add(e.Name+".Generated.cs", generatedFile{TypeName: e.Name, Kind: "enum", Key: k, SourceName: e.SourceName}, e.Write)
+	}
+
+	renderUnions(files, ".")
+	renderFilters(files, ".")
+
+	return writeGoldenFiles(files)
+}
+
+// writeGoldenFiles concatenates the files by name, followed by the generation
+// errors, into the content compared with a golden file.
+func writeGoldenFiles(files generatedFiles) []byte {
+	var b bytes.Buffer
+	for _, name := range files.names() {
+		fmt.Fprintf(&b, "// ---- %s ----\n", name)
+		b.Write(files[name].Content)
+	}
+
+	for _, err := range generationErrors {
+		fmt.Fprintf(&b, "// error: %v\n", err)
+	}
+
+	return b.Bytes()
+}
+
+// extractGoldenComments reads the comments and enum constants of the golden
+// types, which are declared in this file, the same way as those of the moby packages.
+func extractGoldenComments(t *testing.T) {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "golden_test.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test binary imports this package by its module path, not as main.
+	extractGoComments(reflect.TypeOf(GoldenBase{}).PkgPath(), file)
+}
+
+// resetGeneratorState clears the global state of the generator for the test
+// and restores it afterwards.
+func resetGeneratorState(t *testing.T) {
+	t.Helper()
+
+	types, enums, unions, filters, consts := reflectedTypes, reflectedEnums, reflectedUnions, reflectedFilters, goEnumConsts
+	unhandled, values := unhandledEnumConsts, goConstValues
+	tComments, fComments, packages, fields := typeComments, fieldComments, goPackageNames, goTypeFields
+	errs, usings, used, names := generationErrors, GlobalUsings, usedConfigs, resolvedNames
+
+	t.Cleanup(func() {
+		reflectedTypes, reflectedEnums, reflectedUnions, reflectedFilters, goEnumConsts = types, enums, unions, filters, consts
+		unhandledEnumConsts, goConstValues = unhandled, values
+		typeComments, fieldComments, goPackageNames, goTypeFields = tComments, fComments, packages, fields
+		generationErrors, GlobalUsings, usedConfigs, resolvedNames = errs, usings, used, names
+	})
+
+	reflectedTypes = map[string]*CSModelType{}
+	reflectedEnums = map[string]*CSEnumType{}
+	reflectedUnions = map[string]*CSUnionType{}
+	reflectedFilters = map[string]*CSFiltersType{}
+	goEnumConsts = map[string][]GoEnumConst{}
+	unhandledEnumConsts = map[string][]string{}
+	goConstValues = map[string]constant.Value{}
+	typeComments = map[string]string{}
+	fieldComments = map[string]string{}
+	goPackageNames = map[string]string{}
+	goTypeFields = map[string][]string{}
+	usedConfigs = map[string]bool{}
+	resolvedNames = map[string]string{}
+	generationErrors = nil
+
+	// A few namespaces are global in Docker.DotNet.csproj, the others have to be imported.
+	GlobalUsings = map[string]bool{
+		"System":                     true,
+		"System.Collections.Generic": true,
+	}
+}
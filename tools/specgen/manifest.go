@@ -0,0 +1,129 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"reflect"
+	"slices"
+)
+
+// manifestFileName is the name of the manifest written next to the generated models.
+const manifestFileName = "specgen-manifest.json"
+
+// manifest lists every generated C# type, so a review of a moby update shows
+// why each model exists and which files changed.
+type manifest struct {
+	Types []manifestType `json:"types"`
+}
+
+type manifestType struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// Source is the Go type the C# type is generated from, e.g. container.Config.
+	Source string `json:"source,omitempty"`
+	// File is the path of the generated file, relative to the manifest.
+	File   string   `json:"file"`
+	Routes []string `json:"routes,omitempty"`
+	SHA256 string   `json:"sha256"`
+}
+
+// routeTypeKeys maps the key of every Go type reachable from one of routes to the routes it is reachable from.
+func routeTypeKeys(routes []Route) map[string][]Route {
+	keys := map[string][]Route{}
+	for _, r := range routes {
+		seen := map[string]reflect.Type{}
+		for _, t := range append([]reflect.Type{r.Parameters, r.Response}, r.Models...) {
+			if t != nil {
+				collectTypes(t, seen)
+			}
+		}
+
+		for k := range seen {
+			keys[k] = append(keys[k], r)
+		}
+	}
+
+	return keys
+}
+
+// collectTypes adds t and the types of its fields to seen by their key,
+// following the same members as reflectTypeMembers.
+func collectTypes(t reflect.Type, seen map[string]reflect.Type) {
+	t = ultimateType(t)
+	if _, ok := CSCustomTypeMap[t]; ok {
+		return
+	}
+
+	k := typeToKey(t)
+	if _, ok := seen[k]; ok {
+		return
+	}
+
+	seen[k] = t
+
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+
+		collectTypes(f.Type, seen)
+
+		if u, ok := unionTypes[typeToKey(t)+"."+f.Name]; ok {
+			for _, m := range u.Members {
+				collectTypes(m.Type, seen)
+			}
+		}
+	}
+}
+
+// renderManifest adds the manifest of all files added so far. The routes of
+// types generated from Go types are looked up with routeTypeKeys.
+func renderManifest(files generatedFiles, name string) {
+	typeRoutes := routeTypeKeys(routes)
+
+	var m manifest
+	for _, fileName := range files.names() {
+		f := files[fileName]
+
+		rel, err := filepath.Rel(filepath.Dir(name), fileName)
+		if err != nil {
+			rel = fileName
+		}
+
+		fileRoutes := slices.Clone(f.Routes)
+		if f.Key != "" {
+			fileRoutes = nil
+			for _, r := range typeRoutes[f.Key] {
+				fileRoutes = append(fileRoutes, r.String())
+			}
+		}
+
+		sum := sha256.Sum256(f.Content)
+		m.Types = append(m.Types, manifestType{
+			Name:   f.TypeName,
+			Kind:   f.Kind,
+			Source: f.SourceName,
+			File:   filepath.ToSlash(rel),
+			Routes: fileRoutes,
+			SHA256: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	files.add(name, generatedFile{}, func(w io.Writer) {
+		b, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			reportError("render %s: %w", filepath.Base(name), err)
+			return
+		}
+
+		w.Write(append(b, '\n'))
+	})
+}
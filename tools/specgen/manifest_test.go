@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// TestRenderManifest compares the manifest of a few files with the golden
+// file, and checks that it does not depend on the order the files are added in.
+func TestRenderManifest(t *testing.T) {
+	saved := routes
+	t.Cleanup(func() { routes = saved })
+
+	routes = []Route{
+		{Method: "GET", Path: "/golden", Response: reflect.TypeOf([]GoldenEmbedded{})},
+		{Method: "GET", Path: "/golden/{id}", Response: reflect.TypeOf(GoldenChild{})},
+		{Method: "DELETE", Path: "/golden/{id}", Parameters: reflect.TypeOf(GoldenRemoveParameters{})},
+	}
+
+	type file struct {
+		name    string
+		file    generatedFile
+		content string
+	}
+
+	files := []file{
+		{
+			name:    filepath.Join("Models", "GoldenEmbedded.Generated.cs"),
+			file:    generatedFile{TypeName: "GoldenEmbedded", Kind: "class", Key: typeToKey(reflect.TypeOf(GoldenEmbedded{})), SourceName: "main.GoldenEmbedded"},
+			content: "embedded\n",
+		},
+		{
+			name:    filepath.Join("Models", "GoldenChild.Generated.cs"),
+			file:    generatedFile{TypeName: "GoldenChild", Kind: "class", Key: typeToKey(reflect.TypeOf(GoldenChild{})), SourceName: "main.GoldenChild"},
+			content: "child\n",
+		},
+		{
+			name:    filepath.Join("Models", "GoldenRemoveParameters.Generated.cs"),
+			file:    generatedFile{TypeName: "GoldenRemoveParameters", Kind: "class", Key: typeToKey(reflect.TypeOf(GoldenRemoveParameters{})), SourceName: "main.GoldenRemoveParameters"},
+			content: "remove\n",
+		},
+		{
+			name:    filepath.Join("Models", "DockerModelsJsonSerializerContext.Generated.cs"),
+			file:    generatedFile{TypeName: "DockerModelsJsonSerializerContext", Kind: "class"},
+			content: "context\n",
+		},
+		{
+			name:    filepath.Join("Endpoints", "IGoldenOperations.Generated.cs"),
+			file:    generatedFile{TypeName: "IGoldenOperations", Kind: "interface", Routes: []string{"GET /golden", "DELETE /golden/{id}"}},
+			content: "interface\n",
+		},
+	}
+
+	render := func(files []file) []byte {
+		resetGeneratorState(t)
+
+		generated := generatedFiles{}
+		for _, f := range files {
+			generated.add(f.name, f.file, func(w io.Writer) { io.WriteString(w, f.content) })
+		}
+
+		name := filepath.Join("Models", manifestFileName)
+		renderManifest(generated, name)
+
+		if len(generationErrors) != 0 {
+			t.Fatalf("errors = %v", generationErrors)
+		}
+
+		return generated[name].Content
+	}
+
+	got := render(files)
+	compareGolden(t, "manifest", got)
+
+	reversed := slices.Clone(files)
+	slices.Reverse(reversed)
+
+	if again := render(reversed); !bytes.Equal(again, got) {
+		t.Errorf("manifest depends on the order of the files:\n%s\nwant:\n%s", again, got)
+	}
+}
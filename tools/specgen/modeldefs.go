@@ -13,6 +13,13 @@ import (
 // Args map
 type Args map[string]map[string]bool
 
+// PushResult is the aux data of the progress of POST /images/{name}/push for a pushed tag.
+type PushResult struct {
+	Tag    string
+	Digest string
+	Size   int
+}
+
 // ImageBuildParameters for POST /build
 type ImageBuildParameters struct {
 	Tags           []string                       `rest:"query,t"`
@@ -333,6 +340,11 @@ type VolumesPruneParameters struct {
 	Filters Args `rest:"query"`
 }
 
+// VolumeRemoveParameters for DELETE /volumes/(name)
+type VolumeRemoveParameters struct {
+	Force bool `rest:"query"`
+}
+
 // VolumeResponse for GET /volumes
 type VolumeResponse volume.Volume
 
@@ -0,0 +1,447 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// modelDiffReport lists the changes of the models and query parameters
+// between two swagger documents, usually those of two moby releases.
+type modelDiffReport struct {
+	Old        string            `json:"old"`
+	New        string            `json:"new"`
+	Types      []typeChange      `json:"types"`
+	Properties []propertyChange  `json:"properties"`
+	Parameters []parameterChange `json:"parameters"`
+}
+
+// typeChange is an added or removed model.
+type typeChange struct {
+	Kind string `json:"kind"`
+	// Type is the swagger definition or inline model, DotNetType the C# model generated for it, if any.
+	Type       string `json:"type"`
+	DotNetType string `json:"dotnetType,omitempty"`
+	Breaking   bool   `json:"breaking"`
+}
+
+// propertyChange is an added, removed or retyped property, or a property whose
+// JSON name changed (renamed). Old and New are the C# types, or the JSON names
+// of a renamed property.
+type propertyChange struct {
+	Kind       string `json:"kind"`
+	Type       string `json:"type"`
+	DotNetType string `json:"dotnetType,omitempty"`
+	Property   string `json:"property"`
+	Old        string `json:"old,omitempty"`
+	New        string `json:"new,omitempty"`
+	Breaking   bool   `json:"breaking"`
+}
+
+// parameterChange is an added, removed or retyped query parameter of a route
+// both documents declare.
+type parameterChange struct {
+	Kind  string `json:"kind"`
+	Route string `json:"route"`
+	// DotNetType is the C# parameters model of the route, if any.
+	DotNetType string `json:"dotnetType,omitempty"`
+	Parameter  string `json:"parameter"`
+	Old        string `json:"old,omitempty"`
+	New        string `json:"new,omitempty"`
+	Breaking   bool   `json:"breaking"`
+}
+
+// breakingChanges returns the number of changes that break code compiled against the old models.
+func (r *modelDiffReport) breakingChanges() int {
+	n := 0
+	for _, c := range r.Types {
+		if c.Breaking {
+			n++
+		}
+	}
+	for _, c := range r.Properties {
+		if c.Breaking {
+			n++
+		}
+	}
+	for _, c := range r.Parameters {
+		if c.Breaking {
+			n++
+		}
+	}
+
+	return n
+}
+
+// routeKey identifies a route independently of the names of its path parameters.
+func routeKey(method, path string) string {
+	return method + " " + pathParameterRegexp.ReplaceAllString(path, "{}")
+}
+
+// diffModels compares the models and query parameters of two swagger
+// documents. dotnetTypes maps swagger definitions and dotnetParameters the
+// routeKey of routes to the C# models generated for them. Removing or retyping
+// a type, property or query parameter is reported as breaking, renaming the
+// JSON name of a property keeps its C# name and is not.
+func diffModels(oldSpec, newSpec *swaggerSpec, dotnetTypes, dotnetParameters map[string]string) *modelDiffReport {
+	oldModels, newModels := reflectSwagger(oldSpec), reflectSwagger(newSpec)
+
+	// Empty lists are written to JSON as [] rather than null.
+	r := &modelDiffReport{Types: []typeChange{}, Properties: []propertyChange{}, Parameters: []parameterChange{}}
+
+	names := sortedKeys(oldModels)
+	for _, name := range sortedKeys(newModels) {
+		if _, ok := oldModels[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		o, inOld := oldModels[name]
+		n, inNew := newModels[name]
+
+		switch {
+		case !inOld:
+			r.Types = append(r.Types, typeChange{Kind: "added", Type: name, DotNetType: dotnetTypes[name]})
+		case !inNew:
+			r.Types = append(r.Types, typeChange{Kind: "removed", Type: name, DotNetType: dotnetTypes[name], Breaking: true})
+		default:
+			r.Properties = append(r.Properties, diffProperties(name, dotnetTypes[name], o, n)...)
+		}
+	}
+
+	for _, path := range sortedKeys(oldSpec.Paths) {
+		newPath, newItem := findSwaggerPath(newSpec, path)
+		if newItem == nil {
+			continue
+		}
+
+		for _, method := range []string{"GET", "PUT", "POST", "DELETE", "HEAD"} {
+			o, n := oldSpec.Paths[path].operation(method), newItem.operation(method)
+			if o == nil || n == nil {
+				continue
+			}
+
+			route := method + " " + newPath
+			r.Parameters = append(r.Parameters, diffQueryParameters(route, dotnetParameters[routeKey(method, path)], o, n)...)
+		}
+	}
+
+	return r
+}
+
+func diffProperties(name, dotnetName string, o, n *CSModelType) []propertyChange {
+	var changes []propertyChange
+	change := func(kind string, p CSProperty, oldValue, newValue string, breaking bool) {
+		changes = append(changes, propertyChange{Kind: kind, Type: name, DotNetType: dotnetName, Property: p.Name, Old: oldValue, New: newValue, Breaking: breaking})
+	}
+
+	find := func(m *CSModelType, name string) (CSProperty, bool) {
+		i := slices.IndexFunc(m.Properties, func(p CSProperty) bool { return p.Name == name })
+		if i < 0 {
+			return CSProperty{}, false
+		}
+
+		return m.Properties[i], true
+	}
+
+	for _, op := range o.Properties {
+		np, ok := find(n, op.Name)
+		if !ok {
+			change("removed", op, op.Type.Name, "", true)
+			continue
+		}
+
+		if op.Type.Name != np.Type.Name {
+			change("retyped", op, op.Type.Name, np.Type.Name, true)
+		}
+
+		oldJSON, _ := jsonPropertyName(op)
+		newJSON, _ := jsonPropertyName(np)
+		if oldJSON != newJSON {
+			change("renamed", op, oldJSON, newJSON, false)
+		}
+	}
+
+	for _, np := range n.Properties {
+		if _, ok := find(o, np.Name); !ok {
+			change("added", np, "", np.Type.Name, false)
+		}
+	}
+
+	return changes
+}
+
+func diffQueryParameters(route, dotnetName string, o, n *swaggerOperation) []parameterChange {
+	query := func(op *swaggerOperation) map[string]swaggerParameter {
+		params := map[string]swaggerParameter{}
+		for _, p := range op.Parameters {
+			if p.In == "query" {
+				params[p.Name] = p
+			}
+		}
+
+		return params
+	}
+
+	oldParams, newParams := query(o), query(n)
+
+	var changes []parameterChange
+	change := func(kind, name, oldValue, newValue string, breaking bool) {
+		changes = append(changes, parameterChange{Kind: kind, Route: route, DotNetType: dotnetName, Parameter: name, Old: oldValue, New: newValue, Breaking: breaking})
+	}
+
+	for _, name := range sortedKeys(oldParams) {
+		op := oldParams[name]
+		np, ok := newParams[name]
+		switch {
+		case !ok:
+			change("removed", name, op.typeName(), "", true)
+		case op.typeName() != np.typeName():
+			change("retyped", name, op.typeName(), np.typeName(), true)
+		}
+	}
+
+	for _, name := range sortedKeys(newParams) {
+		if _, ok := oldParams[name]; !ok {
+			change("added", name, "", newParams[name].typeName(), false)
+		}
+	}
+
+	return changes
+}
+
+// findSwaggerPath returns the path of spec that matches path, whose parameters may be named differently.
+func findSwaggerPath(spec *swaggerSpec, path string) (string, *swaggerPathItem) {
+	if item, ok := spec.Paths[path]; ok {
+		return path, item
+	}
+
+	key := routeKey("", path)
+	for _, p := range sortedKeys(spec.Paths) {
+		if routeKey("", p) == key {
+			return p, spec.Paths[p]
+		}
+	}
+
+	return "", nil
+}
+
+// writeMarkdown writes the report as Markdown tables, marking breaking changes.
+func (r *modelDiffReport) writeMarkdown(w io.Writer) {
+	fmt.Fprintf(w, "# Model changes from %s to %s\n\n", r.Old, r.New)
+	fmt.Fprintf(w, "%d of %d changes break code written against the .NET models of %s and are marked as **breaking**.\n", r.breakingChanges(), len(r.Types)+len(r.Properties)+len(r.Parameters), r.Old)
+
+	typeName := func(name, dotnetName string) string {
+		if dotnetName == "" || dotnetName == name {
+			return "`" + name + "`"
+		}
+
+		return fmt.Sprintf("`%s` (`%s`)", name, dotnetName)
+	}
+
+	kind := func(kind string, breaking bool) string {
+		if breaking {
+			return kind + " **breaking**"
+		}
+
+		return kind
+	}
+
+	code := func(s string) string {
+		if s == "" {
+			return ""
+		}
+
+		return "`" + s + "`"
+	}
+
+	fmt.Fprint(w, "\n## Types\n\n")
+	if len(r.Types) == 0 {
+		fmt.Fprintln(w, "No changes.")
+	} else {
+		fmt.Fprintln(w, "| Change | Type |")
+		fmt.Fprintln(w, "|--------|------|")
+		for _, c := range r.Types {
+			fmt.Fprintf(w, "| %s | %s |\n", kind(c.Kind, c.Breaking), typeName(c.Type, c.DotNetType))
+		}
+	}
+
+	fmt.Fprint(w, "\n## Properties\n\n")
+	if len(r.Properties) == 0 {
+		fmt.Fprintln(w, "No changes.")
+	} else {
+		fmt.Fprintln(w, "| Change | Type | Property | Old | New |")
+		fmt.Fprintln(w, "|--------|------|----------|-----|-----|")
+		for _, c := range r.Properties {
+			fmt.Fprintf(w, "| %s | %s | `%s` | %s | %s |\n", kind(c.Kind, c.Breaking), typeName(c.Type, c.DotNetType), c.Property, code(c.Old), code(c.New))
+		}
+	}
+
+	fmt.Fprint(w, "\n## Query parameters\n\n")
+	if len(r.Parameters) == 0 {
+		fmt.Fprintln(w, "No changes.")
+	} else {
+		fmt.Fprintln(w, "| Change | Route | Parameter | Old | New |")
+		fmt.Fprintln(w, "|--------|-------|-----------|-----|-----|")
+		for _, c := range r.Parameters {
+			route := "`" + c.Route + "`"
+			if c.DotNetType != "" {
+				route += fmt.Sprintf(" (`%s`)", c.DotNetType)
+			}
+
+			fmt.Fprintf(w, "| %s | %s | `%s` | %s | %s |\n", kind(c.Kind, c.Breaking), route, c.Parameter, code(c.Old), code(c.New))
+		}
+	}
+}
+
+func (r *modelDiffReport) writeJSON(w io.Writer) error {
+	// C# types such as IList<string> are written as is.
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// resolveMobyAPIModule returns the directory of the github.com/moby/moby/api
+// module at a release tag or module version, downloading it into the module
+// cache if needed. A directory is returned as is.
+func resolveMobyAPIModule(ref string) (string, error) {
+	if fi, err := os.Stat(ref); err == nil && fi.IsDir() {
+		return ref, nil
+	}
+
+	cmd := exec.Command("go", "mod", "download", "-json", "github.com/moby/moby/api@"+ref)
+	output, err := cmd.Output()
+
+	var module struct {
+		Dir   string
+		Error string
+	}
+
+	if jsonErr := json.Unmarshal(output, &module); jsonErr != nil {
+		if err == nil {
+			err = jsonErr
+		}
+
+		return "", fmt.Errorf("resolve github.com/moby/moby/api@%s: %w", ref, err)
+	}
+
+	if module.Error != "" {
+		return "", fmt.Errorf("resolve github.com/moby/moby/api@%s: %s", ref, module.Error)
+	}
+
+	return module.Dir, nil
+}
+
+// runDiff implements specgen diff <old> <new>.
+func runDiff(args []string) int {
+	fs := flag.NewFlagSet("specgen diff", flag.ContinueOnError)
+	format := fs.String("format", "markdown", "Report format, markdown or json.")
+	fs.BoolVar(verbose, "verbose", false, "Print progress information to stderr.")
+	fs.StringVar(configFile, "config", "", "File with the customizations of the generated types, see README.md (default: specgen.yaml of the specgen module).")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: specgen diff [flags] <old release tag or directory> <new release tag or directory>")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	if *format != "markdown" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "specgen: unknown format %q, use markdown or json\n", *format)
+		return exitUsage
+	}
+
+	var specs []*swaggerSpec
+	for _, ref := range fs.Args() {
+		dir, err := resolveMobyAPIModule(ref)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
+		}
+
+		name := filepath.Join(dir, "swagger.yaml")
+		verbosef("Reading %s", name)
+
+		spec, err := loadSwagger(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
+		}
+
+		specs = append(specs, spec)
+	}
+
+	cfg, err := readConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		return exitIO
+	}
+
+	typeConfigs = cfg.Types
+	resolveNames(routeModels())
+
+	// Name the definitions after the C# models the current route table generates for them.
+	for _, t := range routeModels() {
+		reflectType(t)
+	}
+
+	dotnetTypes := map[string]string{}
+	for _, k := range sortedKeys(reflectedTypes) {
+		m := reflectedTypes[k]
+		def, ok := matchSwaggerDefinition(m.SourceName, func(name string) bool {
+			return slices.ContainsFunc(specs, func(s *swaggerSpec) bool {
+				_, ok := s.Definitions.Schemas[name]
+				return ok
+			})
+		})
+		if _, exists := dotnetTypes[def]; ok && !exists {
+			dotnetTypes[def] = m.Name
+		}
+	}
+
+	dotnetParameters := map[string]string{}
+	for _, r := range routes {
+		if r.Parameters == nil {
+			continue
+		}
+
+		if m, ok := reflectedTypes[typeToKey(r.Parameters)]; ok {
+			dotnetParameters[routeKey(r.Method, r.Path)] = m.Name
+		}
+	}
+
+	report := diffModels(specs[0], specs[1], dotnetTypes, dotnetParameters)
+	report.Old, report.New = strings.TrimSuffix(fs.Arg(0), "/"), strings.TrimSuffix(fs.Arg(1), "/")
+
+	if code := reportGenerationErrors(); code != exitOK {
+		return code
+	}
+
+	if *format == "json" {
+		if err := report.writeJSON(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
+		}
+
+		return exitOK
+	}
+
+	report.writeMarkdown(os.Stdout)
+	return exitOK
+}
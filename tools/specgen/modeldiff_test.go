@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiffModels(t *testing.T) {
+	resetGeneratorState(t)
+
+	oldSpec, err := loadSwagger(filepath.Join("testdata", "diff", "old.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newSpec, err := loadSwagger(filepath.Join("testdata", "diff", "new.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := diffModels(oldSpec, newSpec,
+		map[string]string{"Widget": "WidgetResponse"},
+		map[string]string{routeKey("GET", "/widgets/json"): "WidgetsListParameters"})
+	report.Old, report.New = "old.yaml", "new.yaml"
+
+	for _, err := range generationErrors {
+		t.Error(err)
+	}
+
+	var got bytes.Buffer
+	report.writeMarkdown(&got)
+	if err := report.writeJSON(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join("testdata", "diff.golden")
+	if *update {
+		if err := os.WriteFile(name, got.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+
+	if !bytes.Equal(got.Bytes(), want) {
+		var diff bytes.Buffer
+		writeUnifiedDiff(&diff, name, "report", want, got.Bytes())
+		t.Errorf("report does not match %s (run go test -update to accept it):\n%s", name, &diff)
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// resolvedNames maps the key of the Go types whose name collides with the name
+// of another generated type to the name chosen for them by resolveNames.
+var resolvedNames = map[string]string{}
+
+// NameResolution is the name resolveNames chose for a Go type.
+type NameResolution struct {
+	// ConfigKey is the key of the Go type in specgen.yaml.
+	ConfigKey string
+	Name      string
+	// Collisions are the other Go types that have the same name.
+	Collisions []string
+}
+
+// resolveNames finds the Go types reachable from roots that would be generated
+// with the same C# name and names each of them after its package, e.g.
+// swarm.RestartPolicy as SwarmRestartPolicy, unless specgen.yaml sets its name.
+// Names that still collide are reported as errors.
+func resolveNames(roots []reflect.Type) []NameResolution {
+	seen := map[string]reflect.Type{}
+	for _, t := range roots {
+		collectTypes(t, seen)
+	}
+
+	// The C# names of the Go types generated as models or enums, with the
+	// names specgen.yaml sets.
+	byName := map[string][]string{}
+	pinned := map[string]bool{}
+	for _, k := range sortedKeys(seen) {
+		t := seen[k]
+		if !generatesCSType(t) {
+			continue
+		}
+
+		// The config is not looked up with typeConfig, so that the types that
+		// are not reflected are still reported as unused by validateConfig.
+		name := t.Name()
+		if tc := typeConfigs[configKey(t)]; tc != nil && tc.Name != "" {
+			name = tc.Name
+			pinned[k] = true
+		}
+
+		byName[name] = append(byName[name], k)
+	}
+
+	// The unions are named by unionTypes.
+	for _, k := range sortedKeys(unionTypes) {
+		byName[unionTypes[k].Name] = append(byName[unionTypes[k].Name], k)
+		pinned[k] = true
+	}
+
+	var resolutions []NameResolution
+	names := map[string][]string{}
+	for _, name := range sortedKeys(byName) {
+		keys := byName[name]
+		for _, k := range keys {
+			if len(keys) == 1 || pinned[k] {
+				names[name] = append(names[name], k)
+				continue
+			}
+
+			t := seen[k]
+			resolved := pascalCase(packageName(t)) + t.Name()
+			resolvedNames[k] = resolved
+			names[resolved] = append(names[resolved], k)
+
+			var collisions []string
+			for _, other := range keys {
+				if other != k {
+					collisions = append(collisions, other)
+				}
+			}
+
+			resolutions = append(resolutions, NameResolution{ConfigKey: configKey(t), Name: resolved, Collisions: collisions})
+		}
+	}
+
+	for _, name := range sortedKeys(names) {
+		if keys := names[name]; len(keys) > 1 {
+			reportError("types (%s) have the same name (%s), set their names in specgen.yaml", strings.Join(keys, ", "), name)
+		}
+	}
+
+	return resolutions
+}
+
+// generatesCSType reports whether a Go type is generated as a C# model or enum.
+func generatesCSType(t reflect.Type) bool {
+	if t.Name() == "" {
+		return false
+	}
+
+	if t.Kind() == reflect.Struct {
+		return true
+	}
+
+	if !isEnumKind(t.Kind()) || len(goEnumConsts[t.PkgPath()+"."+t.Name()]) == 0 {
+		return false
+	}
+
+	tc := typeConfigs[configKey(t)]
+	return tc == nil || !tc.OpenValues
+}
+
+// csTypeName returns the name of the C# model or enum generated for a Go type,
+// the name specgen.yaml sets, else the name resolveNames chose, else the name
+// of the Go type.
+func csTypeName(t reflect.Type, tc *TypeConfig) string {
+	if tc != nil && tc.Name != "" {
+		return tc.Name
+	}
+
+	if name, ok := resolvedNames[typeToKey(t)]; ok {
+		return name
+	}
+
+	return t.Name()
+}
+
+// packageName returns the name of the package of a Go type, e.g. swarm for
+// swarm.RestartPolicy.
+func packageName(t reflect.Type) string {
+	name, _, _ := strings.Cut(t.String(), ".")
+	return name
+}
+
+// writeNameResolutions writes the names resolveNames chose as entries of
+// specgen.yaml, so they can be pinned there.
+func writeNameResolutions(w io.Writer, resolutions []NameResolution) {
+	if len(resolutions) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "specgen: %d types were named after their package because their names collide, pin the names in specgen.yaml:\n", len(resolutions))
+	for _, r := range resolutions {
+		fmt.Fprintf(w, "  # collides with %s\n", strings.Join(r.Collisions, ", "))
+		fmt.Fprintf(w, "  %s:\n", r.ConfigKey)
+		fmt.Fprintf(w, "    name: %s\n", r.Name)
+	}
+}
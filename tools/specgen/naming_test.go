@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestResolveNames names the golden types whose names collide after their
+// package and appends the report of the chosen names.
+func TestResolveNames(t *testing.T) {
+	var report bytes.Buffer
+	got := generateGolden(t, nil, func(t *testing.T) {
+		writeNameResolutions(&report, resolveNames([]reflect.Type{reflect.TypeOf(GoldenCollision{})}))
+		reflectType(reflect.TypeOf(GoldenCollision{}))
+	})
+
+	compareGolden(t, "collisions", append(got, report.Bytes()...))
+}
+
+// TestResolveNamesPinned reports the names that still collide with a name
+// specgen.yaml sets.
+func TestResolveNamesPinned(t *testing.T) {
+	resetGeneratorState(t)
+
+	configs := typeConfigs
+	typeConfigs = map[string]*TypeConfig{
+		configKey(reflect.TypeOf(GoldenCollision{})): {Name: "MainWaitExitError"},
+	}
+	t.Cleanup(func() { typeConfigs = configs })
+
+	resolveNames([]reflect.Type{reflect.TypeOf(GoldenCollision{})})
+
+	want := "types (main.GoldenCollision, main.WaitExitError) have the same name (MainWaitExitError), set their names in specgen.yaml"
+	if len(generationErrors) != 1 || generationErrors[0].Error() != want {
+		t.Errorf("errors = %v, want [%s]", generationErrors, want)
+	}
+}
@@ -0,0 +1,592 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// BodyKind selects what is sent as the JSON request body of an operation.
+type BodyKind int
+
+const (
+	// NoBody sends the parameters as query string and headers only.
+	NoBody BodyKind = iota
+	// ParametersBody sends the parameters object itself.
+	ParametersBody
+	// ParametersMemberBody sends the parameters member named by Operation.BodyMember.
+	ParametersMemberBody
+)
+
+// Operation describes the C# method generated for a Route into the
+// I<Group>Operations interface and the <Group>Operations class.
+type Operation struct {
+	Group   string
+	Name    string
+	Summary string
+
+	// Remarks is shown before the HTTP route in the remarks, e.g. the
+	// corresponding Docker CLI command.
+	Remarks string
+
+	// Responses lists the documented status codes, e.g. "404 - No such volume.".
+	Responses []string
+
+	// Returns documents the result of the operation.
+	Returns string
+
+	// SeeAlso lists the crefs of related operations.
+	SeeAlso []string
+
+	// Errors documents when a DockerApiException is thrown, if it is more
+	// specific than an invalid input or a daemon error.
+	Errors string
+
+	// ParamDocs documents the path segments and the parameters argument by C# name.
+	ParamDocs map[string]string
+
+	// ParameterName is the C# name of the parameters argument, "parameters" by default.
+	ParameterName string
+
+	// OptionalParameters makes the parameters argument nullable and optional.
+	OptionalParameters bool
+
+	// ParameterlessOverload adds an overload without the parameters argument.
+	ParameterlessOverload bool
+
+	// ExpandParameters passes each property of the parameters as an optional
+	// argument, e.g. bool? force = null, instead of the parameters object.
+	ExpandParameters bool
+
+	// RequiredMembers lists the properties of the parameters that must be set,
+	// e.g. the container to connect to a network.
+	RequiredMembers []string
+
+	// InfiniteTimeout disables the client timeout for routes that wait for the
+	// daemon, e.g. until a container exits.
+	InfiniteTimeout bool
+
+	Body       BodyKind
+	BodyMember string
+}
+
+const cancellationTokenDoc = "When triggered, the operation will stop at the next available time, if possible."
+
+var pathSegmentRegexp = regexp.MustCompile(`\{([a-zA-Z]+)\}`)
+
+var notFoundExceptions = map[NotFoundKind]string{
+	NotFoundContainer: "DockerContainerNotFoundException",
+	NotFoundImage:     "DockerImageNotFoundException",
+	NotFoundNetwork:   "DockerNetworkNotFoundException",
+	NotFoundPlugin:    "DockerPluginNotFoundException",
+}
+
+var notFoundDocs = map[NotFoundKind]string{
+	NotFoundContainer: "No such container was found.",
+	NotFoundImage:     "No such image was found.",
+	NotFoundNetwork:   "No such network was found.",
+	NotFoundPlugin:    "No such plugin was found.",
+}
+
+// OperationGroup collects the generated operations of one I<Group>Operations interface.
+type OperationGroup struct {
+	Name   string
+	Routes []Route
+}
+
+// InterfaceName is the name of the public C# interface.
+func (g *OperationGroup) InterfaceName() string {
+	return "I" + g.Name + "Operations"
+}
+
+// ClassName is the name of the internal C# implementation.
+func (g *OperationGroup) ClassName() string {
+	return g.Name + "Operations"
+}
+
+// operationGroups returns the groups of all routes with an Operation, in table order.
+func operationGroups() []*OperationGroup {
+	var groups []*OperationGroup
+	byName := map[string]*OperationGroup{}
+
+	for _, r := range routes {
+		if r.Operation == nil {
+			continue
+		}
+
+		g, ok := byName[r.Operation.Group]
+		if !ok {
+			g = &OperationGroup{Name: r.Operation.Group}
+			byName[g.Name] = g
+			groups = append(groups, g)
+		}
+
+		g.Routes = append(g.Routes, r)
+	}
+
+	return groups
+}
+
+// csOperationArgument is an argument of a generated operation.
+type csOperationArgument struct {
+	Type    string
+	Name    string
+	Default string
+}
+
+func (a csOperationArgument) String() string {
+	if a.Default != "" {
+		return a.Type + " " + a.Name + " = " + a.Default
+	}
+
+	return a.Type + " " + a.Name
+}
+
+// routeSignature holds the C# pieces shared by the interface and the implementation of a route.
+type routeSignature struct {
+	PathSegments  []string
+	ParameterType string
+	ResultType    string
+	Arguments     []csOperationArgument
+
+	// Properties are the parameter properties passed as arguments by ExpandParameters.
+	Properties []string
+}
+
+func newRouteSignature(r Route) routeSignature {
+	op := r.Operation
+	s := routeSignature{}
+
+	for _, m := range pathSegmentRegexp.FindAllStringSubmatch(r.Path, -1) {
+		s.PathSegments = append(s.PathSegments, m[1])
+		s.Arguments = append(s.Arguments, csOperationArgument{Type: "string", Name: m[1]})
+	}
+
+	if r.Parameters != nil {
+		s.ParameterType = csModelName(r.Parameters)
+
+		if op.ExpandParameters {
+			for _, p := range reflectedTypes[typeToKey(r.Parameters)].Properties {
+				s.Properties = append(s.Properties, p.Name)
+				s.Arguments = append(s.Arguments, csOperationArgument{Type: p.Type.Name + "?", Name: strings.ToLower(p.Name[:1]) + p.Name[1:], Default: "null"})
+			}
+		} else {
+			a := csOperationArgument{Type: s.ParameterType, Name: op.parameterName()}
+			if op.OptionalParameters {
+				a.Type += "?"
+				a.Default = "null"
+			}
+
+			s.Arguments = append(s.Arguments, a)
+		}
+	}
+
+	switch r.Stream {
+	case NoStream:
+		if r.Response == nil {
+			s.ResultType = "Task"
+		} else if r.Response.Kind() == reflect.Slice {
+			s.ResultType = "Task<IList<" + csModelName(r.Response.Elem()) + ">>"
+		} else {
+			s.ResultType = "Task<" + csModelName(r.Response) + ">"
+		}
+	case RawStream:
+		s.ResultType = "Task<Stream>"
+	case ProgressStream:
+		s.ResultType = "Task"
+		s.Arguments = append(s.Arguments, csOperationArgument{Type: "IProgress<" + csModelName(r.Response) + ">", Name: "progress"})
+	}
+
+	s.Arguments = append(s.Arguments, csOperationArgument{Type: "CancellationToken", Name: "cancellationToken", Default: "default"})
+	return s
+}
+
+func (op *Operation) parameterName() string {
+	if op.ParameterName != "" {
+		return op.ParameterName
+	}
+
+	return "parameters"
+}
+
+// csModelName returns the name of the reflected C# model for t.
+func csModelName(t reflect.Type) string {
+	if m, ok := reflectedTypes[typeToKey(t)]; ok {
+		return m.Name
+	}
+
+	reportError("type (%s) is used by a route but was not reflected", t)
+	return t.Name()
+}
+
+// hasParameterAttribute reports whether the C# model for t has a property with
+// an attribute whose name starts with prefix, e.g. QueryString or Header.
+func hasParameterAttribute(t reflect.Type, prefix string) bool {
+	for _, p := range reflectedTypes[typeToKey(t)].Properties {
+		for _, a := range p.Attributes {
+			if strings.HasPrefix(a.Type.Name, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func writeOperationDoc(w io.Writer, r Route, s routeSignature) {
+	op := r.Operation
+
+	fmt.Fprintln(w, "    /// <summary>")
+	writeDocLines(w, op.Summary)
+	fmt.Fprintln(w, "    /// </summary>")
+	fmt.Fprintln(w, "    /// <remarks>")
+	if op.Remarks != "" {
+		writeDocLines(w, op.Remarks)
+		fmt.Fprintln(w, "    ///")
+	}
+	fmt.Fprintf(w, "    /// HTTP %s %s\n", r.Method, r.Path)
+	if len(op.Responses) > 0 {
+		fmt.Fprintln(w, "    ///")
+	}
+	for _, line := range op.Responses {
+		fmt.Fprintf(w, "    /// %s\n", line)
+	}
+	fmt.Fprintln(w, "    /// </remarks>")
+
+	for _, a := range s.Arguments {
+		doc := op.ParamDocs[a.Name]
+		if a.Name == "cancellationToken" {
+			doc = cancellationTokenDoc
+		}
+
+		if doc != "" {
+			fmt.Fprintf(w, "    /// <param name=\"%s\">%s</param>\n", a.Name, doc)
+		}
+	}
+
+	if op.Returns != "" {
+		fmt.Fprintf(w, "    /// <returns>%s</returns>\n", op.Returns)
+	}
+
+	for _, cref := range op.SeeAlso {
+		fmt.Fprintf(w, "    /// <seealso cref=\"%s\"/>\n", cref)
+	}
+
+	if r.NotFound != NotFoundDefault {
+		fmt.Fprintf(w, "    /// <exception cref=\"%s\">%s</exception>\n", notFoundExceptions[r.NotFound], notFoundDocs[r.NotFound])
+	}
+
+	if validatesArguments(r, s) {
+		fmt.Fprintln(w, "    /// <exception cref=\"ArgumentNullException\">One or more of the inputs was <see langword=\"null\"/>.</exception>")
+	}
+
+	errors := op.Errors
+	if errors == "" {
+		errors = "The input is invalid or the daemon experienced an error."
+	}
+
+	fmt.Fprintf(w, "    /// <exception cref=\"DockerApiException\">%s</exception>\n", errors)
+	fmt.Fprintln(w, "    /// <exception cref=\"HttpRequestException\">The request failed due to an underlying issue such as network connectivity, DNS failure, server certificate validation or timeout.</exception>")
+}
+
+// writeDocLines writes text as XML documentation lines, one per line of text.
+func writeDocLines(w io.Writer, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintln(w, strings.TrimRight("    /// "+line, " "))
+	}
+}
+
+// validatesArguments reports whether the operation throws an
+// ArgumentNullException for a missing argument of s.
+func validatesArguments(r Route, s routeSignature) bool {
+	for _, a := range s.Arguments {
+		if a.Name == "progress" || a.Default == "" && a.Name != "cancellationToken" {
+			return true
+		}
+	}
+
+	return len(r.Operation.RequiredMembers) > 0
+}
+
+func joinArguments(args []csOperationArgument) string {
+	s := make([]string, len(args))
+	for i, a := range args {
+		s[i] = a.String()
+	}
+
+	return strings.Join(s, ", ")
+}
+
+// writeOperationUsings imports the models namespace unless the project imports it globally.
+func writeOperationUsings(w io.Writer) {
+	if !GlobalUsings[*modelsNamespace] {
+		fmt.Fprintf(w, "using %s;\n", *modelsNamespace)
+		fmt.Fprintln(w, "")
+	}
+}
+
+// WriteInterface writes the partial I<Group>Operations interface.
+func (g *OperationGroup) WriteInterface(w io.Writer) {
+	writeOperationUsings(w)
+	fmt.Fprintln(w, "namespace Docker.DotNet;")
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "public partial interface %s\n", g.InterfaceName())
+	fmt.Fprintln(w, "{")
+
+	for i, r := range g.Routes {
+		if i > 0 {
+			fmt.Fprintln(w, "")
+		}
+
+		s := newRouteSignature(r)
+
+		if r.Operation.ParameterlessOverload {
+			overload := s
+			overload.Arguments = overload.Arguments[len(overload.Arguments)-1:]
+
+			writeOperationDoc(w, r, overload)
+			fmt.Fprintf(w, "    %s %s(%s);\n", s.ResultType, r.Operation.Name, joinArguments(overload.Arguments))
+			fmt.Fprintln(w, "")
+		}
+
+		writeOperationDoc(w, r, s)
+		fmt.Fprintf(w, "    %s %s(%s);\n", s.ResultType, r.Operation.Name, joinArguments(s.Arguments))
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+// WriteClass writes the partial <Group>Operations class implementing the interface.
+func (g *OperationGroup) WriteClass(w io.Writer) {
+	writeOperationUsings(w)
+	fmt.Fprintln(w, "namespace Docker.DotNet;")
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "internal partial class %s : %s\n", g.ClassName(), g.InterfaceName())
+	fmt.Fprintln(w, "{")
+
+	var notFoundKinds []NotFoundKind
+	for _, r := range g.Routes {
+		if r.NotFound != NotFoundDefault && !containsNotFoundKind(notFoundKinds, r.NotFound) {
+			notFoundKinds = append(notFoundKinds, r.NotFound)
+		}
+	}
+
+	for _, k := range notFoundKinds {
+		fmt.Fprintf(w, "    private static readonly ApiResponseErrorHandlingDelegate %s = (statusCode, responseBody) =>\n", notFoundHandlerName(k))
+		fmt.Fprintln(w, "    {")
+		fmt.Fprintln(w, "        if (statusCode == HttpStatusCode.NotFound)")
+		fmt.Fprintln(w, "        {")
+		fmt.Fprintf(w, "            throw new %s(statusCode, responseBody);\n", notFoundExceptions[k])
+		fmt.Fprintln(w, "        }")
+		fmt.Fprintln(w, "    };")
+		fmt.Fprintln(w, "")
+	}
+
+	for i, r := range g.Routes {
+		if i > 0 {
+			fmt.Fprintln(w, "")
+		}
+
+		writeOperationMethod(w, r)
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+// findCSProperty returns the property of the C# model for t that is named name.
+func findCSProperty(t reflect.Type, name string) (CSProperty, bool) {
+	if t == nil {
+		return CSProperty{}, false
+	}
+
+	if m, ok := reflectedTypes[typeToKey(t)]; ok {
+		for _, p := range m.Properties {
+			if p.Name == name {
+				return p, true
+			}
+		}
+	}
+
+	return CSProperty{}, false
+}
+
+func containsNotFoundKind(kinds []NotFoundKind, k NotFoundKind) bool {
+	for _, kind := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+func notFoundHandlerName(k NotFoundKind) string {
+	return "NoSuch" + strings.TrimSuffix(strings.TrimPrefix(notFoundExceptions[k], "Docker"), "NotFoundException") + "Handler"
+}
+
+func writeOperationMethod(w io.Writer, r Route) {
+	op := r.Operation
+	s := newRouteSignature(r)
+	parameterName := op.parameterName()
+
+	if op.ParameterlessOverload {
+		fmt.Fprintf(w, "    public %s %s(CancellationToken cancellationToken = default)\n", s.ResultType, op.Name)
+		fmt.Fprintln(w, "    {")
+		fmt.Fprintf(w, "        return %s(null, cancellationToken);\n", op.Name)
+		fmt.Fprintln(w, "    }")
+		fmt.Fprintln(w, "")
+	}
+
+	if r.Stream == ProgressStream && r.Reader == "" {
+		reportError("operation (%s) reports progress, but the route has no Reader", r)
+		return
+	}
+
+	fmt.Fprintf(w, "    public async %s %s(%s)\n", s.ResultType, op.Name, joinArguments(s.Arguments))
+	fmt.Fprintln(w, "    {")
+
+	var statements []string
+
+	for _, segment := range s.PathSegments {
+		statements = append(statements, fmt.Sprintf(
+			"        if (string.IsNullOrEmpty(%[1]s))\n        {\n            throw new ArgumentNullException(nameof(%[1]s));\n        }\n", segment))
+	}
+
+	if r.Parameters != nil && !op.OptionalParameters && !op.ExpandParameters {
+		statements = append(statements, fmt.Sprintf(
+			"        if (%[1]s == null)\n        {\n            throw new ArgumentNullException(nameof(%[1]s));\n        }\n", parameterName))
+	}
+
+	for _, member := range op.RequiredMembers {
+		p, ok := findCSProperty(r.Parameters, member)
+		if !ok {
+			reportError("required member (%s) not found on type (%s)", member, r.Parameters)
+			return
+		}
+
+		condition := fmt.Sprintf("%s.%s == null", parameterName, p.Name)
+		if p.Type.Name == "string" {
+			condition = fmt.Sprintf("string.IsNullOrEmpty(%s.%s)", parameterName, p.Name)
+		}
+
+		statements = append(statements, fmt.Sprintf(
+			"        if (%[1]s)\n        {\n            throw new ArgumentNullException(nameof(%[2]s.%[3]s));\n        }\n", condition, parameterName, p.Name))
+	}
+
+	if r.Stream == ProgressStream {
+		statements = append(statements, "        if (progress == null)\n        {\n            throw new ArgumentNullException(nameof(progress));\n        }\n")
+	}
+
+	if op.ExpandParameters {
+		if op.OptionalParameters || op.Body != NoBody {
+			reportError("operation (%s) cannot expand optional parameters or a body", r)
+			return
+		}
+
+		members := make([]string, len(s.Properties))
+		for i, p := range s.Properties {
+			members[i] = p + " = " + s.Arguments[len(s.PathSegments)+i].Name
+		}
+
+		statements = append(statements, fmt.Sprintf("        var %s = new %s { %s };\n", parameterName, s.ParameterType, strings.Join(members, ", ")))
+	}
+
+	queryParameters, data, headers := "null", "null", "null"
+
+	if r.Parameters != nil && hasParameterAttribute(r.Parameters, "QueryString") {
+		queryParameters = "queryParameters"
+		if op.OptionalParameters {
+			statements = append(statements, fmt.Sprintf("        var queryParameters = %[1]s == null ? null : new QueryString<%[2]s>(%[1]s);\n", parameterName, s.ParameterType))
+		} else {
+			statements = append(statements, fmt.Sprintf("        var queryParameters = new QueryString<%s>(%s);\n", s.ParameterType, parameterName))
+		}
+	}
+
+	switch op.Body {
+	case ParametersBody:
+		data = "data"
+		statements = append(statements, fmt.Sprintf("        var data = new JsonRequestContent<%[1]s>(%[2]s, DockerClient.JsonSerializer);\n", s.ParameterType, parameterName))
+	case ParametersMemberBody:
+		f, ok := r.Parameters.FieldByName(op.BodyMember)
+		if !ok {
+			reportError("body member (%s) not found on type (%s)", op.BodyMember, r.Parameters)
+			return
+		}
+
+		data = "data"
+		statements = append(statements, fmt.Sprintf("        var data = new JsonRequestContent<%s>(%s.%s, DockerClient.JsonSerializer);\n", csType(f.Type).Name, parameterName, op.BodyMember))
+	}
+
+	if op.Body != NoBody && op.OptionalParameters {
+		reportError("operation (%s %s) cannot send optional parameters as body", r.Method, r.Path)
+		return
+	}
+
+	if r.Parameters != nil && hasParameterAttribute(r.Parameters, "Header") {
+		headers = "headers"
+		statements = append(statements, fmt.Sprintf("        var headers = new RequestHeaders<%s>(%s).GetHeaders();\n", s.ParameterType, parameterName))
+	}
+
+	errorHandlers := "_client.NoErrorHandlers"
+	if r.NotFound != NotFoundDefault {
+		errorHandlers = "[" + notFoundHandlerName(r.NotFound) + "]"
+	}
+
+	method := "HttpMethod." + strings.ToUpper(r.Method[:1]) + strings.ToLower(r.Method[1:])
+
+	path := strings.TrimPrefix(r.Path, "/")
+	if len(s.PathSegments) > 0 {
+		path = "$\"" + path + "\""
+	} else {
+		path = "\"" + path + "\""
+	}
+
+	// Use the shortest MakeRequest overload that carries all request parts.
+	args := []string{errorHandlers, method, path}
+	switch {
+	case op.InfiniteTimeout:
+		if r.Stream != NoStream {
+			reportError("operation (%s) streams its response, which has no timeout", r)
+			return
+		}
+
+		args = append(args, queryParameters, data, headers, "Timeout.InfiniteTimeSpan")
+	case headers != "null":
+		args = append(args, queryParameters, data, headers)
+	case data != "null":
+		args = append(args, queryParameters, data)
+	case queryParameters != "null":
+		args = append(args, queryParameters)
+	}
+	args = append(args, "cancellationToken")
+
+	var call string
+	switch r.Stream {
+	case NoStream:
+		if r.Response == nil {
+			call = "        await _client.MakeRequestAsync(" + strings.Join(args, ", ") + ")\n            .ConfigureAwait(false);\n"
+		} else {
+			var responseType string
+			if r.Response.Kind() == reflect.Slice {
+				responseType = csModelName(r.Response.Elem()) + "[]"
+			} else {
+				responseType = csModelName(r.Response)
+			}
+
+			call = "        return await _client.MakeRequestAsync<" + responseType + ">(" + strings.Join(args, ", ") + ")\n            .ConfigureAwait(false);\n"
+		}
+	case RawStream:
+		call = "        return await _client.MakeRequestForStreamAsync(" + strings.Join(args, ", ") + ")\n            .ConfigureAwait(false);\n"
+	case ProgressStream:
+		call = "        using var stream = await _client.MakeRequestForStreamAsync(" + strings.Join(args, ", ") + ")\n            .ConfigureAwait(false);\n\n" +
+			"        await foreach (var message in " + messageStreamsClassName + "." + r.Reader + "(stream, cancellationToken).ConfigureAwait(false))\n" +
+			"        {\n            progress.Report(message);\n        }\n"
+	}
+
+	statements = append(statements, call)
+
+	fmt.Fprint(w, strings.Join(statements, "\n"))
+	fmt.Fprintln(w, "    }")
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// TestWriteOperations generates an operation group that covers the not-found
+// handlers, the streamed responses and the argument variations of Operation.
+func TestWriteOperations(t *testing.T) {
+	resetGeneratorState(t)
+
+	for _, typ := range []reflect.Type{reflect.TypeOf(GoldenChild{}), reflect.TypeOf(GoldenBase{}), reflect.TypeOf(GoldenParameters{}), reflect.TypeOf(GoldenListParameters{}), reflect.TypeOf(GoldenRemoveParameters{})} {
+		reflectType(typ)
+	}
+
+	g := &OperationGroup{Name: "Golden", Routes: []Route{
+		{Method: "GET", Path: "/golden",
+			Parameters: reflect.TypeOf(GoldenListParameters{}),
+			Response:   reflect.TypeOf([]GoldenBase{}),
+			Operation: &Operation{
+				Name:                  "ListGoldenAsync",
+				Summary:               "List goldens.\n\nReturns the goldens that match the filters.",
+				Responses:             []string{"200 - No error.", "500 - Server error."},
+				OptionalParameters:    true,
+				ParameterlessOverload: true,
+			}},
+		{Method: "GET", Path: "/golden/{id}",
+			Response: reflect.TypeOf(GoldenChild{}),
+			NotFound: NotFoundContainer,
+			Operation: &Operation{
+				Name:      "InspectGoldenAsync",
+				Summary:   "Inspect a golden.",
+				Remarks:   "The corresponding command in the Docker CLI is <c>docker golden inspect</c>.",
+				ParamDocs: map[string]string{"id": "ID of the golden."},
+				Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to the golden.",
+				SeeAlso:   []string{"ListGoldenAsync(GoldenListParameters, CancellationToken)"},
+				Errors:    "The golden is tarnished, or the daemon experienced an error.",
+			}},
+		{Method: "GET", Path: "/golden/{id}/export",
+			NotFound: NotFoundImage,
+			Stream:   RawStream,
+			Operation: &Operation{
+				Name:    "ExportGoldenAsync",
+				Summary: "Export a golden as a tarball.",
+			}},
+		{Method: "GET", Path: "/golden/{id}/stats",
+			Parameters: reflect.TypeOf(GoldenListParameters{}),
+			Response:   reflect.TypeOf(GoldenBase{}),
+			NotFound:   NotFoundContainer,
+			Stream:     ProgressStream,
+			Reader:     "ReadGoldenStatsAsync",
+			Operation: &Operation{
+				Name:      "GetGoldenStatsAsync",
+				Summary:   "Stream the statistics of a golden.",
+				ParamDocs: map[string]string{"progress": "Receives the statistics."},
+			}},
+		{Method: "DELETE", Path: "/golden/{id}",
+			Parameters: reflect.TypeOf(GoldenRemoveParameters{}),
+			Operation: &Operation{
+				Name:             "RemoveGoldenAsync",
+				Summary:          "Remove a golden.",
+				ParamDocs:        map[string]string{"force": "Remove the golden even if it is in use."},
+				ExpandParameters: true,
+			}},
+		{Method: "POST", Path: "/golden/{id}/update",
+			Parameters: reflect.TypeOf(GoldenParameters{}),
+			NotFound:   NotFoundNetwork,
+			Operation: &Operation{
+				Name:            "UpdateGoldenAsync",
+				Summary:         "Update a golden.",
+				RequiredMembers: []string{"Token", "Body"},
+				Body:            ParametersMemberBody,
+				BodyMember:      "Body",
+			}},
+		{Method: "POST", Path: "/golden/{id}/wait",
+			Response: reflect.TypeOf(GoldenBase{}),
+			NotFound: NotFoundContainer,
+			Operation: &Operation{
+				Name:            "WaitGoldenAsync",
+				Summary:         "Wait for a golden to settle.",
+				InfiniteTimeout: true,
+			}},
+	}}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// ---- %s.Generated.cs ----\n", g.InterfaceName())
+	g.WriteInterface(&b)
+	fmt.Fprintf(&b, "// ---- %s.Generated.cs ----\n", g.ClassName())
+	g.WriteClass(&b)
+
+	for _, err := range generationErrors {
+		fmt.Fprintf(&b, "// error: %v\n", err)
+	}
+
+	compareGolden(t, "operations", b.Bytes())
+}
+
+// TestWriteOperationsErrors checks the Operation combinations that cannot be generated.
+func TestWriteOperationsErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		route Route
+		want  string
+	}{
+		{"streamed infinite timeout",
+			Route{Method: "GET", Path: "/golden/{id}/logs", Stream: RawStream,
+				Operation: &Operation{Name: "LogsAsync", InfiniteTimeout: true}},
+			"operation (GET /golden/{id}/logs) streams its response, which has no timeout"},
+		{"progress without reader",
+			Route{Method: "GET", Path: "/golden/{id}/stats", Response: reflect.TypeOf(GoldenRemoveParameters{}), Stream: ProgressStream,
+				Operation: &Operation{Name: "StatsAsync"}},
+			"operation (GET /golden/{id}/stats) reports progress, but the route has no Reader"},
+		{"unknown required member",
+			Route{Method: "POST", Path: "/golden", Parameters: reflect.TypeOf(GoldenRemoveParameters{}),
+				Operation: &Operation{Name: "CreateAsync", RequiredMembers: []string{"Name"}}},
+			"required member (Name) not found on type (main.GoldenRemoveParameters)"},
+		{"expanded body",
+			Route{Method: "POST", Path: "/golden", Parameters: reflect.TypeOf(GoldenRemoveParameters{}),
+				Operation: &Operation{Name: "CreateAsync", ExpandParameters: true, Body: ParametersBody}},
+			"operation (POST /golden) cannot expand optional parameters or a body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetGeneratorState(t)
+			reflectType(reflect.TypeOf(GoldenRemoveParameters{}))
+
+			writeOperationMethod(io.Discard, tt.route)
+
+			if len(generationErrors) != 1 || generationErrors[0].Error() != tt.want {
+				t.Errorf("errors = %v, want [%s]", generationErrors, tt.want)
+			}
+		})
+	}
+}
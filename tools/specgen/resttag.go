@@ -6,9 +6,10 @@ import (
 )
 
 const (
-	header = "header"
-	body   = "body"
-	query  = "query"
+	header  = "header"
+	headers = "headers"
+	body    = "body"
+	query   = "query"
 )
 
 // RestTag is a type that represents the valid values of a 'rest' struct tag.
@@ -20,7 +21,8 @@ type RestTag struct {
 }
 
 // RestTagFromString is a method to parse a 'rest' struct tag to a resulting RestTag struct.
-// This can take the form of rest:in,name,required
+// This can take the form of rest:in,name,required. Both 'header' and 'headers'
+// are accepted for header parameters and are normalized to 'header'.
 func RestTagFromString(tag string) (RestTag, error) {
 	if tag == "" {
 		return RestTag{}, errors.New("nil or empty tag string")
@@ -33,7 +35,8 @@ func RestTagFromString(tag string) (RestTag, error) {
 	if elen >= 1 {
 		r.In = entries[0]
 		switch r.In {
-		case header:
+		case header, headers:
+			r.In = header
 		case body:
 		case query:
 		default:
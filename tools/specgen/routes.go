@@ -0,0 +1,1173 @@
+package main
+
+import (
+	"reflect"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/api/types/image"
+	"github.com/moby/moby/api/types/jsonstream"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/api/types/plugin"
+	"github.com/moby/moby/api/types/registry"
+	"github.com/moby/moby/api/types/swarm"
+	"github.com/moby/moby/api/types/system"
+	"github.com/moby/moby/api/types/volume"
+	"github.com/moby/moby/client"
+)
+
+// NotFoundKind selects the exception thrown when the daemon answers a route with 404.
+type NotFoundKind int
+
+const (
+	// NotFoundDefault throws the generic DockerApiException.
+	NotFoundDefault NotFoundKind = iota
+	NotFoundContainer
+	NotFoundImage
+	NotFoundNetwork
+	NotFoundPlugin
+)
+
+// StreamKind describes how the response body of a route is consumed.
+type StreamKind int
+
+const (
+	// NoStream deserializes the complete response body as Response.
+	NoStream StreamKind = iota
+	// RawStream returns the response body as a Stream.
+	RawStream
+	// ProgressStream reads each JSON message of type Response with the Reader
+	// of the route and reports it to an IProgress<T>.
+	ProgressStream
+)
+
+// Route is a Docker Engine API endpoint. Path segments in braces, e.g. {id},
+// become string arguments of the generated operation.
+type Route struct {
+	Method string
+	Path   string
+
+	// Parameters is the type carrying the query, header and body parameters.
+	Parameters reflect.Type
+
+	// Response is the type returned by the daemon. Slices are returned as IList<T>.
+	Response reflect.Type
+
+	// Models lists additional types that are reflected for this route.
+	Models []reflect.Type
+
+	NotFound NotFoundKind
+	Stream   StreamKind
+
+	// Reader is the name of the MessageStreams method generated to read the
+	// messages of a ProgressStream route, e.g. ReadEventsAsync.
+	Reader string
+
+	// Operation describes the generated C# operation.
+	Operation *Operation
+
+	// HandWritten says why a route has no Operation and is implemented by
+	// hand, e.g. because it hijacks the connection. Such routes are only used
+	// to reflect their models.
+	HandWritten string
+
+	// Unimplemented says why a route has no operation at all, e.g. because
+	// adding its member to a public interface would break the implementers of
+	// the interface. Such routes are only used to reflect their models.
+	Unimplemented string
+}
+
+// String returns the method and path of the route, e.g. GET /volumes/{name}.
+func (r Route) String() string {
+	return r.Method + " " + r.Path
+}
+
+// Documentation shared by the operations of a group.
+const (
+	parametersDoc  = "Specifics of how to perform the operation."
+	containerIDDoc = "The ID or name of the container."
+	imageNameDoc   = "An image name or ID."
+	networkIDDoc   = "Network ID or name."
+	pluginNameDoc  = "The name of the plugin. The `:latest` tag is optional, and is the default if omitted."
+	execIDDoc      = "ID of the exec instance."
+	completeDoc    = "A <see cref=\"Task\"/> that resolves when the operation is complete."
+)
+
+// swarmHandWritten is the reason the swarm, node and service routes are
+// implemented by hand: the daemon answers 503 if the node is not, or already
+// is, part of a swarm, which only SwarmOperations turns into exceptions.
+const swarmHandWritten = "throws the swarm participation exceptions on 503"
+
+var routes = []Route{
+
+	{Method: "GET", Path: "/_ping",
+		Operation: &Operation{
+			Group:     "System",
+			Name:      "PingAsync",
+			Summary:   "Ping.\n\nThis is a dummy endpoint you can use to test if the server is accessible.",
+			Responses: []string{"200 - No error.", "500 - Server error."},
+		}},
+
+	{Method: "POST", Path: "/auth",
+		Parameters:  reflect.TypeOf(registry.AuthConfig{}),
+		Response:    reflect.TypeOf(registry.AuthResponse{}),
+		HandWritten: "AuthenticateAsync discards the AuthResponse"},
+
+	{Method: "POST", Path: "/build",
+		Parameters:  reflect.TypeOf(ImageBuildParameters{}),
+		Response:    reflect.TypeOf(jsonstream.Message{}),
+		Stream:      ProgressStream,
+		Reader:      "ReadBuildImageMessagesAsync",
+		HandWritten: "sends the build context as an application/x-tar body"},
+
+	{Method: "POST", Path: "/commit",
+		Parameters: reflect.TypeOf(CommitContainerChangesParameters{}),
+		Response:   reflect.TypeOf(CommitContainerChangesResponse{}),
+		Operation: &Operation{
+			Group:     "Image",
+			Name:      "CommitContainerChangesAsync",
+			Summary:   "Create a new image from a container.",
+			Remarks:   "The equivalent command in the Docker CLI is <c>docker commit</c>.",
+			ParamDocs: map[string]string{"parameters": parametersDoc},
+			Errors:    "There was a conflict, or the input is invalid, or the daemon experienced an error.",
+			Body:      ParametersBody,
+		}},
+
+	{Method: "POST", Path: "/containers/create",
+		Parameters: reflect.TypeOf(CreateContainerParameters{}),
+		Response:   reflect.TypeOf(container.CreateResponse{}),
+		NotFound:   NotFoundImage,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "CreateContainerAsync",
+			Summary:   "Creates a new container from an image.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker container create</c>.",
+			ParamDocs: map[string]string{"parameters": parametersDoc},
+			Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to a <see cref=\"CreateContainerResponse\"/>, which provides information about the newly-created container.",
+			Body:      ParametersBody,
+		}},
+
+	{Method: "GET", Path: "/containers/json",
+		Parameters: reflect.TypeOf(ContainersListParameters{}),
+		Response:   reflect.TypeOf([]container.Summary{}),
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "ListContainersAsync",
+			Summary:   "Returns a list of containers.",
+			Remarks:   "The corresponding commands in the Docker CLI are <c>docker ps</c> and <c>docker container ls</c>.",
+			ParamDocs: map[string]string{"parameters": parametersDoc},
+			Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to a list of <see cref=\"ContainerListResponse\"/> objects, which represent the containers found.",
+		}},
+
+	{Method: "POST", Path: "/containers/prune",
+		Parameters: reflect.TypeOf(ContainersPruneParameters{}),
+		Response:   reflect.TypeOf(container.PruneReport{}),
+		Operation: &Operation{
+			Group:              "Container",
+			Name:               "PruneContainersAsync",
+			Summary:            "Deletes stopped containers.",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker container prune</c>.",
+			ParamDocs:          map[string]string{"parameters": parametersDoc},
+			Returns:            "A <see cref=\"Task{TResult}\"/> that resolves to a <see cref=\"ContainersPruneResponse\"/>, which details which containers were removed.",
+			OptionalParameters: true,
+		}},
+
+	{Method: "DELETE", Path: "/containers/{id}",
+		Parameters: reflect.TypeOf(ContainerRemoveParameters{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "RemoveContainerAsync",
+			Summary:   "Deletes a container.",
+			Remarks:   "The corresponding commands in the Docker CLI are <c>docker rm</c> and <c>docker container rm</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Returns:   "A <see cref=\"Task\"/> that resolves when the container has been removed.",
+			Errors:    "There is a conflict, the input is invalid, or the daemon experienced an error.",
+		}},
+
+	{Method: "GET", Path: "/containers/{id}/archive",
+		Parameters:  reflect.TypeOf(ContainerPathStatParameters{}),
+		Response:    reflect.TypeOf(container.PathStat{}),
+		NotFound:    NotFoundContainer,
+		HandWritten: "reads the stat from the X-Docker-Container-Path-Stat header, and only sends HEAD for statOnly"},
+
+	{Method: "PUT", Path: "/containers/{id}/archive",
+		Parameters:  reflect.TypeOf(CopyToContainerParameters{}),
+		NotFound:    NotFoundContainer,
+		HandWritten: "sends the archive as an application/x-tar body"},
+
+	{Method: "POST", Path: "/containers/{id}/attach",
+		Parameters:  reflect.TypeOf(ContainerAttachParameters{}),
+		NotFound:    NotFoundContainer,
+		HandWritten: "hijacks the connection for a multiplexed stream"},
+
+	// POST /containers/{id}/attach/ws
+
+	{Method: "GET", Path: "/containers/{id}/changes",
+		Response: reflect.TypeOf([]container.FilesystemChange{}),
+		NotFound: NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "InspectChangesAsync",
+			Summary:   "Reports which files in a container's filesystem have been added, deleted, or modified.",
+			ParamDocs: map[string]string{"id": containerIDDoc},
+			Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to a list of <see cref=\"ContainerFileSystemChangeResponse\"/> objects. Each object corresponds to a single changed file.",
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/exec",
+		Parameters: reflect.TypeOf(ContainerExecCreateParameters{}),
+		Response:   reflect.TypeOf(ContainerExecCreateResponse{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Exec",
+			Name:      "CreateContainerExecAsync",
+			Summary:   "Creates an exec instance, to run a command inside a running container.",
+			Remarks:   "The exec instance is started by <see cref=\"StartContainerExecAsync\"/>.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Body:      ParametersBody,
+		}},
+
+	{Method: "GET", Path: "/containers/{id}/export",
+		NotFound: NotFoundContainer,
+		Stream:   RawStream,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "ExportContainerAsync",
+			Summary:   "Exports the contents of a container as a tarball.",
+			Remarks:   "The corresponding commands in the Docker CLI are <c>docker export</c> and <c>docker container export</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc},
+			Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to a <see cref=\"Stream\"/>, which can be read to obtain the bytes of the tarball.",
+		}},
+
+	// The overload without parameters is implemented by hand.
+	{Method: "GET", Path: "/containers/{id}/json",
+		Parameters: reflect.TypeOf(ContainerInspectParameters{}),
+		Response:   reflect.TypeOf(container.InspectResponse{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "InspectContainerAsync",
+			Summary:   "Retrieves low-level information about a container with additional options.",
+			Remarks:   "The corresponding commands in the Docker CLI are <c>docker inspect --size</c> and <c>docker container inspect --size</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": "Specifics of how to perform the operation, such as whether to include size information."},
+			Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to a <see cref=\"ContainerInspectResponse\"/>, which holds details about the container.",
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/kill",
+		Parameters: reflect.TypeOf(ContainerKillParameters{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "KillContainerAsync",
+			Summary:   "Sends a POSIX signal to a container--typically to kill it.",
+			Remarks:   "The corresponding commands in the Docker CLI are <c>docker kill</c> and <c>docker container kill</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Returns:   completeDoc,
+			SeeAlso:   []string{"StopContainerAsync(string, ContainerStopParameters, CancellationToken)", "PruneContainersAsync(ContainersPruneParameters, CancellationToken)"},
+			Errors:    "The container was not running, the input is invalid, or the daemon experienced an error.",
+		}},
+
+	{Method: "GET", Path: "/containers/{id}/logs",
+		Parameters:  reflect.TypeOf(ContainerLogsParameters{}),
+		NotFound:    NotFoundContainer,
+		Stream:      RawStream,
+		HandWritten: "reads a multiplexed stream, whose framing depends on the TTY of the container"},
+
+	{Method: "POST", Path: "/containers/{id}/pause",
+		NotFound: NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "PauseContainerAsync",
+			Summary:   "Suspends a container.",
+			Remarks:   "This uses the freeze cgroup to suspend all processes in the container. The processes are unaware that they are being\nsuspended (e.g., they cannot capture a SIGSTOP signal).\n<br/>The corresponding commands in the Docker CLI are <c>docker pause</c> and <c>docker container pause</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc},
+			Returns:   completeDoc,
+			SeeAlso:   []string{"UnpauseContainerAsync(string, CancellationToken)"},
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/rename",
+		Parameters: reflect.TypeOf(ContainerRenameParameters{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "RenameContainerAsync",
+			Summary:   "Changes the name of a container.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Returns:   completeDoc,
+			Errors:    "The name is already in use, the input is invalid, or the daemon experienced an error.",
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/resize",
+		Parameters: reflect.TypeOf(ContainerResizeParameters{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "ResizeContainerTtyAsync",
+			Summary:   "Resizes a container's TTY.",
+			Remarks:   "You must restart the container for the change to take effect.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Returns:   completeDoc,
+			SeeAlso:   []string{"RestartContainerAsync(string, ContainerRestartParameters, CancellationToken)"},
+		}},
+
+	{Method: "POST", Path: "/exec/{id}/resize",
+		Parameters: reflect.TypeOf(ContainerResizeParameters{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Exec",
+			Name:      "ResizeExecTtyAsync",
+			Summary:   "Resizes the TTY of an exec instance.",
+			ParamDocs: map[string]string{"id": execIDDoc, "parameters": parametersDoc},
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/restart",
+		Parameters: reflect.TypeOf(ContainerRestartParameters{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:           "Container",
+			Name:            "RestartContainerAsync",
+			Summary:         "Stops and then restarts a container.",
+			Remarks:         "The corresponding commands in the Docker CLI are <c>docker restart</c> and <c>docker container restart</c>.",
+			ParamDocs:       map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Returns:         completeDoc,
+			InfiniteTimeout: true,
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/start",
+		Parameters:  reflect.TypeOf(ContainerStartParameters{}),
+		NotFound:    NotFoundContainer,
+		HandWritten: "returns false if the daemon answers 304 because the container is already started"},
+
+	{Method: "POST", Path: "/containers/{id}/stop",
+		Parameters:  reflect.TypeOf(ContainerStopParameters{}),
+		NotFound:    NotFoundContainer,
+		HandWritten: "returns false if the daemon answers 304 because the container is already stopped"},
+
+	// The obsolete overload returning the raw stream is implemented by hand.
+	{Method: "GET", Path: "/containers/{id}/stats",
+		Parameters: reflect.TypeOf(ContainerStatsParameters{}),
+		Response:   reflect.TypeOf(container.StatsResponse{}),
+		NotFound:   NotFoundContainer,
+		Stream:     ProgressStream,
+		Reader:     "ReadContainerStatsAsync",
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "GetContainerStatsAsync",
+			Summary:   "Retrieves a live, raw stream of the container's resource usage statistics.",
+			Remarks:   "The corresponding commands in the Docker CLI are <c>docker stats</c> and <c>docker container stats</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc, "progress": "Provides a callback to trigger whenever a new frame of statistics is available."},
+			Returns:   "A <see cref=\"Task\"/> that resolves when the stream has ended.",
+		}},
+
+	{Method: "GET", Path: "/containers/{id}/top",
+		Parameters: reflect.TypeOf(ContainerListProcessesParameters{}),
+		Response:   reflect.TypeOf(container.TopResponse{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "ListProcessesAsync",
+			Summary:   "Retrieves a list of processes running within the container.",
+			Remarks:   "This operation is not supported on Windows, because the underlying API does not support it.\n<br/>The corresponding commands in the Docker CLI are <c>docker top</c> and <c>docker container top</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to a <see cref=\"ContainerProcessesResponse\"/>, which holds information about the processes.",
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/unpause",
+		NotFound: NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "UnpauseContainerAsync",
+			Summary:   "Resumes a container that was suspended.",
+			Remarks:   "The corresponding commands in the Docker CLI are <c>docker unpause</c> and <c>docker container unpause</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc},
+			Returns:   completeDoc,
+			SeeAlso:   []string{"PauseContainerAsync(string, CancellationToken)"},
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/update",
+		Parameters: reflect.TypeOf(ContainerUpdateParameters{}),
+		Response:   reflect.TypeOf(ContainerUpdateResponse{}),
+		NotFound:   NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Container",
+			Name:      "UpdateContainerAsync",
+			Summary:   "Changes configuration options of a container without recreating it.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker update</c>.",
+			ParamDocs: map[string]string{"id": containerIDDoc, "parameters": parametersDoc},
+			Returns:   "A <see cref=\"Task{TResult}\"/> that resolves to a <see cref=\"ContainerUpdateResponse\"/>, which provides updated information about the container.",
+			Body:      ParametersBody,
+		}},
+
+	{Method: "POST", Path: "/containers/{id}/wait",
+		Response: reflect.TypeOf(ContainerWaitResponse{}),
+		NotFound: NotFoundContainer,
+		Operation: &Operation{
+			Group:           "Container",
+			Name:            "WaitContainerAsync",
+			Summary:         "Waits for a container to stop.",
+			Remarks:         "The corresponding commands in the Docker CLI are <c>docker wait</c> and <c>docker container wait</c>.",
+			ParamDocs:       map[string]string{"id": containerIDDoc},
+			Returns:         "A <see cref=\"Task{TResult}\"/> that resolves to a <see cref=\"ContainerWaitResponse\"/> when the container has stopped.",
+			InfiniteTimeout: true,
+		}},
+
+	{Method: "POST", Path: "/exec/{id}/start",
+		Parameters:  reflect.TypeOf(ContainerExecStartParameters{}),
+		NotFound:    NotFoundContainer,
+		HandWritten: "hijacks the connection for a multiplexed stream"},
+
+	{Method: "GET", Path: "/exec/{id}/json",
+		Response: reflect.TypeOf(container.ExecInspectResponse{}),
+		NotFound: NotFoundContainer,
+		Operation: &Operation{
+			Group:     "Exec",
+			Name:      "InspectContainerExecAsync",
+			Summary:   "Returns low-level information about an exec instance.",
+			ParamDocs: map[string]string{"id": execIDDoc},
+		}},
+
+	// The obsolete overload returning the raw stream is implemented by hand.
+	{Method: "GET", Path: "/events",
+		Parameters: reflect.TypeOf(ContainerEventsParameters{}),
+		Response:   reflect.TypeOf(events.Message{}),
+		Models:     []reflect.Type{reflect.TypeOf(events.Actor{})},
+		Stream:     ProgressStream,
+		Reader:     "ReadEventsAsync",
+		Operation: &Operation{
+			Group: "System",
+			Name:  "MonitorEventsAsync",
+			Summary: "Monitor events.\n\nStream real-time events from the server.\n\n" +
+				"Various objects within Docker report events when something happens to them.\n\n" +
+				"Containers report these events: {attach, commit, copy, create, destroy, detach, die, exec_create, exec_detach, exec_start, export, kill, oom, pause, rename, resize, restart, start, stop, top, unpause, update}\n\n" +
+				"Images report these events: {delete, import, load, pull, push, save, tag, untag}\n\n" +
+				"Volumes report these events: {create, mount, unmount, destroy}\n\n" +
+				"Networks report these events: {create, connect, disconnect, destroy}\n\n" +
+				"The Docker daemon reports these events: {reload}",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker events</c>.",
+			Responses: []string{"200 - No error.", "500 - Server error."},
+			ParamDocs: map[string]string{"parameters": parametersDoc, "progress": "Provides a callback to trigger whenever an event is received."},
+		}},
+
+	{Method: "GET", Path: "/distribution/{name}/json",
+		Response: reflect.TypeOf(registry.DistributionInspect{}),
+		NotFound: NotFoundImage,
+		Operation: &Operation{
+			Group:     "Distribution",
+			Name:      "InspectAsync",
+			Summary:   "Retrieves low-level information about an image from a registry.",
+			Remarks:   "The equivalent command in the Docker CLI is <c>docker manifest inspect</c>.",
+			ParamDocs: map[string]string{"name": "An image name or reference."},
+		}},
+
+	{Method: "POST", Path: "/images/create",
+		Parameters:  reflect.TypeOf(ImagesCreateParameters{}),
+		Response:    reflect.TypeOf(jsonstream.Message{}),
+		Stream:      ProgressStream,
+		Reader:      "ReadCreateImageMessagesAsync",
+		HandWritten: "sends the registry credentials of the authConfig argument, and imports the image from an application/x-tar body"},
+
+	{Method: "GET", Path: "/images/get",
+		NotFound:    NotFoundImage,
+		Stream:      RawStream,
+		HandWritten: "sends the names query parameter once per image"},
+
+	{Method: "GET", Path: "/images/json",
+		Parameters: reflect.TypeOf(ImagesListParameters{}),
+		Response:   reflect.TypeOf([]image.Summary{}),
+		Operation: &Operation{
+			Group:     "Image",
+			Name:      "ListImagesAsync",
+			Summary:   "Retrieves a list of the images on the server.",
+			Remarks:   "The equivalent commands in the Docker CLI are <c>docker images</c> and <c>docker image ls</c>.",
+			ParamDocs: map[string]string{"parameters": parametersDoc},
+		}},
+
+	{Method: "POST", Path: "/images/load",
+		Parameters:  reflect.TypeOf(ImageLoadParameters{}),
+		Response:    reflect.TypeOf(jsonstream.Message{}),
+		Stream:      ProgressStream,
+		Reader:      "ReadLoadImageMessagesAsync",
+		HandWritten: "sends the images as an application/x-tar body"},
+
+	{Method: "POST", Path: "/images/prune",
+		Parameters: reflect.TypeOf(ImagesPruneParameters{}),
+		Response:   reflect.TypeOf(image.PruneReport{}),
+		Operation: &Operation{
+			Group:              "Image",
+			Name:               "PruneImagesAsync",
+			Summary:            "Deletes unused images.",
+			Remarks:            "The equivalent command in the Docker CLI is <c>docker image prune</c>.",
+			ParamDocs:          map[string]string{"parameters": parametersDoc},
+			Errors:             "There was a conflict, or the input is invalid, or the daemon experienced an error.",
+			OptionalParameters: true,
+		}},
+
+	{Method: "GET", Path: "/images/search",
+		Parameters: reflect.TypeOf(ImagesSearchParameters{}),
+		Response:   reflect.TypeOf([]registry.SearchResult{}),
+		Operation: &Operation{
+			Group:     "Image",
+			Name:      "SearchImagesAsync",
+			Summary:   "Searches for an image on Docker Hub.",
+			Remarks:   "The equivalent command in the Docker CLI is <c>docker search</c>.",
+			ParamDocs: map[string]string{"parameters": parametersDoc},
+			Errors:    "There was a conflict, or the input is invalid, or the daemon experienced an error.",
+		}},
+
+	{Method: "DELETE", Path: "/images/{name}",
+		Parameters:  reflect.TypeOf(ImageDeleteParameters{}),
+		Response:    reflect.TypeOf([]image.DeleteResponse{}),
+		NotFound:    NotFoundImage,
+		HandWritten: "returns the untagged and deleted images as dictionaries"},
+
+	{Method: "GET", Path: "/images/{name}/history",
+		Response: reflect.TypeOf([]image.HistoryResponseItem{}),
+		NotFound: NotFoundImage,
+		Operation: &Operation{
+			Group:     "Image",
+			Name:      "GetImageHistoryAsync",
+			Summary:   "Gets the \"history\" (parent layers) of an image.",
+			Remarks:   "The equivalent commands in the Docker CLI are <c>docker history</c> and <c>docker image history</c>.",
+			ParamDocs: map[string]string{"name": imageNameDoc},
+		}},
+
+	{Method: "GET", Path: "/images/{name}/json",
+		Response: reflect.TypeOf(image.InspectResponse{}),
+		NotFound: NotFoundImage,
+		Operation: &Operation{
+			Group:     "Image",
+			Name:      "InspectImageAsync",
+			Summary:   "Retrieves low-level information about an image.",
+			Remarks:   "The equivalent commands in the Docker CLI are <c>docker inspect</c> and <c>docker image inspect</c>.",
+			ParamDocs: map[string]string{"name": imageNameDoc},
+		}},
+
+	{Method: "POST", Path: "/images/{name}/push",
+		Parameters:  reflect.TypeOf(ImagePushParameters{}),
+		Response:    reflect.TypeOf(jsonstream.Message{}),
+		Stream:      ProgressStream,
+		Reader:      "ReadPushImageMessagesAsync",
+		HandWritten: "sends the registry credentials of the authConfig argument"},
+
+	{Method: "POST", Path: "/images/{name}/tag",
+		Parameters: reflect.TypeOf(ImageTagParameters{}),
+		NotFound:   NotFoundImage,
+		Operation: &Operation{
+			Group:     "Image",
+			Name:      "TagImageAsync",
+			Summary:   "Tags an image so that it becomes part of a registry.",
+			Remarks:   "The equivalent commands in the Docker CLI are <c>docker tag</c> and <c>docker image tag</c>.",
+			ParamDocs: map[string]string{"name": imageNameDoc, "parameters": parametersDoc},
+			Errors:    "There was a conflict, or the input is invalid, or the daemon experienced an error.",
+		}},
+
+	{Method: "GET", Path: "/info",
+		Response: reflect.TypeOf(system.Info{}),
+		Models:   []reflect.Type{reflect.TypeOf(registry.ServiceConfig{})},
+		Operation: &Operation{
+			Group:     "System",
+			Name:      "GetSystemInfoAsync",
+			Summary:   "Get system information.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker info</c>.",
+			Responses: []string{"200 - No error.", "500 - Server error."},
+		}},
+
+	{Method: "GET", Path: "/system/df",
+		Parameters: reflect.TypeOf(SytemDataUsageInfoParameters{}),
+		Response:   reflect.TypeOf(system.DiskUsage{}),
+		Operation: &Operation{
+			Group:              "System",
+			Name:               "GetDataUsageInfoAsync",
+			Summary:            "Get data usage information",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker system df</c>.",
+			Responses:          []string{"200 - No error.", "500 - Server error."},
+			ParamDocs:          map[string]string{"parameters": parametersDoc},
+			OptionalParameters: true,
+		}},
+
+	{Method: "GET", Path: "/networks",
+		Parameters: reflect.TypeOf(NetworksListParameters{}),
+		Response:   reflect.TypeOf([]network.Inspect{}),
+		Operation: &Operation{
+			Group:              "Network",
+			Name:               "ListNetworksAsync",
+			Summary:            "List networks.",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker network ls</c>.",
+			Responses:          []string{"200 - No error.", "500 - Server error."},
+			ParamDocs:          map[string]string{"parameters": parametersDoc},
+			OptionalParameters: true,
+		}},
+
+	{Method: "POST", Path: "/networks/create",
+		Parameters: reflect.TypeOf(network.CreateRequest{}),
+		Response:   reflect.TypeOf(network.CreateResponse{}),
+		Operation: &Operation{
+			Group:     "Network",
+			Name:      "CreateNetworkAsync",
+			Summary:   "Create a network.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker network create</c>.",
+			Responses: []string{"201 - No error.", "403 - Operation not supported for pre-defined networks.", "404 - Plugin not found.", "500 - Server error."},
+			ParamDocs: map[string]string{"parameters": parametersDoc},
+			Body:      ParametersBody,
+		}},
+
+	{Method: "POST", Path: "/networks/prune",
+		Parameters: reflect.TypeOf(NetworksDeleteUnusedParameters{}),
+		Response:   reflect.TypeOf(network.PruneReport{}),
+		Operation: &Operation{
+			Group:              "Network",
+			Name:               "PruneNetworksAsync",
+			Summary:            "Delete unused networks.",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker network prune</c>.",
+			Responses:          []string{"200 - No error.", "500 - Server error."},
+			ParamDocs:          map[string]string{"parameters": parametersDoc},
+			OptionalParameters: true,
+		}},
+
+	{Method: "GET", Path: "/networks/{id}",
+		Response: reflect.TypeOf(network.Inspect{}),
+		NotFound: NotFoundNetwork,
+		Operation: &Operation{
+			Group:     "Network",
+			Name:      "InspectNetworkAsync",
+			Summary:   "Inspect a network.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker network inspect</c>.",
+			Responses: []string{"200 - No error.", "404 - Network not found.", "500 - Server error."},
+			ParamDocs: map[string]string{"id": networkIDDoc},
+		}},
+
+	{Method: "DELETE", Path: "/networks/{id}",
+		NotFound: NotFoundNetwork,
+		Operation: &Operation{
+			Group:     "Network",
+			Name:      "DeleteNetworkAsync",
+			Summary:   "Remove a network.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker network rm</c>.",
+			Responses: []string{"204 - No error.", "404 - No such network.", "500 - Server error."},
+			ParamDocs: map[string]string{"id": networkIDDoc},
+		}},
+
+	{Method: "POST", Path: "/networks/{id}/connect",
+		Parameters: reflect.TypeOf(client.NetworkConnectOptions{}),
+		NotFound:   NotFoundNetwork,
+		Operation: &Operation{
+			Group:           "Network",
+			Name:            "ConnectNetworkAsync",
+			Summary:         "Connect a container to a network.",
+			Remarks:         "The corresponding command in the Docker CLI is <c>docker network connect</c>.",
+			Responses:       []string{"200 - No error.", "403 - Operation not supported for swarm scoped networks.", "404 - Network or container not found.", "500 - Server error."},
+			ParamDocs:       map[string]string{"id": networkIDDoc, "parameters": parametersDoc},
+			RequiredMembers: []string{"Container"},
+			Body:            ParametersBody,
+		}},
+
+	{Method: "POST", Path: "/networks/{id}/disconnect",
+		Parameters: reflect.TypeOf(client.NetworkDisconnectOptions{}),
+		NotFound:   NotFoundNetwork,
+		Operation: &Operation{
+			Group:           "Network",
+			Name:            "DisconnectNetworkAsync",
+			Summary:         "Disconnect a container from a network.",
+			Remarks:         "The corresponding command in the Docker CLI is <c>docker network disconnect</c>.",
+			Responses:       []string{"200 - No error.", "403 - Operation not supported for swarm scoped networks.", "404 - Network or container not found.", "500 - Server error."},
+			ParamDocs:       map[string]string{"id": networkIDDoc, "parameters": parametersDoc},
+			RequiredMembers: []string{"Container"},
+			Body:            ParametersBody,
+		}},
+
+	{Method: "GET", Path: "/plugins",
+		Parameters: reflect.TypeOf(PluginListParameters{}),
+		Response:   reflect.TypeOf([]plugin.Plugin{}),
+		Operation: &Operation{
+			Group:              "Plugin",
+			Name:               "ListPluginsAsync",
+			Summary:            "List plugins.\n\nReturns information about installed plugins.",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker plugin ls</c>.",
+			Responses:          []string{"200 - No error.", "500 - Server error."},
+			ParamDocs:          map[string]string{"parameters": parametersDoc},
+			OptionalParameters: true,
+		}},
+
+	{Method: "GET", Path: "/plugins/privileges",
+		Parameters: reflect.TypeOf(PluginGetPrivilegeParameters{}),
+		Response:   reflect.TypeOf([]plugin.Privilege{}),
+		Operation: &Operation{
+			Group:     "Plugin",
+			Name:      "GetPrivilegesAsync",
+			Summary:   "Get plugin privileges.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker plugin privileges</c>.",
+			Responses: []string{"200 - No error.", "500 - Server error."},
+			ParamDocs: map[string]string{"parameters": parametersDoc},
+		}},
+
+	{Method: "POST", Path: "/plugins/pull",
+		Parameters: reflect.TypeOf(PluginInstallParameters{}),
+		Response:   reflect.TypeOf(jsonstream.Message{}),
+		Stream:     ProgressStream,
+		Reader:     "ReadInstallPluginMessagesAsync",
+		Operation: &Operation{
+			Group:           "Plugin",
+			Name:            "InstallPluginAsync",
+			Summary:         "Install a plugin.\n\nPulls and installs a plugin. After the plugin is installed, it can be enabled using the `POST /plugins/{name}/enable` endpoint.",
+			Remarks:         "The corresponding command in the Docker CLI is <c>docker plugin install</c>.",
+			Responses:       []string{"204 - No error.", "500 - Server error."},
+			ParamDocs:       map[string]string{"parameters": parametersDoc, "progress": "Provides a callback to trigger whenever a progress message is received."},
+			RequiredMembers: []string{"Privileges"},
+			Body:            ParametersMemberBody,
+			BodyMember:      "Privileges",
+		}},
+
+	{Method: "GET", Path: "/plugins/{name}/json",
+		Response: reflect.TypeOf(plugin.Plugin{}),
+		NotFound: NotFoundPlugin,
+		Operation: &Operation{
+			Group:     "Plugin",
+			Name:      "InspectPluginAsync",
+			Summary:   "Inspect a plugin.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker plugin inspect</c>.",
+			Responses: []string{"200 - No error.", "404 - Plugin not installed.", "500 - Server error."},
+			ParamDocs: map[string]string{"name": pluginNameDoc},
+		}},
+
+	{Method: "DELETE", Path: "/plugins/{name}",
+		Parameters: reflect.TypeOf(PluginRemoveParameters{}),
+		NotFound:   NotFoundPlugin,
+		Operation: &Operation{
+			Group:              "Plugin",
+			Name:               "RemovePluginAsync",
+			Summary:            "Remove a plugin.",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker plugin rm</c>.",
+			Responses:          []string{"200 - No error.", "404 - Plugin not installed.", "500 - Server error."},
+			ParamDocs:          map[string]string{"name": pluginNameDoc, "parameters": parametersDoc},
+			OptionalParameters: true,
+		}},
+
+	{Method: "POST", Path: "/plugins/{name}/enable",
+		Parameters: reflect.TypeOf(PluginEnableParameters{}),
+		NotFound:   NotFoundPlugin,
+		Operation: &Operation{
+			Group:              "Plugin",
+			Name:               "EnablePluginAsync",
+			Summary:            "Enable a plugin.",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker plugin enable</c>.",
+			Responses:          []string{"200 - No error.", "404 - Plugin not installed.", "500 - Server error."},
+			ParamDocs:          map[string]string{"name": pluginNameDoc, "parameters": parametersDoc},
+			OptionalParameters: true,
+		}},
+
+	{Method: "POST", Path: "/plugins/{name}/disable",
+		Parameters: reflect.TypeOf(PluginDisableParameters{}),
+		NotFound:   NotFoundPlugin,
+		Operation: &Operation{
+			Group:              "Plugin",
+			Name:               "DisablePluginAsync",
+			Summary:            "Disable a plugin.",
+			Remarks:            "The corresponding command in the Docker CLI is <c>docker plugin disable</c>.",
+			Responses:          []string{"200 - No error.", "404 - Plugin not installed.", "500 - Server error."},
+			ParamDocs:          map[string]string{"name": pluginNameDoc, "parameters": parametersDoc},
+			OptionalParameters: true,
+		}},
+
+	{Method: "POST", Path: "/plugins/{name}/upgrade",
+		Parameters: reflect.TypeOf(PluginUpgradeParameters{}),
+		NotFound:   NotFoundPlugin,
+		Operation: &Operation{
+			Group:           "Plugin",
+			Name:            "UpgradePluginAsync",
+			Summary:         "Upgrade a plugin.",
+			Remarks:         "The corresponding command in the Docker CLI is <c>docker plugin upgrade</c>.",
+			Responses:       []string{"200 - No error.", "404 - Plugin not installed.", "500 - Server error."},
+			ParamDocs:       map[string]string{"name": pluginNameDoc, "parameters": parametersDoc},
+			RequiredMembers: []string{"Privileges"},
+			Body:            ParametersMemberBody,
+			BodyMember:      "Privileges",
+		}},
+
+	{Method: "POST", Path: "/plugins/create",
+		Parameters:  reflect.TypeOf(PluginCreateParameters{}),
+		HandWritten: "sends the plugin as an application/x-tar body"},
+
+	{Method: "POST", Path: "/plugins/{name}/push",
+		NotFound: NotFoundPlugin,
+		Operation: &Operation{
+			Group:     "Plugin",
+			Name:      "PushPluginAsync",
+			Summary:   "Push a plugin.\n\nPush a plugin to the registry.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker plugin push</c>.",
+			Responses: []string{"200 - No error.", "404 - Plugin not installed.", "500 - Server error."},
+			ParamDocs: map[string]string{"name": pluginNameDoc},
+		}},
+
+	{Method: "POST", Path: "/plugins/{name}/set",
+		Parameters: reflect.TypeOf(PluginConfigureParameters{}),
+		NotFound:   NotFoundPlugin,
+		Operation: &Operation{
+			Group:           "Plugin",
+			Name:            "ConfigurePluginAsync",
+			Summary:         "Configure a plugin.",
+			Remarks:         "The corresponding command in the Docker CLI is <c>docker plugin set</c>.",
+			Responses:       []string{"204 - No error.", "404 - Plugin not installed.", "500 - Server error."},
+			ParamDocs:       map[string]string{"name": pluginNameDoc, "parameters": parametersDoc},
+			RequiredMembers: []string{"Args"},
+			Body:            ParametersMemberBody,
+			BodyMember:      "Args",
+		}},
+
+	{Method: "GET", Path: "/version",
+		Response: reflect.TypeOf(system.VersionResponse{}),
+		Operation: &Operation{
+			Group:     "System",
+			Name:      "GetVersionAsync",
+			Summary:   "Get version.\n\nReturns the version of Docker that is running and various information about the system that Docker is running on.",
+			Remarks:   "The corresponding command in the Docker CLI is <c>docker version</c>.",
+			Responses: []string{"200 - No error.", "500 - Server error."},
+		}},
+
+	{Method: "GET", Path: "/volumes",
+		Parameters: reflect.TypeOf(VolumesListParameters{}),
+		Response:   reflect.TypeOf(VolumesListResponse{}),
+		Operation: &Operation{
+			Group:                 "Volume",
+			Name:                  "ListAsync",
+			Summary:               "List volumes",
+			Responses:             []string{"200 - No error.", "500 - Server error."},
+			OptionalParameters:    true,
+			ParameterlessOverload: true,
+		}},
+
+	{Method: "POST", Path: "/volumes/create",
+		Parameters: reflect.TypeOf(VolumesCreateParameters{}),
+		Response:   reflect.TypeOf(VolumeResponse{}),
+		Operation: &Operation{
+			Group:     "Volume",
+			Name:      "CreateAsync",
+			Summary:   "Create a volume.",
+			Responses: []string{"201 - The volume was created successfully.", "500 - Server error."},
+			ParamDocs: map[string]string{"parameters": "Volume parameters to create."},
+			Body:      ParametersBody,
+		}},
+
+	{Method: "POST", Path: "/volumes/prune",
+		Parameters: reflect.TypeOf(VolumesPruneParameters{}),
+		Response:   reflect.TypeOf(volume.PruneReport{}),
+		Operation: &Operation{
+			Group:              "Volume",
+			Name:               "PruneAsync",
+			Summary:            "Delete unused volumes.",
+			Responses:          []string{"200 - No error.", "500 - Server error."},
+			OptionalParameters: true,
+		}},
+
+	{Method: "GET", Path: "/volumes/{name}",
+		Response: reflect.TypeOf(VolumeResponse{}),
+		Operation: &Operation{
+			Group:     "Volume",
+			Name:      "InspectAsync",
+			Summary:   "Inspect a volume.",
+			Responses: []string{"200 - No error.", "404 - No such volume.", "500 - Server error."},
+			ParamDocs: map[string]string{"name": "Volume name or ID."},
+		}},
+
+	{Method: "DELETE", Path: "/volumes/{name}",
+		Parameters: reflect.TypeOf(VolumeRemoveParameters{}),
+		Operation: &Operation{
+			Group:            "Volume",
+			Name:             "RemoveAsync",
+			Summary:          "Remove a volume.\n\nInstruct the driver to remove the volume.",
+			Responses:        []string{"204 - The volume was removed.", "404 - No such volume or volume driver.", "409 - Volume is in use and cannot be removed.", "500 - Server error."},
+			ParamDocs:        map[string]string{"name": "Volume name or ID.", "force": "Force the removal of the volume."},
+			ExpandParameters: true,
+		}},
+
+	//
+	// Swarm API
+	//
+
+	{Method: "POST", Path: "/swarm/init",
+		Parameters:  reflect.TypeOf(swarm.InitRequest{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "POST", Path: "/swarm/join",
+		Parameters:  reflect.TypeOf(swarm.JoinRequest{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "POST", Path: "/swarm/leave",
+		Parameters:  reflect.TypeOf(SwarmLeaveParameters{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "GET", Path: "/swarm",
+		Response:    reflect.TypeOf(swarm.Swarm{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "GET", Path: "/swarm/unlockkey",
+		Response:    reflect.TypeOf(SwarmUnlockResponse{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "POST", Path: "/swarm/update",
+		Parameters:  reflect.TypeOf(SwarmUpdateParameters{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "POST", Path: "/swarm/unlock",
+		Parameters:  reflect.TypeOf(SwarmUnlockParameters{}),
+		HandWritten: swarmHandWritten},
+
+	//
+	// Secrets API (swarm)
+	//
+
+	{Method: "GET", Path: "/secrets",
+		Response: reflect.TypeOf([]swarm.Secret{}),
+		Operation: &Operation{
+			Group:     "Secrets",
+			Name:      "ListAsync",
+			Summary:   "List secrets",
+			Responses: []string{"200 - No error.", "500 - Server error."},
+		}},
+
+	{Method: "GET", Path: "/secrets/{id}",
+		Response: reflect.TypeOf(swarm.Secret{}),
+		Operation: &Operation{
+			Group:     "Secrets",
+			Name:      "InspectAsync",
+			Summary:   "Inspect a secret",
+			Responses: []string{"200 - No error.", "404 - Secret not found.", "406 - Node is not part of a swarm.", "500 - Server error."},
+			ParamDocs: map[string]string{"id": "ID of the secret."},
+		}},
+
+	{Method: "POST", Path: "/secrets/create",
+		Parameters: reflect.TypeOf(swarm.SecretSpec{}),
+		Response:   reflect.TypeOf(SecretCreateResponse{}),
+		Operation: &Operation{
+			Group:         "Secrets",
+			Name:          "CreateAsync",
+			Summary:       "Create a secret",
+			Responses:     []string{"201 - No error.", "406 - Server error or node is not part of a swarm.", "409 - Name conflicts with an existing object.", "500 - Server error."},
+			ParameterName: "body",
+			Body:          ParametersBody,
+		}},
+
+	{Method: "DELETE", Path: "/secrets/{id}",
+		Operation: &Operation{
+			Group:     "Secrets",
+			Name:      "DeleteAsync",
+			Summary:   "Delete a secret",
+			Responses: []string{"204 - No error.", "404 - Secret not found.", "500 - Server error."},
+			ParamDocs: map[string]string{"id": "ID of the secret."},
+		}},
+
+	//
+	// Configs API (swarm)
+	//
+
+	{Method: "GET", Path: "/configs",
+		Response: reflect.TypeOf([]SwarmConfig{}),
+		Operation: &Operation{
+			Group:     "Config",
+			Name:      "ListConfigsAsync",
+			Summary:   "List configs",
+			Responses: []string{"200 - No error.", "500 - Server error."},
+		}},
+
+	{Method: "GET", Path: "/configs/{id}",
+		Response: reflect.TypeOf(SwarmConfig{}),
+		Models:   []reflect.Type{reflect.TypeOf(swarm.ConfigReference{})},
+		Operation: &Operation{
+			Group:     "Config",
+			Name:      "InspectConfigAsync",
+			Summary:   "Inspect a configs",
+			Responses: []string{"200 - No error.", "404 - Secret not found.", "406 - Node is not part of a swarm.", "500 - Server error."},
+			ParamDocs: map[string]string{"id": "ID of the config."},
+		}},
+
+	{Method: "POST", Path: "/configs/create",
+		Parameters: reflect.TypeOf(SwarmCreateConfigParameters{}),
+		Response:   reflect.TypeOf(SwarmCreateConfigResponse{}),
+		Operation: &Operation{
+			Group:         "Config",
+			Name:          "CreateConfigAsync",
+			Summary:       "Create a configs",
+			Responses:     []string{"201 - No error.", "406 - Server error or node is not part of a swarm.", "409 - Name conflicts with an existing object.", "500 - Server error."},
+			ParameterName: "body",
+			Body:          ParametersMemberBody,
+			BodyMember:    "Config",
+		}},
+
+	{Method: "POST", Path: "/configs/{id}/update",
+		Parameters:    reflect.TypeOf(SwarmUpdateConfigParameters{}),
+		Models:        []reflect.Type{reflect.TypeOf(swarm.ConfigSpec{})},
+		Unimplemented: "adding UpdateConfigAsync would break the implementers of IConfigOperations"},
+
+	{Method: "DELETE", Path: "/configs/{id}",
+		Operation: &Operation{
+			Group:     "Config",
+			Name:      "RemoveConfigAsync",
+			Summary:   "Remove a configs",
+			Responses: []string{"204 - No error.", "404 - Secret not found.", "500 - Server error."},
+			ParamDocs: map[string]string{"id": "ID of the config."},
+		}},
+
+	{Method: "GET", Path: "/services",
+		Parameters:  reflect.TypeOf(ServiceListParameters{}),
+		Response:    reflect.TypeOf([]swarm.Service{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "GET", Path: "/services/{id}",
+		Response:    reflect.TypeOf(swarm.Service{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "POST", Path: "/services/create",
+		Parameters:  reflect.TypeOf(ServiceCreateParameters{}),
+		Response:    reflect.TypeOf(swarm.ServiceCreateResponse{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "POST", Path: "/services/{id}/update",
+		Parameters:  reflect.TypeOf(ServiceUpdateParameters{}),
+		Response:    reflect.TypeOf(swarm.ServiceUpdateResponse{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "DELETE", Path: "/services/{id}",
+		HandWritten: swarmHandWritten},
+
+	{Method: "GET", Path: "/services/{id}/logs",
+		Parameters:  reflect.TypeOf(ServiceLogsParameters{}),
+		Stream:      RawStream,
+		HandWritten: swarmHandWritten},
+
+	{Method: "GET", Path: "/tasks",
+		Parameters: reflect.TypeOf(TasksListParameters{}),
+		Response:   reflect.TypeOf([]swarm.Task{}),
+		Operation: &Operation{
+			Group:                 "Tasks",
+			Name:                  "ListAsync",
+			Summary:               "List tasks",
+			Responses:             []string{"200 - No error.", "500 - Server error."},
+			OptionalParameters:    true,
+			ParameterlessOverload: true,
+		}},
+
+	{Method: "GET", Path: "/tasks/{id}",
+		Response: reflect.TypeOf(swarm.Task{}),
+		Operation: &Operation{
+			Group:     "Tasks",
+			Name:      "InspectAsync",
+			Summary:   "Inspect a task",
+			Responses: []string{"200 - No error.", "404 - No such task.", "500 - Server error."},
+			ParamDocs: map[string]string{"id": "ID of the task."},
+		}},
+
+	{Method: "GET", Path: "/nodes",
+		Response:    reflect.TypeOf([]swarm.Node{}),
+		Models:      []reflect.Type{reflect.TypeOf(swarm.TLSInfo{})},
+		HandWritten: swarmHandWritten},
+
+	{Method: "GET", Path: "/nodes/{id}",
+		Response:    reflect.TypeOf(swarm.Node{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "DELETE", Path: "/nodes/{id}",
+		Parameters:  reflect.TypeOf(NodeRemoveParameters{}),
+		HandWritten: swarmHandWritten},
+
+	{Method: "POST", Path: "/nodes/{id}/update",
+		Parameters:  reflect.TypeOf(swarm.NodeSpec{}),
+		HandWritten: swarmHandWritten},
+}
+
+// checkRouteOperations reports the routes that have neither an Operation nor a
+// HandWritten or Unimplemented reason, or more than one of them, so the routes
+// left to hand-written code or not implemented at all stay listed.
+func checkRouteOperations(routes []Route) {
+	for _, r := range routes {
+		switch {
+		case r.Operation == nil && r.HandWritten == "" && r.Unimplemented == "":
+			reportError("route (%s) has neither an Operation nor a HandWritten or Unimplemented reason", r)
+		case r.Operation != nil && r.HandWritten != "":
+			reportError("route (%s) has an Operation, but is HandWritten: %s", r, r.HandWritten)
+		case r.Operation != nil && r.Unimplemented != "":
+			reportError("route (%s) has an Operation, but is Unimplemented: %s", r, r.Unimplemented)
+		case r.HandWritten != "" && r.Unimplemented != "":
+			reportError("route (%s) is HandWritten, but also Unimplemented: %s", r, r.Unimplemented)
+		}
+	}
+}
+
+// checkRouteModels reports the Models of the routes that produce neither a C#
+// model nor an enum, e.g. because the type is mapped to a .NET type.
+func checkRouteModels(routes []Route) {
+	for _, r := range routes {
+		for _, t := range r.Models {
+			k := typeToKey(ultimateType(t))
+			if _, ok := reflectedTypes[k]; ok {
+				continue
+			}
+
+			if _, ok := reflectedEnums[k]; ok {
+				continue
+			}
+
+			reportError("route (%s) model (%s) produces no C# type", r, t)
+		}
+	}
+}
+
+// routeModels returns the types reflected for all routes, in table order.
+func routeModels() []reflect.Type {
+	var models []reflect.Type
+	for _, r := range routes {
+		for _, t := range append([]reflect.Type{r.Parameters, r.Response}, r.Models...) {
+			if t == nil {
+				continue
+			}
+
+			if t.Kind() == reflect.Slice {
+				t = t.Elem()
+			}
+
+			models = append(models, t)
+		}
+	}
+
+	return models
+}
+
+// responseOnlyTypeKeys returns the keys of the types reachable from the
+// response of a route, but not from the parameters or additional models of
+// any route. Only these types are never sent to the daemon.
+func responseOnlyTypeKeys(routes []Route) map[string]bool {
+	responses, requests := map[string]reflect.Type{}, map[string]reflect.Type{}
+	for _, r := range routes {
+		if r.Response != nil {
+			collectTypes(r.Response, responses)
+		}
+
+		for _, t := range append([]reflect.Type{r.Parameters}, r.Models...) {
+			if t != nil {
+				collectTypes(t, requests)
+			}
+		}
+	}
+
+	keys := map[string]bool{}
+	for k := range responses {
+		if _, ok := requests[k]; !ok {
+			keys[k] = true
+		}
+	}
+
+	return keys
+}
+
+// applyResponseStyle declares the models that are only ever responses of
+// routes with style. All other models stay mutable classes.
+func applyResponseStyle(routes []Route, models map[string]*CSModelType, style CSModelStyle) {
+	if style == CSModelStyleClass {
+		return
+	}
+
+	for k := range responseOnlyTypeKeys(routes) {
+		// Union members derive from their abstract base class, which a record cannot.
+		if m, ok := models[k]; ok && m.BaseType == "" {
+			m.Style = style
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestCheckRouteOperations reports routes without an Operation or a reason to
+// be hand-written or not implemented, and routes with more than one.
+func TestCheckRouteOperations(t *testing.T) {
+	resetGeneratorState(t)
+
+	checkRouteOperations([]Route{
+		{Method: "GET", Path: "/golden", Operation: &Operation{Name: "ListAsync"}},
+		{Method: "POST", Path: "/golden/{id}/attach", HandWritten: "hijacks the connection"},
+		{Method: "GET", Path: "/golden/{id}"},
+		{Method: "DELETE", Path: "/golden/{id}", Operation: &Operation{Name: "RemoveAsync"}, HandWritten: "ignores force"},
+		{Method: "POST", Path: "/golden/{id}/update", Unimplemented: "would break the implementers"},
+		{Method: "POST", Path: "/golden/{id}/rename", Operation: &Operation{Name: "RenameAsync"}, Unimplemented: "would break the implementers"},
+		{Method: "POST", Path: "/golden/{id}/kill", HandWritten: "sends the signal", Unimplemented: "would break the implementers"},
+	})
+
+	want := []string{
+		"route (GET /golden/{id}) has neither an Operation nor a HandWritten or Unimplemented reason",
+		"route (DELETE /golden/{id}) has an Operation, but is HandWritten: ignores force",
+		"route (POST /golden/{id}/rename) has an Operation, but is Unimplemented: would break the implementers",
+		"route (POST /golden/{id}/kill) is HandWritten, but also Unimplemented: would break the implementers",
+	}
+
+	var got []string
+	for _, err := range generationErrors {
+		got = append(got, err.Error())
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("errors = %q, want %q", got, want)
+	}
+}
+
+func TestCheckRouteModels(t *testing.T) {
+	resetGeneratorState(t)
+	extractGoldenComments(t)
+
+	routes := []Route{
+		{Method: "GET", Path: "/golden",
+			Response: reflect.TypeOf(GoldenEnums{}),
+			Models:   []reflect.Type{reflect.TypeOf(GoldenChild{}), reflect.TypeOf(GoldenMode(""))}},
+		{Method: "GET", Path: "/golden/time",
+			Models: []reflect.Type{reflect.TypeOf([]GoldenBase{}), reflect.TypeOf(time.Time{})}},
+	}
+
+	for _, typ := range []reflect.Type{reflect.TypeOf(GoldenEnums{}), reflect.TypeOf(GoldenChild{}), reflect.TypeOf(GoldenBase{})} {
+		reflectType(typ)
+	}
+
+	checkRouteModels(routes)
+
+	want := "route (GET /golden/time) model (time.Time) produces no C# type"
+	if len(generationErrors) != 1 || generationErrors[0].Error() != want {
+		t.Errorf("errors = %v, want [%s]", generationErrors, want)
+	}
+}
@@ -1,33 +1,22 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
+	"flag"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"io"
 	"io/fs"
+	"maps"
 	"os"
 	"os/exec"
-	"path"
 	"path/filepath"
 	"reflect"
 	"slices"
 	"strconv"
 	"strings"
-
-	"github.com/moby/moby/api/types/build"
-	"github.com/moby/moby/api/types/container"
-	"github.com/moby/moby/api/types/events"
-	"github.com/moby/moby/api/types/image"
-	"github.com/moby/moby/api/types/jsonstream"
-	"github.com/moby/moby/api/types/network"
-	"github.com/moby/moby/api/types/plugin"
-	"github.com/moby/moby/api/types/registry"
-	"github.com/moby/moby/api/types/swarm"
-	"github.com/moby/moby/api/types/system"
-	"github.com/moby/moby/api/types/volume"
-	"github.com/moby/moby/client"
 )
 
 var reflectedTypes = map[string]*CSModelType{}
@@ -38,535 +27,21 @@ var typeComments = map[string]string{}
 // FieldComments maps package.TypeName.FieldName to the field's documentation comment
 var fieldComments = map[string]string{}
 
+// goTypeFields maps package.TypeName of the types declared in the Go sources to
+// the names of their fields, empty for types that are not structs.
+var goTypeFields = map[string][]string{}
+
 func typeToKey(t reflect.Type) string {
 	return t.String()
 }
 
-// TODO: This type is no longer public (the JSON bool field was removed).
-// Only the interface is available. For now, create an empty type so the
-// code compiles, and we'll figure out the proper implementation later:
-// https://github.com/moby/moby/blob/master/client/image_load.go
-type ImageLoadResult struct{}
-
-var typesToDisambiguate = map[string]*CSModelType{
-	typeToKey(reflect.TypeOf(container.Config{})): {
-		Name: "ContainerConfig",
-		Properties: []CSProperty{
-			{
-				Name:       "StopTimeout",
-				Type:       CSType{"System", "TimeSpan"},
-				Attributes: []CSAttribute{{Type: CSType{"System.Text.Json.Serialization", "JsonConverter"}, Arguments: []CSArgument{{Value: "typeof(JsonTimeSpanSecondsConverter)"}}}},
-			},
-		},
-	},
-	typeToKey(reflect.TypeOf(container.HostConfig{})): {
-		Properties: []CSProperty{
-			{
-				Name:       "ConsoleSize",
-				Type:       CSType{"", "ConsoleSize"},
-				Attributes: []CSAttribute{{Type: CSType{"System.Text.Json.Serialization", "JsonConverter"}, Arguments: []CSArgument{{Value: "typeof(JsonConsoleSizeConverter)"}}}},
-			},
-		},
-	},
-	typeToKey(reflect.TypeOf(container.CreateResponse{})): {Name: "CreateContainerResponse"},
-	typeToKey(reflect.TypeOf(container.RestartPolicy{})): {
-		Properties: []CSProperty{{Name: "Name", Type: CSType{"", "RestartPolicyKind"}}},
-	},
-	typeToKey(reflect.TypeOf(jsonstream.Error{})): {
-		Name: "JSONError",
-	},
-	typeToKey(reflect.TypeOf(jsonstream.Message{})): {
-		Name: "JSONMessage",
-		Properties: []CSProperty{
-			{
-				Name: "Time",
-				Type: CSType{"System", "DateTime"},
-			},
-			{
-				Name: "Aux",
-				Type: CSType{"", "ObjectExtensionData"},
-			},
-		},
-	},
-	typeToKey(reflect.TypeOf(jsonstream.Progress{})): {
-		Name: "JSONProgress",
-	},
-	typeToKey(reflect.TypeOf(CreateContainerParameters{})): {
-		Properties: []CSProperty{
-			{
-				Name:       "StopTimeout",
-				Type:       CSType{"System", "TimeSpan"},
-				Attributes: []CSAttribute{{Type: CSType{"System.Text.Json.Serialization", "JsonConverter"}, Arguments: []CSArgument{{Value: "typeof(JsonTimeSpanSecondsConverter)"}}}},
-			},
-		},
-	},
-	typeToKey(reflect.TypeOf(ContainerExecCreateParameters{})): {
-		Properties: []CSProperty{
-			{
-				Name:       "ConsoleSize",
-				Type:       CSType{"", "ConsoleSize"},
-				Attributes: []CSAttribute{{Type: CSType{"System.Text.Json.Serialization", "JsonConverter"}, Arguments: []CSArgument{{Value: "typeof(JsonConsoleSizeConverter)"}}}},
-			},
-		},
-	},
-	typeToKey(reflect.TypeOf(ContainerExecStartParameters{})): {
-		Properties: []CSProperty{
-			{
-				Name:       "ConsoleSize",
-				Type:       CSType{"", "ConsoleSize"},
-				Attributes: []CSAttribute{{Type: CSType{"System.Text.Json.Serialization", "JsonConverter"}, Arguments: []CSArgument{{Value: "typeof(JsonConsoleSizeConverter)"}}}},
-			},
-		},
-	},
-	typeToKey(reflect.TypeOf(volume.AccessMode{})):     {Name: "VolumeAccessMode"},
-	typeToKey(reflect.TypeOf(volume.Info{})):           {Name: "VolumeInfo"},
-	typeToKey(reflect.TypeOf(volume.Secret{})):         {Name: "VolumeSecret"},
-	typeToKey(reflect.TypeOf(volume.Topology{})):       {Name: "VolumeTopology"},
-	typeToKey(reflect.TypeOf(registry.AuthResponse{})): {Name: "AuthResponse"},
-	typeToKey(reflect.TypeOf(registry.DistributionInspect{})): {
-		Name: "DistributionInspectResponse",
-	},
-	typeToKey(reflect.TypeOf(registry.SearchResult{})): {Name: "ImageSearchResponse"},
-	typeToKey(reflect.TypeOf(swarm.RuntimeSpec{})):     {Name: "SwarmRuntimeSpec"},
-	typeToKey(reflect.TypeOf(swarm.ConfigSpec{})):      {Name: "SwarmConfigSpec"},
-	typeToKey(reflect.TypeOf(swarm.SecretSpec{})):      {Name: "SwarmSecretSpec"},
-	typeToKey(reflect.TypeOf(swarm.Driver{})):          {Name: "SwarmDriver"},
-	typeToKey(reflect.TypeOf(swarm.InitRequest{})):     {Name: "SwarmInitParameters"},
-	typeToKey(reflect.TypeOf(swarm.IPAMConfig{})):      {Name: "SwarmIPAMConfig"},
-	typeToKey(reflect.TypeOf(swarm.JoinRequest{})):     {Name: "SwarmJoinParameters"},
-	typeToKey(reflect.TypeOf(swarm.Limit{})):           {Name: "SwarmLimit"},
-	typeToKey(reflect.TypeOf(swarm.Network{})):         {Name: "SwarmNetwork"},
-	typeToKey(reflect.TypeOf(swarm.Node{})):            {Name: "NodeListResponse"},
-	typeToKey(reflect.TypeOf(swarm.NodeSpec{})):        {Name: "NodeUpdateParameters"},
-	typeToKey(reflect.TypeOf(swarm.Platform{})):        {Name: "SwarmPlatform"},
-	typeToKey(reflect.TypeOf(swarm.Resources{})):       {Name: "SwarmResources"},
-	typeToKey(reflect.TypeOf(swarm.RestartPolicy{})):   {Name: "SwarmRestartPolicy"},
-	typeToKey(reflect.TypeOf(swarm.Service{})):         {Name: "SwarmService"},
-	typeToKey(reflect.TypeOf(swarm.Swarm{})):           {Name: "SwarmInspectResponse"},
-	typeToKey(reflect.TypeOf(swarm.Task{})): {
-		Name: "TaskResponse",
-		Properties: []CSProperty{
-			{Name: "DesiredState", Type: CSType{"", "TaskState"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(swarm.TaskStatus{})): {
-		Properties: []CSProperty{
-			{Name: "State", Type: CSType{"", "TaskState"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(swarm.UpdateConfig{})):    {Name: "SwarmUpdateConfig"},
-	typeToKey(reflect.TypeOf(swarm.ConfigReference{})): {Name: "SwarmConfigReference"},
-	typeToKey(reflect.TypeOf(container.Summary{})): {
-		Name: "ContainerListResponse",
-		Properties: []CSProperty{
-			{Name: "Created", Type: CSType{"System", "DateTime"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(container.FilesystemChange{})): {
-		Name: "ContainerFileSystemChangeResponse",
-		Properties: []CSProperty{
-			{Name: "Kind", Type: CSType{"", "FileSystemChangeKind"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(container.ExecInspectResponse{})): {
-		Name: "ContainerExecInspectResponse",
-		Properties: []CSProperty{
-			{Name: "DetachKeys", Type: CSType{"", "string"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(container.InspectResponse{})): {
-		Name: "ContainerInspectResponse",
-		Properties: []CSProperty{
-			{Name: "Created", Type: CSType{"System", "DateTime"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(container.PathStat{})):    {Name: "ContainerPathStatResponse"},
-	typeToKey(reflect.TypeOf(container.TopResponse{})): {Name: "ContainerProcessesResponse"},
-	typeToKey(reflect.TypeOf(container.PruneReport{})): {Name: "ContainersPruneResponse"},
-	typeToKey(reflect.TypeOf(image.DeleteResponse{})):  {Name: "ImageDeleteResponse"},
-	typeToKey(reflect.TypeOf(image.HistoryResponseItem{})): {
-		Name: "ImageHistoryResponse",
-		Properties: []CSProperty{
-			{Name: "Created", Type: CSType{"System", "DateTime"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(image.InspectResponse{})): {
-		Name: "ImageInspectResponse",
-		Properties: []CSProperty{
-			{Name: "Created", Type: CSType{"System", "DateTime"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(ImageLoadResult{})):   {Name: "ImagesLoadResponse"},
-	typeToKey(reflect.TypeOf(image.PruneReport{})): {Name: "ImagesPruneResponse"},
-	typeToKey(reflect.TypeOf(image.Summary{})): {
-		Name: "ImagesListResponse",
-		Properties: []CSProperty{
-			{Name: "Created", Type: CSType{"System", "DateTime"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(system.Info{})):                     {Name: "SystemInfoResponse"},
-	typeToKey(reflect.TypeOf(client.NetworkConnectOptions{})):    {Name: "NetworkConnectParameters"},
-	typeToKey(reflect.TypeOf(client.NetworkDisconnectOptions{})): {Name: "NetworkDisconnectParameters"},
-	typeToKey(reflect.TypeOf(network.CreateRequest{})):           {Name: "NetworksCreateParameters"},
-	typeToKey(reflect.TypeOf(network.CreateResponse{})):          {Name: "NetworksCreateResponse"},
-	typeToKey(reflect.TypeOf(network.Inspect{})):                 {Name: "NetworkResponse"},
-	typeToKey(reflect.TypeOf(network.PruneReport{})):             {Name: "NetworksPruneResponse"},
-	typeToKey(reflect.TypeOf(network.Task{})):                    {Name: "NetworkTask"},
-	typeToKey(reflect.TypeOf(plugin.Args{})):                     {Name: "PluginArgs"},
-	typeToKey(reflect.TypeOf(plugin.CapabilityID{})):             {Name: "PluginCapabilityID"},
-	typeToKey(reflect.TypeOf(plugin.Config{})):                   {Name: "PluginConfig"},
-	typeToKey(reflect.TypeOf(plugin.Device{})):                   {Name: "PluginDevice"},
-	typeToKey(reflect.TypeOf(plugin.Env{})):                      {Name: "PluginEnv"},
-	typeToKey(reflect.TypeOf(plugin.LinuxConfig{})):              {Name: "PluginLinuxConfig"},
-	typeToKey(reflect.TypeOf(plugin.Mount{})):                    {Name: "PluginMount"},
-	typeToKey(reflect.TypeOf(plugin.NetworkConfig{})):            {Name: "PluginNetworkConfig"},
-	typeToKey(reflect.TypeOf(plugin.Privilege{})):                {Name: "PluginPrivilege"},
-	typeToKey(reflect.TypeOf(plugin.RootFS{})):                   {Name: "PluginRootFS"},
-	typeToKey(reflect.TypeOf(plugin.Settings{})):                 {Name: "PluginSettings"},
-	typeToKey(reflect.TypeOf(plugin.User{})):                     {Name: "PluginUser"},
-	typeToKey(reflect.TypeOf(plugin.Interface{})): {
-		Name: "PluginInterface",
-		Properties: []CSProperty{
-			{Name: "Types", Type: CSType{"System.Collections.Generic", "IList<string>"}},
-		},
-	},
-	typeToKey(reflect.TypeOf(build.DiskUsage{})):         {Name: "BuildDiskUsage"},
-	typeToKey(reflect.TypeOf(container.DiskUsage{})):     {Name: "ContainerDiskUsage"},
-	typeToKey(reflect.TypeOf(container.StatsResponse{})): {Name: "ContainerStatsResponse"},
-	typeToKey(reflect.TypeOf(image.DiskUsage{})):         {Name: "ImageDiskUsage"},
-	typeToKey(reflect.TypeOf(system.DiskUsage{})):        {Name: "SystemDataUsageInfoResponse"},
-	typeToKey(reflect.TypeOf(system.VersionResponse{})):  {Name: "VersionResponse"},
-	typeToKey(reflect.TypeOf(volume.DiskUsage{})):        {Name: "VolumeDiskUsage"},
-	typeToKey(reflect.TypeOf(volume.PruneReport{})):      {Name: "VolumesPruneResponse"},
-	typeToKey(reflect.TypeOf(VolumeResponse{})):          {Name: "VolumeResponse"},
-}
-
-var dockerTypesToReflect = []reflect.Type{
-
-	// POST /auth
-	reflect.TypeOf(registry.AuthConfig{}),
-	reflect.TypeOf(registry.AuthResponse{}),
-
-	// POST /build
-	reflect.TypeOf(ImageBuildParameters{}),
-	reflect.TypeOf(client.ImageBuildResult{}),
-
-	// POST /commit
-	reflect.TypeOf(CommitContainerChangesParameters{}),
-	reflect.TypeOf(CommitContainerChangesResponse{}),
-
-	// POST /containers/create
-	reflect.TypeOf(CreateContainerParameters{}),
-	reflect.TypeOf(container.CreateResponse{}),
-	reflect.TypeOf(network.Port{}),
-
-	// GET /containers/json
-	reflect.TypeOf(ContainersListParameters{}),
-	reflect.TypeOf(container.Summary{}),
-
-	// POST /containers/prune
-	reflect.TypeOf(ContainersPruneParameters{}),
-	reflect.TypeOf(container.PruneReport{}),
-
-	// DELETE /containers/(id)
-	reflect.TypeOf(ContainerRemoveParameters{}),
-
-	// GET /containers/(id)/archive
-	reflect.TypeOf(ContainerPathStatParameters{}),
-	reflect.TypeOf(container.PathStat{}),
-
-	// PUT /containers/(id)/archive
-	reflect.TypeOf(CopyToContainerParameters{}),
-
-	// POST /containers/(id)/attach
-	reflect.TypeOf(ContainerAttachParameters{}),
-
-	// POST /containers/(id)/attach/ws
-
-	// GET /containers/(id)/changes
-	reflect.TypeOf(container.FilesystemChange{}),
-
-	// POST /containers/(id)/exec
-	reflect.TypeOf(ContainerExecCreateParameters{}),
-	reflect.TypeOf(ContainerExecCreateResponse{}),
-
-	// GET /containers/(id)/json
-	reflect.TypeOf(ContainerInspectParameters{}),
-	reflect.TypeOf(container.InspectResponse{}),
-
-	// POST /containers/(id)/kill
-	reflect.TypeOf(ContainerKillParameters{}),
-
-	// GET /containers/(id)/logs
-	reflect.TypeOf(ContainerLogsParameters{}),
-
-	// POST /containers/(id)/pause
-
-	// POST /containers/(id)/rename
-	reflect.TypeOf(ContainerRenameParameters{}),
-
-	// POST /containers/(id)/resize
-	// POST /exec/(id)/resize
-	reflect.TypeOf(ContainerResizeParameters{}),
-
-	// POST /containers/(id)/restart
-	reflect.TypeOf(ContainerRestartParameters{}),
-
-	// POST /containers/(id)/start
-	reflect.TypeOf(ContainerStartParameters{}),
-
-	// POST /containers/(id)/stop
-	reflect.TypeOf(ContainerStopParameters{}),
-
-	// GET /containers/(id)/stats
-	reflect.TypeOf(ContainerStatsParameters{}),
-	reflect.TypeOf(container.StatsResponse{}),
-
-	// GET /containers/(id)/top
-	reflect.TypeOf(ContainerListProcessesParameters{}),
-	reflect.TypeOf(container.TopResponse{}),
-
-	// POST /containers/(id)/unpause
-
-	// POST /containers/(id)/update
-	reflect.TypeOf(ContainerUpdateParameters{}),
-	reflect.TypeOf(ContainerUpdateResponse{}),
-
-	// POST /containers/(id)/wait
-	reflect.TypeOf(ContainerWaitResponse{}),
-
-	// POST /exec/(id)/start
-	reflect.TypeOf(ContainerExecStartParameters{}),
-
-	// GET /exec/(id)/json
-	reflect.TypeOf(container.ExecInspectResponse{}),
-
-	// GET /events
-	reflect.TypeOf(ContainerEventsParameters{}),
-	reflect.TypeOf(events.Actor{}),
-	reflect.TypeOf(events.Message{}),
-
-	// GET /distribution/{name}/json
-	reflect.TypeOf(registry.DistributionInspect{}),
-
-	// POST /images/create
-	reflect.TypeOf(ImagesCreateParameters{}),
-	reflect.TypeOf(jsonstream.Message{}),
-
-	// GET /images/get
-	// TODO: stream
-
-	// GET /images/json
-	reflect.TypeOf(ImagesListParameters{}),
-	reflect.TypeOf(image.Summary{}),
-
-	// POST /images/load
-	// TODO: headers: application/x-tar body.
-	reflect.TypeOf(ImageLoadParameters{}),
-	reflect.TypeOf(ImageLoadResult{}),
-
-	// POST /images/prune
-	reflect.TypeOf(ImagesPruneParameters{}),
-	reflect.TypeOf(image.PruneReport{}),
-
-	// GET /images/search
-	reflect.TypeOf(ImagesSearchParameters{}),
-	reflect.TypeOf(registry.SearchResult{}),
-
-	// DELETE /images/(id)
-	reflect.TypeOf(ImageDeleteParameters{}),
-	reflect.TypeOf(image.DeleteResponse{}),
-
-	// GET /images/(id)/history
-	reflect.TypeOf(image.HistoryResponseItem{}),
-
-	// GET /images/(id)/json
-	reflect.TypeOf(image.InspectResponse{}),
-
-	// POST /images/(id)/push
-	reflect.TypeOf(ImagePushParameters{}),
-
-	// POST /images/(id)/tag
-	reflect.TypeOf(ImageTagParameters{}),
-
-	// GET /info
-	reflect.TypeOf(system.Info{}),
-	reflect.TypeOf(registry.ServiceConfig{}),
-
-	// GET /system/df
-	reflect.TypeOf(SytemDataUsageInfoParameters{}),
-	reflect.TypeOf(system.DiskUsage{}),
-
-	// GET /networks
-	reflect.TypeOf(NetworksListParameters{}),
-	reflect.TypeOf(network.Inspect{}),
-
-	// POST /networks/create
-	reflect.TypeOf(network.CreateRequest{}),
-	reflect.TypeOf(network.CreateResponse{}),
-
-	// POST /networks/prune
-	reflect.TypeOf(NetworksDeleteUnusedParameters{}),
-	reflect.TypeOf(network.PruneReport{}),
-
-	// GET /networks/(id)
-	// []NetworkResponse reflected above in GET /networks
-
-	// DELETE /networks/(id)
-
-	// POST /networks/(id)/connect
-	reflect.TypeOf(client.NetworkConnectOptions{}),
-
-	// POST /networks/(id)/disconnect
-	reflect.TypeOf(client.NetworkDisconnectOptions{}),
-
-	// GET /plugins
-	// []Plugin
-	reflect.TypeOf(PluginListParameters{}),
-	reflect.TypeOf(plugin.Plugin{}),
-
-	// GET /plugins/privileges
-	// []Privilege
-	reflect.TypeOf(PluginGetPrivilegeParameters{}),
-	reflect.TypeOf(plugin.Privilege{}),
-
-	// POST /plugins/pull
-	// []PluginConfigArgs
-	reflect.TypeOf(PluginInstallParameters{}),
-
-	// GET /plugins/{name}/json
-	// Plugin
-
-	// DELETE /plugins/{name}
-	reflect.TypeOf(PluginRemoveParameters{}),
-
-	// POST /plugins/{name}/enable
-	reflect.TypeOf(PluginEnableParameters{}),
-
-	// POST /plugins/{name}/disable
-	reflect.TypeOf(PluginDisableParameters{}),
-
-	// POST /plugins/{name}/upgrade
-	// []PluginConfigArgs
-	reflect.TypeOf(PluginUpgradeParameters{}),
-
-	// POST /plugins/create
-	reflect.TypeOf(PluginCreateParameters{}),
-
-	// POST /plugins/{name}/push
-
-	// POST /plugins/{name}/set
-	reflect.TypeOf(PluginConfigureParameters{}),
-
-	// GET /version
-	reflect.TypeOf(system.VersionResponse{}),
-
-	// GET /volumes
-	reflect.TypeOf(VolumesListParameters{}),
-	reflect.TypeOf(VolumesListResponse{}),
-
-	// POST /volumes/create
-	reflect.TypeOf(VolumesCreateParameters{}),
-
-	// POST /volumes/prune
-	reflect.TypeOf(VolumesPruneParameters{}),
-	reflect.TypeOf(volume.PruneReport{}),
-
-	// GET /volumes/(id)
-	reflect.TypeOf(VolumeResponse{}),
-
-	// DELETE /volumes/(id)
-
-	//
-	// Swarm API
-	//
-
-	// POST /swarm/init
-	reflect.TypeOf(swarm.InitRequest{}),
-
-	// POST /swarm/join
-	reflect.TypeOf(swarm.JoinRequest{}),
-
-	// POST /swarm/leave
-	reflect.TypeOf(SwarmLeaveParameters{}),
-
-	// GET /swarm
-	reflect.TypeOf(swarm.Swarm{}),
-
-	// GET /swarm/unlockkey
-	reflect.TypeOf(SwarmUnlockResponse{}),
-
-	// POST /swarm/update
-	reflect.TypeOf(SwarmUpdateParameters{}),
-
-	// POST /swarm/unlock
-	reflect.TypeOf(SwarmUnlockParameters{}),
-
-	//
-	// Secrets API (swarm)
-	//
-
-	// GET /secrets
-	// GET /secrets/(id)
-	reflect.TypeOf(swarm.Secret{}),
-
-	// POST /secrets/create
-	reflect.TypeOf(SecretCreateResponse{}),
-
-	//
-	// Configs API (swarm)
-	//
-
-	// GET /configs
-	// GET /configs/(id)
-	reflect.TypeOf(SwarmConfig{}),
-	reflect.TypeOf(swarm.ConfigReference{}),
-
-	// POST /configs/create
-	reflect.TypeOf(SwarmCreateConfigParameters{}),
-	reflect.TypeOf(SwarmCreateConfigResponse{}),
-
-	// POST /configs/(id)/update
-	reflect.TypeOf(SwarmUpdateConfigParameters{}),
-	reflect.TypeOf(swarm.ConfigSpec{}),
-
-	// GET /services
-	// GET /services/(id)
-	reflect.TypeOf(swarm.Service{}),
-	reflect.TypeOf(ServiceListParameters{}),
-
-	// POST /services/create
-	reflect.TypeOf(ServiceCreateParameters{}),
-	reflect.TypeOf(swarm.ServiceCreateResponse{}),
-
-	// POST /services/(id)/update
-	reflect.TypeOf(ServiceUpdateParameters{}),
-	reflect.TypeOf(swarm.ServiceUpdateResponse{}),
-
-	// DELETE /services/(id)
-
-	// GET /services/(id)/logs
-	reflect.TypeOf(ServiceLogsParameters{}),
-
-	// GET /tasks
-	reflect.TypeOf(TasksListParameters{}),
-	reflect.TypeOf(swarm.Task{}),
-
-	// GET /nodes
-	// GET /nodes/(id)
-	reflect.TypeOf(swarm.Node{}),
-	reflect.TypeOf(swarm.TLSInfo{}),
-
-	// DELETE /nodes/(id)
-	reflect.TypeOf(NodeRemoveParameters{}),
-
-	// POST /nodes/(id)/update
-	reflect.TypeOf(swarm.NodeSpec{}),
-}
-
-func csType(t reflect.Type, _ bool) CSType {
+func csType(t reflect.Type) CSType {
 	def, ok := CSCustomTypeMap[t]
 	if !ok {
+		if e := reflectEnum(t); e != nil {
+			return CSType{"", e.Name}
+		}
+
 		def, ok = CSInboxTypesMap[t.Kind()]
 	}
 
@@ -576,16 +51,22 @@ func csType(t reflect.Type, _ bool) CSType {
 
 	switch t.Kind() {
 	case reflect.Array:
-		return CSType{"", fmt.Sprintf("%s[]", csType(t.Elem(), false).Name)}
+		return CSType{"", fmt.Sprintf("%s[]", csType(t.Elem()).Name)}
 	case reflect.Slice:
-		return CSType{"System.Collections.Generic", fmt.Sprintf("IList<%s>", csType(t.Elem(), false).Name)}
+		return CSType{"System.Collections.Generic", fmt.Sprintf("IList<%s>", csType(t.Elem()).Name)}
 	case reflect.Map:
+		// Dictionary keys are written as JSON property names, which the enum
+		// converters do not support, so keys always use the underlying type.
+		keyType, ok := CSInboxTypesMap[t.Key().Kind()]
+		if !ok {
+			keyType = csType(t.Key())
+		}
 		if t.Elem() == EmptyStruct {
-			return CSType{"System.Collections.Generic", fmt.Sprintf("IDictionary<%s, EmptyStruct>", csType(t.Key(), false).Name)}
+			return CSType{"System.Collections.Generic", fmt.Sprintf("IDictionary<%s, EmptyStruct>", keyType.Name)}
 		}
-		return CSType{"System.Collections.Generic", fmt.Sprintf("IDictionary<%s, %s>", csType(t.Key(), false).Name, csType(t.Elem(), false).Name)}
+		return CSType{"System.Collections.Generic", fmt.Sprintf("IDictionary<%s, %s>", keyType.Name, csType(t.Elem()).Name)}
 	case reflect.Ptr:
-		return csType(t.Elem(), true)
+		return csType(t.Elem())
 	case reflect.Struct:
 		if m, ok := reflectedTypes[typeToKey(t)]; ok {
 			// We have aliased this type. Return it as a reference.
@@ -595,10 +76,38 @@ func csType(t reflect.Type, _ bool) CSType {
 	case reflect.Interface:
 		return CSType{"", "object"}
 	default:
-		panic(fmt.Errorf("cannot convert type %s", t))
+		reportError("cannot convert type %s", t)
+		return CSType{"", "object"}
 	}
 }
 
+// isNullable reports whether a JSON property of Go type t can be null or
+// missing on the wire without meaning the zero value of its C# type. Nil
+// pointers, slices, maps and interfaces are encoded as null, or omitted with
+// omitempty. Everything else is always sent, or omitted only if it is Go's zero
+// value, which the C# default of the property already represents. omitzero
+// also omits structs, whose C# models default to null.
+func isNullable(t reflect.Type, omitZero bool) bool {
+	// Types such as net.IP are encoded as strings, only raw bytes can be null.
+	if _, ok := CSCustomTypeMap[t]; ok {
+		return t == reflect.TypeOf([]byte(nil))
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return true
+	case reflect.Struct:
+		return omitZero
+	}
+
+	// A string enum defaults to its first member, which is not always "".
+	if e := reflectEnum(t); e != nil && e.IsString {
+		return len(e.Members) == 0 || e.Members[0].Value != ""
+	}
+
+	return false
+}
+
 func ultimateType(t reflect.Type) reflect.Type {
 	for {
 		switch t.Kind() {
@@ -662,29 +171,41 @@ func extractGoCommentsRecursive(rootDir string, baseImportPath string) error {
 	}
 
 	for _, fileInfo := range files {
-		file := fileInfo.file
-		importPath := fileInfo.importPath
-
-		for _, decl := range file.Decls {
-			switch d := decl.(type) {
-			case *ast.GenDecl:
-				if d.Tok == token.TYPE {
-					for _, spec := range d.Specs {
-						if typeSpec, ok := spec.(*ast.TypeSpec); ok {
-							typeName := typeSpec.Name.Name
-
-							if comment := commentText(typeSpec.Doc, d.Doc); comment != "" {
-								typeKey := fmt.Sprintf("%s.%s", importPath, typeName)
-								typeComments[typeKey] = comment
-							}
+		extractGoComments(fileInfo.importPath, fileInfo.file)
+	}
+
+	return nil
+}
+
+// extractGoComments records the type and field comments and the enum constants
+// declared in file, which belongs to the package importPath.
+func extractGoComments(importPath string, file *ast.File) {
+	goPackageNames[importPath] = file.Name.Name
 
-							if structType, ok := typeSpec.Type.(*ast.StructType); ok && structType.Fields != nil {
-								for _, field := range structType.Fields.List {
-									for _, fieldName := range field.Names {
-										if fieldComment := commentText(field.Doc, field.Comment); fieldComment != "" {
-											fieldKey := fmt.Sprintf("%s.%s.%s", importPath, typeName, fieldName.Name)
-											fieldComments[fieldKey] = fieldComment
-										}
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok == token.CONST {
+				collectEnumConsts(importPath, d)
+			}
+
+			if d.Tok == token.TYPE {
+				for _, spec := range d.Specs {
+					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+						typeName := typeSpec.Name.Name
+						typeKey := fmt.Sprintf("%s.%s", importPath, typeName)
+						goTypeFields[typeKey] = astStructFields(typeSpec.Type)
+
+						if comment := qualifyDocLinks(importPath, commentText(typeSpec.Doc, d.Doc)); comment != "" {
+							typeComments[typeKey] = comment
+						}
+
+						if structType, ok := typeSpec.Type.(*ast.StructType); ok && structType.Fields != nil {
+							for _, field := range structType.Fields.List {
+								for _, fieldName := range field.Names {
+									if fieldComment := qualifyDocLinks(importPath, commentText(field.Doc, field.Comment)); fieldComment != "" {
+										fieldKey := fmt.Sprintf("%s.%s.%s", importPath, typeName, fieldName.Name)
+										fieldComments[fieldKey] = fieldComment
 									}
 								}
 							}
@@ -694,8 +215,38 @@ func extractGoCommentsRecursive(rootDir string, baseImportPath string) error {
 			}
 		}
 	}
+}
+
+// astStructFields returns the names of the fields of a struct type expression,
+// with embedded fields named after their type.
+func astStructFields(expr ast.Expr) []string {
+	structType, ok := expr.(*ast.StructType)
+	if !ok || structType.Fields == nil {
+		return nil
+	}
 
-	return nil
+	var fields []string
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			fields = append(fields, name.Name)
+		}
+
+		if len(field.Names) == 0 {
+			typ := field.Type
+			if star, ok := typ.(*ast.StarExpr); ok {
+				typ = star.X
+			}
+
+			switch typ := typ.(type) {
+			case *ast.Ident:
+				fields = append(fields, typ.Name)
+			case *ast.SelectorExpr:
+				fields = append(fields, typ.Sel.Name)
+			}
+		}
+	}
+
+	return fields
 }
 
 // commentText returns the first non-empty comment from the provided groups.
@@ -713,6 +264,28 @@ func commentText(groups ...*ast.CommentGroup) string {
 	return ""
 }
 
+// deprecationPrefix starts the paragraph of a Go doc comment that deprecates
+// the documented type or field.
+const deprecationPrefix = "Deprecated: "
+
+// splitDeprecation splits the deprecation paragraph off a doc comment and
+// returns the rest of the comment and the deprecation message, which is empty
+// if the comment does not deprecate anything.
+func splitDeprecation(comment string) (string, string) {
+	var paragraphs []string
+	var deprecated string
+	for _, p := range strings.Split(comment, "\n\n") {
+		if deprecated == "" && strings.HasPrefix(p, deprecationPrefix) {
+			deprecated = strings.Join(strings.Fields(strings.TrimPrefix(p, deprecationPrefix)), " ")
+			continue
+		}
+
+		paragraphs = append(paragraphs, p)
+	}
+
+	return strings.Join(paragraphs, "\n\n"), deprecated
+}
+
 // getTypeComment retrieves the documentation comment for a type
 func getTypeComment(t reflect.Type) string {
 	// Look up using the full package path
@@ -756,10 +329,10 @@ func reflectTypeMembers(t reflect.Type, m *CSModelType) {
 			reflectedTypes[typeToKey(f.Type)] = inlineModel
 
 			csProp := CSProperty{
-				Name:    f.Name,
-				Type:    CSType{"", inlineStructName},
-				Comment: getFieldComment(t, f.Name),
+				Name: f.Name,
+				Type: CSType{"", inlineStructName},
 			}
+			csProp.Comment, csProp.Deprecated = splitDeprecation(getFieldComment(t, f.Name))
 
 			jsonTag := strings.Split(f.Tag.Get("json"), ",")
 			jsonName := f.Name
@@ -789,7 +362,8 @@ func reflectTypeMembers(t reflect.Type, m *CSModelType) {
 			reflectType(ut)
 			newType := reflectedTypes[typeToKey(ut)]
 			if newType == nil {
-				panic(fmt.Sprintf("Failed to reflect ultimate type (%s) for anonymous member (%s) on type (%s)", ut, f.Name, t))
+				reportError("failed to reflect ultimate type (%s) for anonymous member (%s) on type (%s)", ut, f.Name, t)
+				continue
 			}
 
 			m.Constructors[1].Parameters = append(m.Constructors[1].Parameters, CSParameter{newType, f.Name})
@@ -810,22 +384,23 @@ func reflectTypeMembers(t reflect.Type, m *CSModelType) {
 				}
 			}
 
-			var typeCustomizations *CSModelType
-			var hasTypeCustomizations bool
-			typeCustomizations, hasTypeCustomizations = typesToDisambiguate[typeToKey(t)]
-
-			// If the json tag says to omit we skip generation.
+			// If the json tag says to omit or the config excludes the field we skip generation.
 			jsonTag := strings.Split(f.Tag.Get("json"), ",")
 			if jsonTag[0] == "-" {
 				continue
 			}
 
+			pc := propertyConfig(t, f.Name)
+			if pc != nil && pc.Exclude {
+				continue
+			}
+
 			// Create our new property.
 			csProp := CSProperty{
-				Name:    f.Name,
-				Type:    csType(f.Type, false),
-				Comment: getFieldComment(t, f.Name),
+				Name: f.Name,
+				Type: csType(f.Type),
 			}
+			csProp.Comment, csProp.Deprecated = splitDeprecation(getFieldComment(t, f.Name))
 
 			jsonName := f.Name
 			if jsonTag[0] != "" {
@@ -833,18 +408,49 @@ func reflectTypeMembers(t reflect.Type, m *CSModelType) {
 			}
 
 			omitEmpty := slices.Contains(jsonTag, "omitempty")
+			omitZero := slices.Contains(jsonTag, "omitzero")
 
-			if hasTypeCustomizations {
-				for _, p := range typeCustomizations.Properties {
-					if f.Name == p.Name {
-						// We have a custom property modification. Change the property.
-						csProp.Type = p.Type
-						break
-					}
-				}
+			if pc != nil && pc.Type != "" {
+				// We have a custom property modification. Change the property.
+				csProp.Type = parseCSType(pc.Type)
+			}
+
+			restTag, restTagErr := RestTagFromString(f.Tag.Get("rest"))
+			if restTagErr != nil && f.Tag.Get("rest") != "" {
+				reportError("invalid rest tag on member (%s) of type (%s): %w", f.Name, t, restTagErr)
+				continue
 			}
 
-			if restTag, err := RestTagFromString(f.Tag.Get("rest")); err == nil && restTag.In != body {
+			if restTagErr == nil && restTag.In == header {
+				if restTag.Name == "" {
+					restTag.Name = f.Name
+				}
+
+				// Structured header values such as X-Registry-Auth are sent as
+				// base64url encoded JSON, everything else as its string value.
+				headerParameter := "HeaderParameter"
+				var leadingArgs []CSArgument
+
+				switch ultimateType(f.Type).Kind() {
+				case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+					headerParameter = "HeaderBase64JsonParameter"
+					leadingArgs = append(leadingArgs, CSArgument{Value: "typeof(" + csProp.Type.Name + ")"})
+				}
+
+				a := CSAttribute{Type: CSType{"", headerParameter}}
+				a.Arguments = append(a.Arguments, leadingArgs...)
+				a.Arguments = append(
+					a.Arguments,
+					CSArgument{
+						restTag.Name,
+						CSInboxTypesMap[reflect.String]},
+					CSArgument{strconv.FormatBool(restTag.Required),
+						CSInboxTypesMap[reflect.Bool]})
+
+				// Header parameters are never part of the request body.
+				csProp.IsNullable = omitEmpty || !restTag.Required
+				csProp.Attributes = append(csProp.Attributes, a, CSAttribute{Type: CSType{"System.Text.Json.Serialization", "JsonIgnore"}})
+			} else if restTagErr == nil && restTag.In == query {
 				if restTag.Name == "" {
 					restTag.Name = strings.ToLower(f.Name)
 				}
@@ -872,25 +478,61 @@ func reflectTypeMembers(t reflect.Type, m *CSModelType) {
 					CSArgument{strconv.FormatBool(restTag.Required),
 						CSInboxTypesMap[reflect.Bool]})
 
-				csProp.IsOpt = omitEmpty || !restTag.Required
+				// Optional query parameters are only sent if they are set.
+				csProp.IsNullable = omitEmpty || !restTag.Required
 				csProp.Attributes = append(csProp.Attributes, a)
 				csProp.DefaultValue = restTag.Default
 			} else {
 				a := CSAttribute{Type: CSType{"System.Text.Json.Serialization", "JsonPropertyName"}}
 				a.Arguments = append(a.Arguments, CSArgument{jsonName, CSInboxTypesMap[reflect.String]})
-				csProp.IsOpt = omitEmpty || f.Type.Kind() == reflect.Ptr
+				csProp.IsNullable = isNullable(f.Type, omitZero)
 				csProp.Attributes = append(csProp.Attributes, a)
 
-				m.HasJsonSerializableProperties = true
-			}
+				// Times and durations get the converter of their wire format. An
+				// omitted unix timestamp or time string has no DateTime value, an
+				// omitted duration is a zero TimeSpan and encoding/json never omits
+				// a time.Time.
+				enc := fieldTimeEncoding(t, f, pc)
+				if enc != "" {
+					csProp.Type = timeEncodingTypes[enc].Type
+					converter := timeEncodingTypes[enc].Converter
+
+					switch {
+					case enc == timeEncodingRFC3339Nano && nullZeroTime(pc):
+						// Go's zero time, and an empty time string, are null.
+						csProp.IsNullable = true
+					case enc == timeEncodingRFC3339Nano:
+						// System.Text.Json reads and writes a DateTimeOffset itself.
+						converter = ""
+						csProp.IsNullable = csProp.IsNullable || omitEmpty && f.Type.Kind() != reflect.Struct
+					case enc == timeEncodingUnix:
+						csProp.IsNullable = csProp.IsNullable || omitEmpty
+					}
 
-			if hasTypeCustomizations {
-				for _, p := range typeCustomizations.Properties {
-					if f.Name == p.Name {
-						csProp.Attributes = append(csProp.Attributes, p.Attributes...)
-						break
+					if converter != "" {
+						csProp.Attributes = append(csProp.Attributes, jsonConverterAttribute(converter))
 					}
 				}
+
+				if pc != nil && pc.NullZeroTime != nil && enc != timeEncodingRFC3339Nano {
+					reportError("config property (%s) of type (%s) sets nullZeroTime, but is not an rfc3339nano time", f.Name, configKey(t))
+				}
+
+				m.HasJsonSerializableProperties = true
+			}
+
+			if pc != nil {
+				csProp.Attributes = append(csProp.Attributes, pc.attributes()...)
+			}
+
+			if u := reflectUnion(t, f); u != nil {
+				csProp.Type = CSType{"", u.Name}
+			} else if ultimateType(f.Type).Kind() == reflect.Interface && !rawJSONFields[typeToKey(t)+"."+f.Name] {
+				reportError("field (%s) of type (%s) is an interface, declare its types in unionTypes or list it in rawJSONFields", f.Name, t)
+			}
+
+			if pc != nil && pc.Nullable != nil {
+				csProp.IsNullable = *pc.Nullable
 			}
 
 			// Lastly assign the property to our type.
@@ -917,19 +559,15 @@ func reflectType(t reflect.Type) {
 	}
 
 	if t.Name() == "" {
-		panic("Unable to reflect a type with no name")
+		reportError("unable to reflect type (%s) with no name", t)
+		return
 	}
 
-	name := t.Name()
-	if n, ok := typesToDisambiguate[k]; ok {
-		if n.Name != "" {
-			name = n.Name
-		}
-	}
+	name := csTypeName(t, typeConfig(t))
 
 	if activeType == nil {
 		activeType = NewModel(name, t.String())
-		activeType.Comment = getTypeComment(t)
+		activeType.Comment, activeType.Deprecated = splitDeprecation(getTypeComment(t))
 	}
 
 	activeType.IsStarted = true
@@ -941,112 +579,520 @@ func reflectType(t reflect.Type) {
 	reflectTypeMembers(t, activeType)
 }
 
+// Exit codes of specgen.
+const (
+	exitOK = 0
+	// exitStale is returned by -check if the generated files on disk are out of date,
+	// and by -crosscheck if the reflected models do not match swagger.yaml other than known.
+	exitStale = 1
+	// exitUsage is returned for invalid flags or paths, the same as the flag package does.
+	exitUsage = 2
+	// exitGenerate is returned if the models could not be generated, see generationErrors.
+	exitGenerate = 3
+	// exitIO is returned if reading or writing files failed.
+	exitIO = 4
+)
+
+var (
+	outDir          = flag.String("out", "", "Directory to write the models to, e.g. ../../src/Docker.DotNet/Models. The operations are written to the sibling Endpoints directory.")
+	csprojFile      = flag.String("csproj", "", "Project file to read the global usings from (default: Docker.DotNet.csproj next to the -out directory).")
+	modelsNamespace = flag.String("namespace", "Docker.DotNet.Models", "C# namespace of the generated models.")
+	mobyModules     = flag.String("moby-modules", "github.com/moby/moby/api,github.com/moby/moby/client", "Comma separated Go modules to read the type comments and enum constants from.")
+	verbose         = flag.Bool("verbose", false, "Print progress information to stderr.")
+	dryRun          = flag.Bool("dry-run", false, "Print the files that would be written and deleted without changing the tree.")
+	swaggerInput    = flag.Bool("swagger", false, "Generate the models from moby's swagger.yaml instead of reflecting the Go types.")
+	crossCheckMode  = flag.Bool("crosscheck", false, "Report mismatches between the reflected models and swagger.yaml instead of generating code.")
+	crossCheckKnown = flag.String("crosscheck-known", "", "File with the mismatches -crosscheck accepts, one per line (default: crosscheck-known.txt of the specgen module).")
+	swaggerFile     = flag.String("swagger-file", "", "Path to swagger.yaml (default: the file of the resolved github.com/moby/moby/api module).")
+	apiVersionsDir  = flag.String("api-versions-dir", "", "Directory with the swagger documents of the released API versions, v1.25.yaml to v1.<latest>.yaml, used to derive the minimum API version of models and properties (default: the docs directory of the resolved github.com/moby/moby/api module).")
+	responseStyle   = flag.String("response-style", string(CSModelStyleClass), "How to declare the models that are only ever responses: class (mutable properties), init (init-only properties) or record.")
+	configFile      = flag.String("config", "", "File with the customizations of the generated types, see README.md (default: specgen.yaml of the specgen module).")
+	fixturesDir     = flag.String("fixtures", "", "Directory to write the JSON fixtures of the models and the test class that round-trips them to, e.g. ../../test/Docker.DotNet.Tests/Fixtures. No fixtures are written if empty.")
+	checkMode       = flag.Bool("check", false, "Compare the generated code with the files on disk, print a diff per file and exit with 1 if they differ, without writing any files.")
+)
+
+// generationErrors collects the problems found while reflecting and rendering
+// the models, so a run reports all of them instead of stopping at the first.
+var generationErrors []error
+
+func reportError(format string, a ...any) {
+	generationErrors = append(generationErrors, fmt.Errorf(format, a...))
+}
+
+func verbosef(format string, a ...any) {
+	if *verbose {
+		fmt.Fprintf(os.Stderr, format+"\n", a...)
+	}
+}
+
 func main() {
-	argsLen := len(os.Args)
-	sourcePath := ""
-	if argsLen >= 2 {
-		sourcePath = os.Args[1]
-		fmt.Println(sourcePath)
-		if _, err := os.Stat(sourcePath); err != nil {
-			if os.IsNotExist(err) {
-				panic(sourcePath + ", is not a valid directory.")
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		os.Exit(runDiff(os.Args[2:]))
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "conformance" {
+		os.Exit(runConformance(os.Args[2:]))
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "fake-daemon" {
+		os.Exit(runFakeDaemon(os.Args[2:]))
+	}
+
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: specgen -out <models directory> [flags]")
+		fmt.Fprintln(flag.CommandLine.Output(), "       specgen diff [flags] <old release tag> <new release tag>")
+		fmt.Fprintln(flag.CommandLine.Output(), "       specgen conformance -out <vectors directory> [flags]")
+		fmt.Fprintln(flag.CommandLine.Output(), "       specgen fake-daemon [flags]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	os.Exit(run())
+}
+
+func run() int {
+	if flag.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "specgen: unexpected argument %q, use -out to set the output directory\n", flag.Arg(0))
+		flag.Usage()
+		return exitUsage
+	}
+
+	switch CSModelStyle(*responseStyle) {
+	case CSModelStyleClass, CSModelStyleInit, CSModelStyleRecord:
+	default:
+		fmt.Fprintf(os.Stderr, "specgen: unknown -response-style %q, use class, init or record\n", *responseStyle)
+		return exitUsage
+	}
+
+	// The cross-check compares the reflected models with swagger.yaml, it has nothing to do with -swagger.
+	if *crossCheckMode && *swaggerInput {
+		fmt.Fprintln(os.Stderr, "specgen: -crosscheck and -swagger cannot be combined")
+		flag.Usage()
+		return exitUsage
+	}
+
+	// The cross-check only reads, every other mode renders into -out.
+	var dirs []string
+	if !*crossCheckMode {
+		if *outDir == "" {
+			fmt.Fprintln(os.Stderr, "specgen: -out is required")
+			flag.Usage()
+			return exitUsage
+		}
+
+		modelsPath := filepath.Clean(*outDir)
+		dirs = append(dirs, modelsPath)
+		if !*swaggerInput {
+			// The operations are generated next to the models, into src/Docker.DotNet/Endpoints.
+			dirs = append(dirs, filepath.Join(filepath.Dir(modelsPath), "Endpoints"))
+
+			if *fixturesDir != "" {
+				dirs = append(dirs, filepath.Clean(*fixturesDir))
 			}
 		}
-	} else {
-		sourcePath, _ = os.Getwd()
-	}
 
-	// Extract comments from moby/moby source files
-	mobyModules := []string{
-		"github.com/moby/moby/api",
-		"github.com/moby/moby/client",
+		for _, dir := range dirs {
+			if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+				fmt.Fprintf(os.Stderr, "specgen: %s is not a directory\n", dir)
+				return exitUsage
+			}
+		}
+
+		csproj := *csprojFile
+		if csproj == "" {
+			csproj = filepath.Join(filepath.Dir(modelsPath), "Docker.DotNet.csproj")
+		}
+
+		usings, err := readGlobalUsings(csproj)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitUsage
+		}
+
+		GlobalUsings = usings
+		verbosef("Read %d global usings from %s", len(usings), csproj)
 	}
 
-	for _, moduleName := range mobyModules {
-		modulePath, err := findGoModulePath(moduleName)
+	files := generatedFiles{}
+
+	if *swaggerInput {
+		spec, err := readSwagger()
 		if err != nil {
-			fmt.Printf("Warning: Failed to find module %s: %v\n", moduleName, err)
-			continue
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
+		}
+
+		renderModels(files, dirs[0], reflectSwagger(spec))
+	} else {
+		cfg, err := readConfig()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
 		}
-		if err := extractGoCommentsRecursive(modulePath, moduleName); err != nil {
-			fmt.Printf("Warning: Failed to extract comments from %s: %v\n", modulePath, err)
+
+		typeConfigs = cfg.Types
+
+		// Extract comments and enum constants from moby/moby source files
+		for _, moduleName := range strings.Split(*mobyModules, ",") {
+			moduleName = strings.TrimSpace(moduleName)
+			if moduleName == "" {
+				continue
+			}
+
+			modulePath, err := findGoModulePath(moduleName)
+			if err != nil {
+				reportError("find module %s: %w", moduleName, err)
+				continue
+			}
+
+			verbosef("Extracting comments from %s", modulePath)
+			if err := extractGoCommentsRecursive(modulePath, moduleName); err != nil {
+				reportError("extract comments from %s: %w", modulePath, err)
+			}
+		}
+
+		// The latest swagger.yaml tells the time fields that are strings or
+		// integers in Go apart, see inferTimeEncoding.
+		specs, err := readVersionedSwaggers()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
+		}
+
+		latest := specs[len(specs)-1].Spec
+		swaggerDefinitions = latest.Definitions
+
+		// Name the types whose names collide before any of them is reflected,
+		// the report lists the names that are not pinned in specgen.yaml yet.
+		writeNameResolutions(os.Stderr, resolveNames(routeModels()))
+
+		// Reflect the specific docker types we are about and their dependencies.
+		for _, t := range routeModels() {
+			reflectType(t)
+		}
+
+		// Report the customizations and route models that no longer have any
+		// effect, e.g. because a moby upgrade renamed or removed their type.
+		// The config may only customize the types moby and modeldefs.go declare.
+		declared := declaredStructFields(routeModels())
+		maps.Copy(declared, goTypeFields)
+		validateConfig(cfg, declared)
+		checkRouteModels(routes)
+		checkRouteOperations(routes)
+
+		verbosef("Reflected %d models and %d enums", len(reflectedTypes), len(reflectedEnums))
+
+		if *crossCheckMode {
+			spec, err := readSwagger()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+				return exitIO
+			}
+
+			known, err := readKnownMismatches()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+				return exitIO
+			}
+
+			mismatches := crossCheck(os.Stdout, reflectedTypes, reflectSwagger(spec), known)
+			fmt.Printf("%d mismatches between the reflected models and swagger.yaml that are not known.\n", mismatches)
+			if code := reportGenerationErrors(); code != exitOK {
+				return code
+			}
+
+			if mismatches > 0 {
+				return exitStale
+			}
+
+			return exitOK
+		}
+
+		annotateApiVersions(specs, routes, reflectedTypes, func(m *CSModelType) (string, bool) {
+			return matchSwaggerDefinition(m.SourceName, func(name string) bool {
+				_, ok := latest.Definitions.Schemas[name]
+				return ok
+			})
+		})
+
+		reflectFilters(routes, latest, filterValueTypes)
+		applyResponseStyle(routes, reflectedTypes, CSModelStyle(*responseStyle))
+
+		modelsPath, endpointsPath := dirs[0], dirs[1]
+
+		renderModels(files, modelsPath, reflectedTypes)
+
+		for _, k := range sortedKeys(reflectedEnums) {
+			v := reflectedEnums[k]
+			files.add(filepath.Join(modelsPath, v.Name+".Generated.cs"), generatedFile{TypeName: v.Name, Kind: "enum", Key: k, SourceName: v.SourceName}, v.Write)
+		}
+
+		renderUnions(files, modelsPath)
+		renderFilters(files, modelsPath)
+
+		for _, g := range operationGroups() {
+			var routes []string
+			for _, r := range g.Routes {
+				routes = append(routes, r.String())
+			}
+
+			files.add(filepath.Join(endpointsPath, g.InterfaceName()+".Generated.cs"), generatedFile{TypeName: g.InterfaceName(), Kind: "interface", Routes: routes}, g.WriteInterface)
+			files.add(filepath.Join(endpointsPath, g.ClassName()+".Generated.cs"), generatedFile{TypeName: g.ClassName(), Kind: "class", Routes: routes}, g.WriteClass)
 		}
+
+		streams := messageStreamRoutes(routes)
+		var streamRoutes []string
+		for _, r := range streams {
+			streamRoutes = append(streamRoutes, r.String())
+		}
+
+		files.add(filepath.Join(endpointsPath, messageStreamsClassName+".Generated.cs"), generatedFile{TypeName: messageStreamsClassName, Kind: "class", Routes: streamRoutes}, func(w io.Writer) {
+			writeMessageStreams(w, streams)
+		})
+	}
+
+	renderManifest(files, filepath.Join(dirs[0], manifestFileName))
+
+	// The fixtures are test data, they are not listed in the manifest.
+	if len(dirs) > 2 {
+		renderFixtures(files, dirs[2], modelFixtures(routeModels()))
+	}
+
+	// Nothing is written if any model could not be generated.
+	if code := reportGenerationErrors(); code != exitOK {
+		return code
+	}
+
+	if *checkMode {
+		return files.check(os.Stdout, dirs)
+	}
+
+	if *dryRun {
+		return files.printChanges(os.Stdout, dirs)
 	}
 
 	// Delete any previously generated files.
-	if files, err := os.ReadDir(sourcePath); err != nil {
-		panic(err)
-	} else {
-		for _, file := range files {
-			if strings.HasSuffix(file.Name(), ".Generated.cs") {
-				if err := os.Remove(path.Join(sourcePath, file.Name())); err != nil {
-					panic(err)
-				}
-			}
+	for _, dir := range dirs {
+		if err := deleteGeneratedFiles(dir); err != nil {
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
 		}
 	}
 
-	// Reflect the specific docker types we are about and their dependencies.
-	for _, t := range dockerTypesToReflect {
-		reflectType(t)
+	if err := files.write(); err != nil {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+		return exitIO
 	}
 
-	jsonSerializableNames := make([]string, 0, len(reflectedTypes))
+	verbosef("Wrote %d files", len(files))
+	return exitOK
+}
+
+// reportGenerationErrors prints the collected generation errors and returns the exit code for them.
+func reportGenerationErrors() int {
+	if len(generationErrors) == 0 {
+		return exitOK
+	}
 
-	for k, v := range reflectedTypes {
-		if _, e := os.Stat(path.Join(sourcePath, v.Name+".Generated.cs")); e == nil {
-			panic(fmt.Sprintf("File: (%s.Generated.cs) already exists. Failed to write key same name for key: (%s) type: (%s).", v.Name, k, v.SourceName))
+	for _, err := range generationErrors {
+		fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "specgen: %d errors, no files were written\n", len(generationErrors))
+	return exitGenerate
+}
+
+func readSwagger() (*swaggerSpec, error) {
+	name := *swaggerFile
+	if name == "" {
+		var err error
+		if name, err = defaultSwaggerPath(); err != nil {
+			return nil, err
 		}
+	}
 
-		f, err := os.CreateTemp(sourcePath, "ser")
-		if err != nil {
-			panic(err)
+	verbosef("Reading %s", name)
+	return loadSwagger(name)
+}
+
+func readVersionedSwaggers() ([]versionedSwagger, error) {
+	dir := *apiVersionsDir
+	if dir == "" {
+		var err error
+		if dir, err = defaultVersionedSwaggerDir(); err != nil {
+			return nil, err
 		}
+	}
+
+	verbosef("Reading the versioned swagger documents in %s", dir)
+	return loadVersionedSwaggers(dir)
+}
+
+// generatedFile is the content of a generated file and the C# type it declares.
+type generatedFile struct {
+	Content []byte
+	// TypeName and Kind are the name and kind (class, enum or interface) of the C# type.
+	TypeName string
+	Kind     string
+	// Key and SourceName identify the Go type the C# type is generated from, if any.
+	Key        string
+	SourceName string
+	// Routes lists the routes the type is generated for, see routeTypeKeys.
+	Routes []string
+}
+
+// origin returns the Go type the file is generated from, or its C# type if it has none.
+func (f *generatedFile) origin() string {
+	if f.SourceName != "" {
+		return f.SourceName
+	}
+
+	return f.TypeName
+}
+
+// generatedFiles holds the generated files by path, so they can be compared
+// with the files on disk before anything is written.
+type generatedFiles map[string]*generatedFile
+
+func (f generatedFiles) add(name string, file generatedFile, write func(w io.Writer)) {
+	if existing, ok := f[name]; ok {
+		reportError("file (%s) is generated for both (%s) and (%s)", filepath.Base(name), existing.origin(), file.origin())
+		return
+	}
 
-		defer f.Close()
+	var b bytes.Buffer
+	write(&b)
+	file.Content = b.Bytes()
+	f[name] = &file
+}
 
-		b := bufio.NewWriter(f)
-		v.Write(b)
-		err = b.Flush()
+func (f generatedFiles) names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+func (f generatedFiles) write() error {
+	for _, name := range f.names() {
+		if err := os.WriteFile(name, f[name].Content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printChanges writes the files a run would write and delete to w.
+func (f generatedFiles) printChanges(w io.Writer, dirs []string) int {
+	for _, dir := range dirs {
+		existing, err := generatedFileNames(dir)
 		if err != nil {
-			os.Remove(f.Name())
-			panic(err)
+			fmt.Fprintf(os.Stderr, "specgen: %v\n", err)
+			return exitIO
 		}
 
-		f.Close()
-		os.Rename(f.Name(), path.Join(sourcePath, v.Name+".Generated.cs"))
+		for _, name := range existing {
+			if _, ok := f[name]; !ok {
+				fmt.Fprintf(w, "delete %s\n", name)
+			}
+		}
+	}
 
-		if v.HasJsonSerializableProperties {
-			jsonSerializableNames = append(jsonSerializableNames, v.Name)
+	for _, name := range f.names() {
+		fmt.Fprintf(w, "write  %s\n", name)
+	}
+
+	return exitOK
+}
+
+// generatedFileNames returns the paths of the *.Generated.cs files and the
+// *.Generated.json fixtures in dir.
+func generatedFileNames(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && (strings.HasSuffix(e.Name(), ".Generated.cs") || strings.HasSuffix(e.Name(), ".Generated.json")) {
+			names = append(names, filepath.Join(dir, e.Name()))
 		}
 	}
 
-	slices.Sort(jsonSerializableNames)
+	return names, nil
+}
 
-	jscf, err := os.Create(path.Join(sourcePath, "DockerModelsJsonSerializerContext.Generated.cs"))
+func deleteGeneratedFiles(dir string) error {
+	names, err := generatedFileNames(dir)
 	if err != nil {
-		panic(err)
+		return err
+	}
+
+	for _, name := range names {
+		if err := os.Remove(name); err != nil {
+			return err
+		}
 	}
 
-	defer jscf.Close()
+	return nil
+}
+
+// renderModels adds a file per model and the JSON serializer context listing them.
+func renderModels(files generatedFiles, sourcePath string, models map[string]*CSModelType) {
+	jsonSerializableNames := make([]string, 0, len(models))
 
-	jscb := bufio.NewWriter(jscf)
+	for _, k := range sortedKeys(models) {
+		v := models[k]
+		files.add(filepath.Join(sourcePath, v.Name+".Generated.cs"), generatedFile{TypeName: v.Name, Kind: v.keyword(), Key: k, SourceName: v.SourceName}, v.Write)
 
-	fmt.Fprintln(jscb, "namespace Docker.DotNet.Models")
-	fmt.Fprintln(jscb, "{")
-	for _, name := range jsonSerializableNames {
-		fmt.Fprintf(jscb, "    [JsonSerializable(typeof(%s))]\n", name)
+		if v.HasJsonSerializableProperties {
+			jsonSerializableNames = append(jsonSerializableNames, v.Name)
+		}
 	}
-	fmt.Fprintln(jscb, "    internal sealed partial class DockerModelsJsonSerializerContext : JsonSerializerContext { }")
-	fmt.Fprintln(jscb, "}")
 
-	err = jscb.Flush()
-	if err != nil {
-		os.Remove(jscf.Name())
-		panic(err)
+	slices.Sort(jsonSerializableNames)
+
+	files.add(filepath.Join(sourcePath, "DockerModelsJsonSerializerContext.Generated.cs"), generatedFile{TypeName: "DockerModelsJsonSerializerContext", Kind: "class"}, func(w io.Writer) {
+		fmt.Fprintf(w, "namespace %s\n", *modelsNamespace)
+		fmt.Fprintln(w, "{")
+		for _, name := range jsonSerializableNames {
+			fmt.Fprintf(w, "    [JsonSerializable(typeof(%s))]\n", name)
+		}
+		fmt.Fprintln(w, "    internal sealed partial class DockerModelsJsonSerializerContext : JsonSerializerContext { }")
+		fmt.Fprintln(w, "}")
+	})
+}
+
+// renderUnions adds a file per union with its base class, Other member and converter.
+func renderUnions(files generatedFiles, sourcePath string) {
+	for _, k := range sortedKeys(reflectedUnions) {
+		u := reflectedUnions[k]
+		files.add(filepath.Join(sourcePath, u.Name+".Generated.cs"), generatedFile{TypeName: u.Name, Kind: "union", SourceName: u.SourceName}, u.Write)
+	}
+}
+
+// renderFilters adds a file per filters builder.
+func renderFilters(files generatedFiles, sourcePath string) {
+	for _, k := range sortedKeys(reflectedFilters) {
+		ft := reflectedFilters[k]
+		files.add(filepath.Join(sourcePath, ft.Name+".Generated.cs"), generatedFile{TypeName: ft.Name, Kind: "filters", Routes: ft.Routes}, ft.Write)
+	}
+}
+
+// sortedKeys returns the keys of m in order, so maps are always iterated the same way.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
 
-	jscf.Close()
+	slices.Sort(keys)
+	return keys
 }
 
 func findGoModulePath(moduleName string) (string, error) {
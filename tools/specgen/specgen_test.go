@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestRunUsage(t *testing.T) {
+	tests := []struct {
+		name string
+		set  func()
+	}{
+		{"crosscheck with swagger", func() { *crossCheckMode, *swaggerInput = true, true }},
+		{"missing out", func() {}},
+		{"unknown response style", func() { *outDir, *responseStyle = t.TempDir(), "struct" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetGeneratorState(t)
+
+			crossCheck, swagger, out, style := *crossCheckMode, *swaggerInput, *outDir, *responseStyle
+			output := flag.CommandLine.Output()
+			t.Cleanup(func() {
+				*crossCheckMode, *swaggerInput, *outDir, *responseStyle = crossCheck, swagger, out, style
+				flag.CommandLine.SetOutput(output)
+			})
+			flag.CommandLine.SetOutput(io.Discard)
+
+			tt.set()
+
+			if got := run(); got != exitUsage {
+				t.Errorf("run() = %d, want %d", got, exitUsage)
+			}
+		})
+	}
+}
+
+func TestReflectUndeclaredInterface(t *testing.T) {
+	resetGeneratorState(t)
+	extractGoldenComments(t)
+
+	reflectType(reflect.TypeOf(GoldenJSON{}))
+
+	want := "field (Any) of type (main.GoldenJSON) is an interface, declare its types in unionTypes or list it in rawJSONFields"
+	if len(generationErrors) != 1 || generationErrors[0].Error() != want {
+		t.Errorf("errors = %v, want [%s]", generationErrors, want)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// messageStreamsClassName is the C# class with the readers of the ProgressStream routes.
+const messageStreamsClassName = "MessageStreams"
+
+// messageStreamRoutes returns the ProgressStream routes, whose response bodies
+// are newline-delimited JSON messages of type Response.
+func messageStreamRoutes(routes []Route) []Route {
+	var streams []Route
+	readers := map[string]Route{}
+
+	for _, r := range routes {
+		if r.Stream != ProgressStream {
+			continue
+		}
+
+		if r.Response == nil || r.Reader == "" {
+			reportError("route (%s) streams messages but has no Response or Reader", r)
+			continue
+		}
+
+		if other, ok := readers[r.Reader]; ok {
+			reportError("route (%s) has the same Reader (%s) as route (%s)", r, r.Reader, other)
+			continue
+		}
+
+		readers[r.Reader] = r
+		streams = append(streams, r)
+	}
+
+	return streams
+}
+
+// writeMessageStreams writes the MessageStreams class, with an
+// IAsyncEnumerable<T> reader of the response body of each route.
+func writeMessageStreams(w io.Writer, routes []Route) {
+	fmt.Fprintln(w, "namespace Docker.DotNet;")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "/// <summary>")
+	fmt.Fprintln(w, "/// Reads the newline-delimited JSON messages of the streaming Docker Engine API routes from their response body.")
+	fmt.Fprintln(w, "/// </summary>")
+	fmt.Fprintf(w, "public static class %s\n", messageStreamsClassName)
+	fmt.Fprintln(w, "{")
+
+	for i, r := range routes {
+		if i > 0 {
+			fmt.Fprintln(w, "")
+		}
+
+		name := csModelName(r.Response)
+
+		fmt.Fprintln(w, "    /// <summary>")
+		fmt.Fprintf(w, "    /// Reads the <see cref=\"%s\"/> messages of <c>%s</c>.\n", name, escapeXMLComment(r.String()))
+		fmt.Fprintln(w, "    /// </summary>")
+		fmt.Fprintln(w, "    /// <param name=\"stream\">The response body.</param>")
+		fmt.Fprintf(w, "    /// <param name=\"cancellationToken\">%s</param>\n", cancellationTokenDoc)
+		fmt.Fprintln(w, "    /// <returns>The messages in the order the daemon sent them, until the stream ends.</returns>")
+		fmt.Fprintf(w, "    public static IAsyncEnumerable<%s> %s(Stream stream, CancellationToken cancellationToken = default)\n", name, r.Reader)
+		fmt.Fprintln(w, "    {")
+		fmt.Fprintln(w, "        if (stream == null)")
+		fmt.Fprintln(w, "        {")
+		fmt.Fprintln(w, "            throw new ArgumentNullException(nameof(stream));")
+		fmt.Fprintln(w, "        }")
+		fmt.Fprintln(w, "")
+		fmt.Fprintf(w, "        return DockerClient.JsonSerializer.DeserializeAsync<%s>(stream, cancellationToken);\n", name)
+		fmt.Fprintln(w, "    }")
+	}
+
+	fmt.Fprintln(w, "}")
+}
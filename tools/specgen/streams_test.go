@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestWriteMessageStreams(t *testing.T) {
+	resetGeneratorState(t)
+
+	reflectType(reflect.TypeOf(GoldenChild{}))
+	reflectType(reflect.TypeOf(GoldenBase{}))
+
+	streams := messageStreamRoutes([]Route{
+		{Method: "GET", Path: "/golden/{id}/stats",
+			Response: reflect.TypeOf(GoldenChild{}),
+			Stream:   ProgressStream,
+			Reader:   "ReadGoldenStatsAsync"},
+		{Method: "GET", Path: "/golden/{id}/logs",
+			Stream: RawStream},
+		{Method: "POST", Path: "/golden/pull",
+			Response: reflect.TypeOf(GoldenBase{}),
+			Stream:   ProgressStream,
+			Reader:   "ReadGoldenPullAsync"},
+		{Method: "POST", Path: "/golden/push",
+			Response: reflect.TypeOf(GoldenBase{}),
+			Stream:   ProgressStream,
+			Reader:   "ReadGoldenPullAsync"},
+		{Method: "POST", Path: "/golden/load",
+			Stream: ProgressStream},
+	})
+
+	var b bytes.Buffer
+	writeMessageStreams(&b, streams)
+
+	for _, err := range generationErrors {
+		fmt.Fprintf(&b, "// error: %v\n", err)
+	}
+
+	compareGolden(t, "streams", b.Bytes())
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// swaggerSpec is the subset of moby's Swagger 2.0 document (api/swagger.yaml) read by specgen.
+type swaggerSpec struct {
+	BasePath    string                      `yaml:"basePath"`
+	Definitions swaggerSchemaMap            `yaml:"definitions"`
+	Paths       map[string]*swaggerPathItem `yaml:"paths"`
+}
+
+// swaggerPathItem holds the operations of a path by HTTP method.
+type swaggerPathItem struct {
+	Get    *swaggerOperation `yaml:"get"`
+	Put    *swaggerOperation `yaml:"put"`
+	Post   *swaggerOperation `yaml:"post"`
+	Delete *swaggerOperation `yaml:"delete"`
+	Head   *swaggerOperation `yaml:"head"`
+}
+
+// operation returns the operation for an upper case HTTP method, or nil.
+func (p *swaggerPathItem) operation(method string) *swaggerOperation {
+	switch method {
+	case "GET":
+		return p.Get
+	case "PUT":
+		return p.Put
+	case "POST":
+		return p.Post
+	case "DELETE":
+		return p.Delete
+	case "HEAD":
+		return p.Head
+	}
+
+	return nil
+}
+
+type swaggerOperation struct {
+	Parameters []swaggerParameter `yaml:"parameters"`
+}
+
+// swaggerParameter is a path, query, header or body parameter of an operation.
+type swaggerParameter struct {
+	Name        string         `yaml:"name"`
+	In          string         `yaml:"in"`
+	Type        swaggerType    `yaml:"type"`
+	Items       *swaggerSchema `yaml:"items"`
+	Description string         `yaml:"description"`
+}
+
+// typeName returns the swagger type of a non-body parameter, e.g. integer or array of string.
+func (p swaggerParameter) typeName() string {
+	if p.Type == "array" && p.Items != nil {
+		return "array of " + string(p.Items.Type)
+	}
+
+	return string(p.Type)
+}
+
+// swaggerSchema is a Swagger schema object including the go-swagger extensions used by moby.
+type swaggerSchema struct {
+	Ref                  string                       `yaml:"$ref"`
+	Type                 swaggerType                  `yaml:"type"`
+	Format               string                       `yaml:"format"`
+	Description          string                       `yaml:"description"`
+	Properties           swaggerSchemaMap             `yaml:"properties"`
+	Items                *swaggerSchema               `yaml:"items"`
+	AdditionalProperties *swaggerAdditionalProperties `yaml:"additionalProperties"`
+	AllOf                []*swaggerSchema             `yaml:"allOf"`
+	Enum                 []any                        `yaml:"enum"`
+	Nullable             *bool                        `yaml:"x-nullable"`
+	GoName               string                       `yaml:"x-go-name"`
+}
+
+// swaggerType is the type of a schema. Older API versions list several types
+// for some properties, e.g. [array, string], the first one is used.
+type swaggerType string
+
+func (t *swaggerType) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.SequenceNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	var s string
+	if err := node.Decode(&s); err != nil {
+		return err
+	}
+
+	*t = swaggerType(s)
+	return nil
+}
+
+// swaggerSchemaMap keeps the declaration order of definitions and properties,
+// so the generated models list their properties in the same order as the document.
+type swaggerSchemaMap struct {
+	Names   []string
+	Schemas map[string]*swaggerSchema
+}
+
+func (m *swaggerSchemaMap) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping", node.Line)
+	}
+
+	m.Schemas = make(map[string]*swaggerSchema, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name := node.Content[i].Value
+
+		var s swaggerSchema
+		if err := node.Content[i+1].Decode(&s); err != nil {
+			return err
+		}
+
+		m.Names = append(m.Names, name)
+		m.Schemas[name] = &s
+	}
+
+	return nil
+}
+
+// swaggerAdditionalProperties is either a boolean or a schema.
+type swaggerAdditionalProperties struct {
+	Allowed bool
+	Schema  *swaggerSchema
+}
+
+func (a *swaggerAdditionalProperties) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&a.Allowed)
+	}
+
+	a.Allowed = true
+	return node.Decode(&a.Schema)
+}
+
+// swaggerDefinitionRef returns the definition name of a local $ref.
+func swaggerDefinitionRef(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
+
+// defaultSwaggerPath returns the swagger.yaml shipped with the resolved moby api module.
+func defaultSwaggerPath() (string, error) {
+	modulePath, err := findGoModulePath("github.com/moby/moby/api")
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(modulePath, "swagger.yaml"), nil
+}
+
+func loadSwagger(name string) (*swaggerSpec, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec swaggerSpec
+	if err := yaml.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	return &spec, nil
+}
+
+// swaggerReflector builds the C# model graph from the swagger definitions, the
+// same way reflectType does for the Go types.
+type swaggerReflector struct {
+	spec   *swaggerSpec
+	models map[string]*CSModelType
+}
+
+func reflectSwagger(spec *swaggerSpec) map[string]*CSModelType {
+	r := &swaggerReflector{spec: spec, models: map[string]*CSModelType{}}
+
+	for _, name := range spec.Definitions.Names {
+		if s := spec.Definitions.Schemas[name]; r.isModel(s) {
+			r.reflectModel(name, "swagger."+name, s)
+		}
+	}
+
+	return r.models
+}
+
+// isModel reports whether the schema is generated as a C# class rather than
+// mapped to a primitive, list or dictionary type.
+func (r *swaggerReflector) isModel(s *swaggerSchema) bool {
+	if len(s.Properties.Names) > 0 {
+		return true
+	}
+
+	for _, p := range s.AllOf {
+		if p.Ref != "" && r.isModel(r.definition(p.Ref)) || r.isModel(p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *swaggerReflector) definition(ref string) *swaggerSchema {
+	s, ok := r.spec.Definitions.Schemas[swaggerDefinitionRef(ref)]
+	if !ok {
+		reportError("swagger reference (%s) does not exist", ref)
+		return &swaggerSchema{}
+	}
+
+	return s
+}
+
+// reflectModel returns the model for a definition (sourceName swagger.Name) or
+// an inline object schema (sourceName swagger.Owner.Property).
+func (r *swaggerReflector) reflectModel(name, sourceName string, s *swaggerSchema) *CSModelType {
+	if m, ok := r.models[name]; ok {
+		return m
+	}
+
+	m := NewModel(name, sourceName)
+	m.Comment = strings.TrimSpace(s.Description)
+	m.IsStarted = true
+	r.models[name] = m
+
+	r.reflectProperties(m, s)
+	return m
+}
+
+// reflectProperties adds the properties of s, including those of all allOf parts, to m.
+func (r *swaggerReflector) reflectProperties(m *CSModelType, s *swaggerSchema) {
+	for _, p := range s.AllOf {
+		if p.Ref != "" {
+			r.reflectProperties(m, r.definition(p.Ref))
+		} else {
+			r.reflectProperties(m, p)
+		}
+	}
+
+	for _, name := range s.Properties.Names {
+		p := s.Properties.Schemas[name]
+
+		csProp := CSProperty{
+			Name:       strings.ToUpper(name[:1]) + name[1:],
+			Type:       r.csType(p, m, name),
+			IsNullable: p.Nullable != nil && *p.Nullable,
+			Comment:    strings.TrimSpace(p.Description),
+		}
+
+		csProp.Attributes = append(csProp.Attributes, CSAttribute{
+			Type:      CSType{"System.Text.Json.Serialization", "JsonPropertyName"},
+			Arguments: []CSArgument{{name, CSInboxTypesMap[reflect.String]}},
+		})
+
+		// A property redeclared by a later allOf part replaces the earlier one.
+		if i := slices.IndexFunc(m.Properties, func(p CSProperty) bool { return p.Name == csProp.Name }); i >= 0 {
+			m.Properties[i] = csProp
+		} else {
+			m.Properties = append(m.Properties, csProp)
+		}
+
+		m.HasJsonSerializableProperties = true
+	}
+}
+
+// csType returns the C# type of the schema of property prop of owner. Inline
+// object schemas are generated as models named after their owner and property,
+// e.g. HostConfigLogConfig.
+func (r *swaggerReflector) csType(s *swaggerSchema, owner *CSModelType, prop string) CSType {
+	if s.Ref != "" {
+		name := swaggerDefinitionRef(s.Ref)
+		d := r.definition(s.Ref)
+		if r.isModel(d) {
+			return CSType{"", r.reflectModel(name, "swagger."+name, d).Name}
+		}
+
+		return r.csType(d, owner, prop)
+	}
+
+	// A single allOf reference is how moby attaches a description to a $ref.
+	if len(s.AllOf) == 1 && len(s.Properties.Names) == 0 {
+		return r.csType(s.AllOf[0], owner, prop)
+	}
+
+	if r.isModel(s) {
+		name := owner.Name + strings.ToUpper(prop[:1]) + prop[1:]
+		return CSType{"", r.reflectModel(name, owner.SourceName+"."+prop, s).Name}
+	}
+
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "dateTime", "date-time":
+			return CSType{"System", "DateTime"}
+		}
+
+		return CSInboxTypesMap[reflect.String]
+	case "integer":
+		return swaggerIntegerType(s.Format)
+	case "number":
+		if s.Format == "float" {
+			return CSInboxTypesMap[reflect.Float32]
+		}
+
+		return CSInboxTypesMap[reflect.Float64]
+	case "boolean":
+		return CSInboxTypesMap[reflect.Bool]
+	case "array":
+		if s.Items == nil {
+			return CSType{"System.Collections.Generic", "IList<object>"}
+		}
+
+		return CSType{"System.Collections.Generic", fmt.Sprintf("IList<%s>", r.csType(s.Items, owner, prop).Name)}
+	case "object", "":
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+			return CSType{"System.Collections.Generic", fmt.Sprintf("IDictionary<string, %s>", r.csType(s.AdditionalProperties.Schema, owner, prop).Name)}
+		}
+
+		if s.AdditionalProperties != nil && s.AdditionalProperties.Allowed {
+			return CSType{"System.Collections.Generic", "IDictionary<string, object>"}
+		}
+
+		return CSType{"", "object"}
+	default:
+		reportError("cannot convert swagger type (%s) of (%s.%s)", s.Type, owner.SourceName, prop)
+		return CSType{"", "object"}
+	}
+}
+
+func swaggerIntegerType(format string) CSType {
+	switch format {
+	case "int8":
+		return CSInboxTypesMap[reflect.Int8]
+	case "int16":
+		return CSInboxTypesMap[reflect.Int16]
+	case "int32":
+		return CSInboxTypesMap[reflect.Int32]
+	case "uint8":
+		return CSInboxTypesMap[reflect.Uint8]
+	case "uint16":
+		return CSInboxTypesMap[reflect.Uint16]
+	case "uint32":
+		return CSInboxTypesMap[reflect.Uint32]
+	case "uint64":
+		return CSInboxTypesMap[reflect.Uint64]
+	default:
+		// int64 and unspecified formats map to Go's int, which is 64bit in practice.
+		return CSInboxTypesMap[reflect.Int64]
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// timeEncoding is how a time or duration field is written on the wire, which
+// decides the C# type and JsonConverter of its property.
+type timeEncoding string
+
+const (
+	// timeEncodingNanoseconds is a time.Duration, an integer number of nanoseconds.
+	timeEncodingNanoseconds timeEncoding = "nanoseconds"
+	// timeEncodingSeconds is an integer number of seconds, e.g. a timeout.
+	timeEncodingSeconds timeEncoding = "seconds"
+	// timeEncodingUnix is an integer unix timestamp, the seconds since the epoch.
+	timeEncodingUnix timeEncoding = "unix"
+	// timeEncodingRFC3339Nano is a time.Time, or a string in its format. Go
+	// writes its zero time as 0001-01-01T00:00:00Z, which is null in C#
+	// unless the config of the property sets nullZeroTime to false.
+	timeEncodingRFC3339Nano timeEncoding = "rfc3339nano"
+	// timeEncodingNone keeps the C# type of the Go type, for a field the
+	// inference gets wrong.
+	timeEncodingNone timeEncoding = "none"
+)
+
+// timeEncodingTypes are the C# type and the JsonConverter of the properties
+// of each encoding. Times with a zone are DateTimeOffset, so it is not lost.
+var timeEncodingTypes = map[timeEncoding]struct {
+	Type      CSType
+	Converter string
+}{
+	timeEncodingNanoseconds: {CSType{"System", "TimeSpan"}, "JsonTimeSpanNanosecondsConverter"},
+	timeEncodingSeconds:     {CSType{"System", "TimeSpan"}, "JsonTimeSpanSecondsConverter"},
+	timeEncodingUnix:        {CSType{"System", "DateTime"}, "JsonUnixTimestampConverter"},
+	timeEncodingRFC3339Nano: {CSType{"System", "DateTimeOffset"}, "JsonGoTimeConverter"},
+}
+
+var (
+	// unixTimestampPattern matches the docs of integer unix timestamps, e.g.
+	// "as a Unix timestamp (number of seconds since EPOCH)".
+	unixTimestampPattern = regexp.MustCompile(`(?i)\bunix timestamp\b|\bseconds since (the )?epoch\b`)
+	// secondsPattern matches the docs of integer durations in seconds, e.g.
+	// "Timeout (in seconds) to stop a container", but not "bytes per second".
+	secondsPattern = regexp.MustCompile(`(?i)\bin seconds\b`)
+	// rfc3339Pattern matches the docs of strings with a time, e.g. "formatted
+	// in [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format with nano-seconds".
+	rfc3339Pattern = regexp.MustCompile(`(?i)\bRFC ?3339\b`)
+)
+
+// swaggerDefinitions are the definitions of the latest swagger.yaml, whose
+// formats and descriptions fieldTimeEncoding reads.
+var swaggerDefinitions swaggerSchemaMap
+
+// fieldTimeEncoding returns the wire format of a field of a Go type, the one
+// of its config or the one inferTimeEncoding infers, empty if the field is
+// neither a time nor a duration or its config sets its C# type. An unknown
+// encoding in the config is empty, validateConfig reports it.
+func fieldTimeEncoding(t reflect.Type, f reflect.StructField, pc *PropertyConfig) timeEncoding {
+	if pc != nil && pc.Encoding != "" {
+		if _, ok := timeEncodingTypes[pc.Encoding]; !ok {
+			return ""
+		}
+
+		return pc.Encoding
+	}
+
+	if pc != nil && (pc.Type != "" || pc.Converter != "") {
+		return ""
+	}
+
+	return inferTimeEncoding(t, f)
+}
+
+// inferTimeEncoding infers the wire format of a field from its Go type, its
+// doc comment and the format and description of its swagger property.
+// time.Duration and time.Time are encoded by their type, integers are unix
+// timestamps or seconds and strings are times if their docs say so.
+func inferTimeEncoding(t reflect.Type, f reflect.StructField) timeEncoding {
+	ft := f.Type
+	for ft.Kind() == reflect.Ptr {
+		ft = ft.Elem()
+	}
+
+	switch ft {
+	case reflect.TypeOf(time.Duration(0)):
+		return timeEncodingNanoseconds
+	case reflect.TypeOf(time.Time{}):
+		return timeEncodingRFC3339Nano
+	}
+
+	// Named types, e.g. enums, keep their C# type.
+	if ft.PkgPath() != "" {
+		return ""
+	}
+
+	docs := getFieldComment(t, f.Name)
+	var format string
+	if s := swaggerProperty(t, f); s != nil {
+		docs += "\n" + s.Description
+		format = s.Format
+	}
+
+	switch ft.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		if unixTimestampPattern.MatchString(docs) {
+			return timeEncodingUnix
+		}
+
+		if secondsPattern.MatchString(docs) {
+			return timeEncodingSeconds
+		}
+	case reflect.String:
+		if format == "dateTime" || format == "date-time" || rfc3339Pattern.MatchString(docs) {
+			return timeEncodingRFC3339Nano
+		}
+	}
+
+	return ""
+}
+
+// nullZeroTime reports whether Go's zero time of an rfc3339nano property is
+// null in C#, which it is unless its config sets nullZeroTime to false.
+func nullZeroTime(pc *PropertyConfig) bool {
+	return pc == nil || pc.NullZeroTime == nil || *pc.NullZeroTime
+}
+
+// jsonConverterAttribute returns the JsonConverter attribute of a converter type.
+func jsonConverterAttribute(converter string) CSAttribute {
+	return CSAttribute{
+		Type:      CSType{"System.Text.Json.Serialization", "JsonConverter"},
+		Arguments: []CSArgument{{Value: "typeof(" + converter + ")"}},
+	}
+}
+
+// swaggerProperty returns the swagger property of a field, nil if the Go type
+// has no swagger definition or the definition has no such property.
+func swaggerProperty(t reflect.Type, f reflect.StructField) *swaggerSchema {
+	name, ok := matchSwaggerDefinition(t.String(), func(name string) bool {
+		_, ok := swaggerDefinitions.Schemas[name]
+		return ok
+	})
+	if !ok {
+		return nil
+	}
+
+	jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+	if jsonName == "" {
+		jsonName = f.Name
+	}
+
+	return swaggerDefinitions.Schemas[name].Properties.Schemas[jsonName]
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+
+	"github.com/moby/moby/api/types/auxprogress"
+	"github.com/moby/moby/api/types/build"
+	"github.com/moby/moby/api/types/jsonstream"
+	"github.com/moby/moby/api/types/system"
+	"github.com/moby/moby/api/types/volume"
+)
+
+// CSUnionMember is a concrete type an interface-typed field can hold.
+type CSUnionMember struct {
+	Type reflect.Type
+	// Property is the JSON property whose presence selects the member.
+	Property string
+	// Name is the C# model of Type, set when the union is reflected.
+	Name string
+}
+
+// CSUnionType is an interface-typed (or raw JSON) Go field generated as an
+// abstract C# class, whose members derive from it. A generated converter
+// deserializes a JSON object as the first member whose Property it has, and
+// anything else as the Other member, which keeps the raw JSON.
+type CSUnionType struct {
+	Name       string
+	SourceName string
+	Comment    string
+	Members    []CSUnionMember
+}
+
+// OtherName returns the name of the member holding values no other member matches.
+func (u *CSUnionType) OtherName() string {
+	return u.Name + "Other"
+}
+
+// ConverterName returns the name of the generated JsonConverter.
+func (u *CSUnionType) ConverterName() string {
+	return u.Name + "JsonConverter"
+}
+
+// unionTypes declares the concrete types of interface-typed or raw JSON
+// fields, by the type key of the struct and the field name. The members are
+// checked in order, so a member whose Property other members also have must
+// come after them.
+var unionTypes = map[string]*CSUnionType{
+	typeToKey(reflect.TypeOf(jsonstream.Message{})) + ".Aux": {
+		Name: "JSONMessageAux",
+		Members: []CSUnionMember{
+			{Type: reflect.TypeOf(auxprogress.ManifestPushedInsteadOfIndex{}), Property: "manifestPushedInsteadOfIndex"},
+			{Type: reflect.TypeOf(auxprogress.ContentMissing{}), Property: "contentMissing"},
+			{Type: reflect.TypeOf(PushResult{}), Property: "Digest"},
+			{Type: reflect.TypeOf(build.Result{}), Property: "ID"},
+		},
+	},
+}
+
+// rawJSONFields are the interface-typed fields that hold any JSON, e.g. the
+// options of a driver, by the type key of the struct and the field name. They
+// stay object, which System.Text.Json reads as a JsonElement. Any other
+// interface-typed field needs a union in unionTypes.
+var rawJSONFields = map[string]bool{
+	typeToKey(reflect.TypeOf(system.Runtime{})) + ".Options": true,
+	typeToKey(reflect.TypeOf(volume.Volume{})) + ".Status":   true,
+	typeToKey(reflect.TypeOf(VolumeResponse{})) + ".Status":  true,
+}
+
+var reflectedUnions = map[string]*CSUnionType{}
+
+// reflectUnion reflects the members of the union declared for field f of t, if any.
+func reflectUnion(t reflect.Type, f reflect.StructField) *CSUnionType {
+	k := typeToKey(t) + "." + f.Name
+	if u, ok := reflectedUnions[k]; ok {
+		return u
+	}
+
+	decl, ok := unionTypes[k]
+	if !ok {
+		return nil
+	}
+
+	u := &CSUnionType{
+		Name:       decl.Name,
+		SourceName: fmt.Sprintf("%s.%s", t, f.Name),
+	}
+	// The property of the union is deprecated, not the union itself.
+	u.Comment, _ = splitDeprecation(getFieldComment(t, f.Name))
+	reflectedUnions[k] = u
+
+	for _, member := range decl.Members {
+		reflectType(member.Type)
+
+		m := reflectedTypes[typeToKey(member.Type)]
+		if m == nil {
+			continue
+		}
+
+		if !slices.ContainsFunc(m.Properties, func(p CSProperty) bool {
+			name, ok := jsonPropertyName(p)
+			return ok && name == member.Property
+		}) {
+			reportError("union (%s) member (%s) has no JSON property (%s)", u.Name, member.Type, member.Property)
+		}
+
+		if m.BaseType != "" && m.BaseType != u.Name {
+			reportError("union (%s) member (%s) is already a member of (%s)", u.Name, member.Type, m.BaseType)
+		}
+
+		m.BaseType = u.Name
+		member.Name = m.Name
+		u.Members = append(u.Members, member)
+	}
+
+	return u
+}
+
+// Write the union base class, its Other member and its converter to the io writer given.
+func (u *CSUnionType) Write(w io.Writer) {
+	fmt.Fprintln(w, "#nullable enable")
+
+	var usings []string
+	added := map[string]bool{}
+	for _, ns := range []string{"System", "System.Text.Json", "System.Text.Json.Serialization", "System.Text.Json.Serialization.Metadata"} {
+		usings = safeAddUsing(ns, usings, added)
+	}
+
+	for _, us := range usings {
+		fmt.Fprintf(w, "using %s;\n", us)
+	}
+
+	if len(usings) > 0 {
+		fmt.Fprintln(w, "")
+	}
+
+	fmt.Fprintf(w, "namespace %s\n", *modelsNamespace)
+	fmt.Fprintln(w, "{")
+
+	writeXMLComment(w, u.Comment, "    ")
+	fmt.Fprintln(w, "    /// <remarks>")
+	fmt.Fprintf(w, "    /// A JSON object is read as the first of these types it has the property of, anything else as <see cref=\"%s\"/>:\n", u.OtherName())
+	fmt.Fprintln(w, "    /// <list type=\"bullet\">")
+	for _, m := range u.Members {
+		fmt.Fprintf(w, "    /// <item><description><see cref=\"%s\"/>: <c>%s</c></description></item>\n", m.Name, escapeXMLComment(m.Property))
+	}
+	fmt.Fprintln(w, "    /// </list>")
+	fmt.Fprintln(w, "    /// </remarks>")
+	fmt.Fprintf(w, "    [JsonConverter(typeof(%s))]\n", u.ConverterName())
+	fmt.Fprintf(w, "    public abstract class %s // (%s)\n", u.Name, u.SourceName)
+	fmt.Fprintln(w, "    {")
+	fmt.Fprintln(w, "    }")
+	fmt.Fprintln(w, "")
+
+	fmt.Fprintln(w, "    /// <summary>")
+	fmt.Fprintf(w, "    /// A <see cref=\"%s\"/> that is none of its known types.\n", u.Name)
+	fmt.Fprintln(w, "    /// </summary>")
+	fmt.Fprintf(w, "    public sealed class %s : %s\n", u.OtherName(), u.Name)
+	fmt.Fprintln(w, "    {")
+	fmt.Fprintln(w, "        public JsonElement Value { get; set; }")
+	fmt.Fprintln(w, "    }")
+	fmt.Fprintln(w, "")
+
+	fmt.Fprintf(w, "    internal sealed class %s : JsonConverter<%s>\n", u.ConverterName(), u.Name)
+	fmt.Fprintln(w, "    {")
+	fmt.Fprintf(w, "        public override %s? Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)\n", u.Name)
+	fmt.Fprintln(w, "        {")
+	fmt.Fprintln(w, "            using var document = JsonDocument.ParseValue(ref reader);")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "            var element = document.RootElement;")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "            if (element.ValueKind == JsonValueKind.Object)")
+	fmt.Fprintln(w, "            {")
+	for i, m := range u.Members {
+		if i > 0 {
+			fmt.Fprintln(w, "")
+		}
+
+		fmt.Fprintf(w, "                if (element.TryGetProperty(\"%s\", out _))\n", m.Property)
+		fmt.Fprintln(w, "                {")
+		fmt.Fprintf(w, "                    return element.Deserialize((JsonTypeInfo<%s>)options.GetTypeInfo(typeof(%s)));\n", m.Name, m.Name)
+		fmt.Fprintln(w, "                }")
+	}
+	fmt.Fprintln(w, "            }")
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "            return new %s { Value = element.Clone() };\n", u.OtherName())
+	fmt.Fprintln(w, "        }")
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "        public override void Write(Utf8JsonWriter writer, %s value, JsonSerializerOptions options)\n", u.Name)
+	fmt.Fprintln(w, "        {")
+	fmt.Fprintln(w, "            switch (value)")
+	fmt.Fprintln(w, "            {")
+	for _, m := range u.Members {
+		fmt.Fprintf(w, "                case %s member:\n", m.Name)
+		fmt.Fprintf(w, "                    System.Text.Json.JsonSerializer.Serialize(writer, member, (JsonTypeInfo<%s>)options.GetTypeInfo(typeof(%s)));\n", m.Name, m.Name)
+		fmt.Fprintln(w, "                    break;")
+	}
+	fmt.Fprintf(w, "                case %s other:\n", u.OtherName())
+	fmt.Fprintln(w, "                    other.Value.WriteTo(writer);")
+	fmt.Fprintln(w, "                    break;")
+	fmt.Fprintln(w, "                default:")
+	fmt.Fprintf(w, "                    throw new JsonException($\"Cannot write {value.GetType()} as %s.\");\n", u.Name)
+	fmt.Fprintln(w, "            }")
+	fmt.Fprintln(w, "        }")
+	fmt.Fprintln(w, "    }")
+
+	fmt.Fprintln(w, "}")
+}
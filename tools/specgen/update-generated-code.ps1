@@ -9,6 +9,9 @@ $ErrorActionPreference = 'Stop'
 
 $scriptDir = $PSScriptRoot
 $modelsDir = Resolve-Path (Join-Path $scriptDir '..\..\src\Docker.DotNet\Models')
+$endpointsDir = Resolve-Path (Join-Path $scriptDir '..\..\src\Docker.DotNet\Endpoints')
+$fixturesDir = Resolve-Path (Join-Path $scriptDir '..\..\test\Docker.DotNet.Tests\Fixtures')
+$conformanceDir = Resolve-Path (Join-Path $scriptDir '..\..\test\Docker.DotNet.Tests\Conformance')
 $specgenExe = Join-Path $scriptDir 'specgen.exe'
 
 $utf8WithoutBom = [System.Text.UTF8Encoding]::new($false)
@@ -18,6 +21,8 @@ $directoryBuildPropsPath = Join-Path $scriptDir '..\..\Directory.Build.props'
 Push-Location $scriptDir
 
 try {
+    $previousApiVersion = go list -m -f '{{.Version}}' github.com/moby/moby/api
+
     Write-Host "Updating moby api package to tag '$ReleaseTag'"
     go get "github.com/moby/moby/api@$ReleaseTag"
 
@@ -35,8 +40,23 @@ try {
     Write-Host "Deleting existing generated model classes in '$modelsDir'"
     Get-ChildItem -Path $modelsDir -Filter '*.Generated.cs' -File | Remove-Item -Force
 
-    Write-Host 'Regenerating model classes'
-    & $specgenExe $modelsDir
+    Write-Host "Deleting existing generated operation classes in '$endpointsDir'"
+    Get-ChildItem -Path $endpointsDir -Filter '*.Generated.cs' -File | Remove-Item -Force
+
+    Write-Host "Deleting existing generated fixtures in '$fixturesDir'"
+    Get-ChildItem -Path $fixturesDir -Include '*.Generated.cs', '*.Generated.json' -File -Recurse -Depth 0 | Remove-Item -Force
+
+    Write-Host 'Regenerating model and operation classes and fixtures'
+    & $specgenExe -out $modelsDir -fixtures $fixturesDir
+
+    Write-Host "Deleting existing conformance vectors in '$conformanceDir'"
+    Get-ChildItem -Path $conformanceDir -Filter '*.Generated.json' -File | Remove-Item -Force
+
+    Write-Host 'Recording conformance vectors of the moby client'
+    & $specgenExe conformance -out $conformanceDir
+
+    Write-Host "Model changes since github.com/moby/moby/api@$previousApiVersion"
+    & $specgenExe diff $previousApiVersion (go list -m -f '{{.Version}}' github.com/moby/moby/api)
 }
 finally {
     if (Test-Path -Path $specgenExe) {
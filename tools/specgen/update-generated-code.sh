@@ -15,6 +15,9 @@ fi
 release_tag="$1"
 script_dir="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
 models_dir="$(cd "$script_dir/../../src/Docker.DotNet/Models" && pwd)"
+endpoints_dir="$(cd "$script_dir/../../src/Docker.DotNet/Endpoints" && pwd)"
+fixtures_dir="$(cd "$script_dir/../../test/Docker.DotNet.Tests/Fixtures" && pwd)"
+conformance_dir="$(cd "$script_dir/../../test/Docker.DotNet.Tests/Conformance" && pwd)"
 specgen_bin="$script_dir/specgen"
 
 docker_version="${release_tag#docker-}"
@@ -29,6 +32,8 @@ cleanup() {
 
 trap cleanup EXIT
 
+previous_api_version="$(go list -m -f '{{.Version}}' github.com/moby/moby/api)"
+
 echo "Updating moby api package to tag '$release_tag'"
 go get "github.com/moby/moby/api@$release_tag"
 
@@ -45,5 +50,20 @@ go build
 echo "Deleting existing generated model classes in '$models_dir'"
 find "$models_dir" -maxdepth 1 -type f -name '*.Generated.cs' -delete
 
-echo "Regenerating model classes"
-"$specgen_bin" "$models_dir"
\ No newline at end of file
+echo "Deleting existing generated operation classes in '$endpoints_dir'"
+find "$endpoints_dir" -maxdepth 1 -type f -name '*.Generated.cs' -delete
+
+echo "Deleting existing generated fixtures in '$fixtures_dir'"
+find "$fixtures_dir" -maxdepth 1 -type f \( -name '*.Generated.cs' -o -name '*.Generated.json' \) -delete
+
+echo "Regenerating model and operation classes and fixtures"
+"$specgen_bin" -out "$models_dir" -fixtures "$fixtures_dir"
+
+echo "Deleting existing conformance vectors in '$conformance_dir'"
+find "$conformance_dir" -maxdepth 1 -type f -name '*.Generated.json' -delete
+
+echo "Recording conformance vectors of the moby client"
+"$specgen_bin" conformance -out "$conformance_dir"
+
+echo "Model changes since github.com/moby/moby/api@$previous_api_version"
+"$specgen_bin" diff "$previous_api_version" "$(go list -m -f '{{.Version}}' github.com/moby/moby/api)"
\ No newline at end of file